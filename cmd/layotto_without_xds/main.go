@@ -36,6 +36,8 @@ import (
 
 	aws_oss "mosn.io/layotto/components/oss/aws"
 
+	local_oss "mosn.io/layotto/components/oss/local"
+
 	aliyun_oss "mosn.io/layotto/components/oss/aliyun"
 
 	huaweicloud_oss "mosn.io/layotto/components/oss/huaweicloud"
@@ -83,6 +85,9 @@ import (
 	// Configuration
 	"mosn.io/layotto/components/configstores"
 	"mosn.io/layotto/components/configstores/apollo"
+	configstore_composite "mosn.io/layotto/components/configstores/composite"
+	configstore_inmemory "mosn.io/layotto/components/configstores/in-memory"
+	configstore_local "mosn.io/layotto/components/configstores/local"
 
 	// Pub/Sub
 	dapr_comp_pubsub "github.com/dapr/components-contrib/pubsub"
@@ -155,6 +160,9 @@ import (
 	_ "mosn.io/layotto/pkg/actuator"
 	"mosn.io/layotto/pkg/actuator/health"
 	actuatorInfo "mosn.io/layotto/pkg/actuator/info"
+	_ "mosn.io/layotto/pkg/actuator/lock"
+	_ "mosn.io/layotto/pkg/actuator/rpc"
+	_ "mosn.io/layotto/pkg/actuator/sequencer"
 	_ "mosn.io/layotto/pkg/filter/stream/actuator/http"
 	"mosn.io/layotto/pkg/integrate/actuator"
 
@@ -272,6 +280,9 @@ func NewRuntimeGrpcServer(data json.RawMessage, opts ...grpc.ServerOption) (mgrp
 			configstores.NewStoreFactory("apollo", apollo.NewStore),
 			configstores.NewStoreFactory("etcd", etcdv3.NewStore),
 			configstores.NewStoreFactory("nacos", nacos.NewStore),
+			configstores.NewStoreFactory("in-memory", configstore_inmemory.NewStore),
+			configstores.NewStoreFactory("local", configstore_local.NewStore),
+			configstores.NewStoreFactory("composite", configstore_composite.NewStore),
 		),
 
 		// RPC
@@ -420,6 +431,7 @@ func NewRuntimeGrpcServer(data json.RawMessage, opts ...grpc.ServerOption) (mgrp
 			oss.NewFactory("aliyun.oss", aliyun_oss.NewAliyunOss),
 			oss.NewFactory("ceph", ceph_oss.NewCephOss),
 			oss.NewFactory("huaweicloud.oss", huaweicloud_oss.NewHuaweicloudOSS),
+			oss.NewFactory("local.oss", local_oss.NewLocalOss),
 		),
 		// Cryption
 		runtime.WithCryptionServiceFactory(
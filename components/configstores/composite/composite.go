@@ -0,0 +1,359 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package composite
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"mosn.io/pkg/log"
+	"mosn.io/pkg/utils"
+
+	"mosn.io/layotto/components/configstores"
+)
+
+var errNoLayers = errors.New("configuration illegal:no layers")
+
+// CompositeConfigStore is a virtual config store layering several config stores.
+// The layers are in the order of precedence, i.e. an item in a former layer overrides the item with the same key in the latter layers.
+//
+// Get merges the items of all the layers, and the subscribers are notified with the merged items when any layer changes.
+// Set and Delete only apply to the first layer, so deleting an item reveals the item in the latter layers.
+//
+// The layers may be shared with others, so they are subscribed by the LayerSubscriber injected by the runtime,
+// and StopSubscribe only stops the subscriptions of the composite store.
+// Without a LayerSubscriber, the layers are owned by the composite store, and stopping a subscription stops the layers.
+type CompositeConfigStore struct {
+	storeName string
+	layers    []configstores.Store
+	subscribe configstores.LayerSubscriber
+
+	mu            sync.Mutex
+	subscriptions map[*subscription]struct{}
+}
+
+func NewStore() configstores.Store {
+	return &CompositeConfigStore{subscriptions: make(map[*subscription]struct{})}
+}
+
+// SetLayers sets the layers in the order of precedence. It's invoked by the runtime before Init.
+func (c *CompositeConfigStore) SetLayers(layers []configstores.Store) error {
+	if len(layers) == 0 {
+		return errNoLayers
+	}
+	c.layers = layers
+	return nil
+}
+
+// SetLayerSubscriber sets how the layers are subscribed. It's invoked by the runtime before Init.
+func (c *CompositeConfigStore) SetLayerSubscriber(subscribe configstores.LayerSubscriber) {
+	c.subscribe = subscribe
+}
+
+// Init init the configuration store.
+func (c *CompositeConfigStore) Init(config *configstores.StoreConfig) error {
+	if config == nil {
+		return errors.New("configuration illegal:no config data")
+	}
+	if len(c.layers) == 0 {
+		return errNoLayers
+	}
+	c.storeName = config.StoreName
+	return nil
+}
+
+// GetDefaultGroup returns empty, so that every layer uses its own default group.
+func (c *CompositeConfigStore) GetDefaultGroup() string {
+	return ""
+}
+
+// GetDefaultLabel returns empty, so that every layer uses its own default label.
+func (c *CompositeConfigStore) GetDefaultLabel() string {
+	return ""
+}
+
+// Get gets the items from all the layers, and merges them by key.
+func (c *CompositeConfigStore) Get(ctx context.Context, req *configstores.GetRequest) ([]*configstores.ConfigurationItem, error) {
+	layered, err := c.getLayers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	merged := mergeAll(layered)
+	res := make([]*configstores.ConfigurationItem, 0, len(merged))
+	for _, item := range merged {
+		res = append(res, item)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+	return res, nil
+}
+
+// getLayers gets the items from every layer, keyed by configuration key.
+func (c *CompositeConfigStore) getLayers(ctx context.Context, req *configstores.GetRequest) ([]map[string]*configstores.ConfigurationItem, error) {
+	layered := make([]map[string]*configstores.ConfigurationItem, len(c.layers))
+	for i, layer := range c.layers {
+		items, err := layer.Get(ctx, &configstores.GetRequest{
+			AppId:    req.AppId,
+			Group:    groupOf(layer, req.Group),
+			Label:    labelOf(layer, req.Label),
+			Keys:     req.Keys,
+			Metadata: req.Metadata,
+		})
+		if err != nil {
+			log.DefaultLogger.Errorf("[configstores] [composite] get from layer %d of %s error: %v", i, c.storeName, err)
+			return nil, err
+		}
+		layered[i] = make(map[string]*configstores.ConfigurationItem, len(items))
+		for _, item := range items {
+			layered[i][item.Key] = item
+		}
+	}
+	return layered, nil
+}
+
+// Set saves the items into the first layer.
+func (c *CompositeConfigStore) Set(ctx context.Context, req *configstores.SetRequest) error {
+	top := c.layers[0]
+	items := make([]*configstores.ConfigurationItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		copied := *item
+		copied.Group = groupOf(top, item.Group)
+		copied.Label = labelOf(top, item.Label)
+		items = append(items, &copied)
+	}
+	return top.Set(ctx, &configstores.SetRequest{StoreName: req.StoreName, AppId: req.AppId, Items: items})
+}
+
+// Delete deletes the items from the first layer.
+func (c *CompositeConfigStore) Delete(ctx context.Context, req *configstores.DeleteRequest) error {
+	top := c.layers[0]
+	return top.Delete(ctx, &configstores.DeleteRequest{
+		AppId:    req.AppId,
+		Group:    groupOf(top, req.Group),
+		Label:    labelOf(top, req.Label),
+		Keys:     req.Keys,
+		Metadata: req.Metadata,
+	})
+}
+
+// Subscribe subscribes all the layers, and notifies ch with the merged items whenever the merged result of a key changes.
+func (c *CompositeConfigStore) Subscribe(req *configstores.SubscribeReq, ch chan *configstores.SubscribeResp) error {
+	// load the items of all the layers first, so that a change can be merged with the other layers
+	layered, err := c.getLayers(context.Background(), &configstores.GetRequest{AppId: req.AppId, Group: req.Group, Label: req.Label, Keys: req.Keys, Metadata: req.Metadata})
+	if err != nil {
+		return err
+	}
+	sub := &subscription{
+		store:   c,
+		appId:   req.AppId,
+		ch:      ch,
+		stop:    make(chan struct{}),
+		layered: layered,
+		merged:  mergeAll(layered),
+	}
+	for i, layer := range c.layers {
+		layerCh := make(chan *configstores.SubscribeResp)
+		stop, err := c.subscribeLayer(i, &configstores.SubscribeReq{
+			AppId:    req.AppId,
+			Group:    groupOf(layer, req.Group),
+			Label:    labelOf(layer, req.Label),
+			Keys:     req.Keys,
+			Metadata: req.Metadata,
+		}, layerCh)
+		if err != nil {
+			log.DefaultLogger.Errorf("[configstores] [composite] subscribe layer %d of %s error: %v", i, c.storeName, err)
+			// roll back the layers subscribed
+			sub.close()
+			return err
+		}
+		sub.stops = append(sub.stops, stop)
+		index := i
+		utils.GoWithRecover(func() {
+			sub.watch(index, layerCh)
+		}, nil)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscriptions[sub] = struct{}{}
+	return nil
+}
+
+func (c *CompositeConfigStore) subscribeLayer(index int, req *configstores.SubscribeReq, ch chan *configstores.SubscribeResp) (func(), error) {
+	if c.subscribe != nil {
+		return c.subscribe(index, req, ch)
+	}
+	layer := c.layers[index]
+	if err := layer.Subscribe(req, ch); err != nil {
+		return nil, err
+	}
+	return layer.StopSubscribe, nil
+}
+
+// StopSubscribe stops the subscriptions of the composite store, and the subscriptions of the layers made by them.
+func (c *CompositeConfigStore) StopSubscribe() {
+	c.mu.Lock()
+	subscriptions := c.subscriptions
+	c.subscriptions = make(map[*subscription]struct{})
+	c.mu.Unlock()
+	for sub := range subscriptions {
+		sub.close()
+	}
+}
+
+// subscription keeps the items of every layer, and merges the changes of a layer with the others
+type subscription struct {
+	store *CompositeConfigStore
+	appId string
+	ch    chan *configstores.SubscribeResp
+	// stop is closed when the subscription is stopped or fails, and stops are the functions stopping the subscriptions of the layers
+	stop  chan struct{}
+	stops []func()
+
+	mu      sync.Mutex
+	layered []map[string]*configstores.ConfigurationItem
+	merged  map[string]*configstores.ConfigurationItem
+}
+
+// close stops watching the layers, and stops the subscriptions of them
+func (s *subscription) close() {
+	close(s.stop)
+	for _, stop := range s.stops {
+		stop()
+	}
+}
+
+// watch merges the changes of a layer until the subscription is stopped
+func (s *subscription) watch(index int, layerCh chan *configstores.SubscribeResp) {
+	for {
+		select {
+		case resp, ok := <-layerCh:
+			if !ok {
+				return
+			}
+			changed := s.apply(index, resp.Items)
+			if len(changed) == 0 {
+				continue
+			}
+			select {
+			case s.ch <- &configstores.SubscribeResp{StoreName: s.store.storeName, AppId: s.appId, Items: changed}:
+			case <-s.stop:
+				return
+			}
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// apply updates the items of a layer, and returns the merged items changed.
+// An item with empty content is removed from the layer, and the item is empty if it's removed from all the layers.
+func (s *subscription) apply(index int, items []*configstores.ConfigurationItem) []*configstores.ConfigurationItem {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := make([]*configstores.ConfigurationItem, 0, len(items))
+	for _, item := range items {
+		if item.Content == "" {
+			delete(s.layered[index], item.Key)
+		} else {
+			s.layered[index][item.Key] = item
+		}
+		merged := merge(s.layered, item.Key)
+		old := s.merged[item.Key]
+		if merged == nil && old == nil {
+			continue
+		}
+		if merged != nil && old != nil && equal(merged, old) {
+			// the change is overridden by a former layer
+			continue
+		}
+		if merged == nil {
+			delete(s.merged, item.Key)
+			changed = append(changed, &configstores.ConfigurationItem{Key: item.Key, Group: item.Group, Label: item.Label})
+			continue
+		}
+		s.merged[item.Key] = merged
+		changed = append(changed, merged)
+	}
+	return changed
+}
+
+// merge returns the item of the key in the first layer having it, with the tags of all the layers.
+// The tags in the former layers override the latter ones.
+func merge(layered []map[string]*configstores.ConfigurationItem, key string) *configstores.ConfigurationItem {
+	var res *configstores.ConfigurationItem
+	for i := len(layered) - 1; i >= 0; i-- {
+		item, ok := layered[i][key]
+		if !ok {
+			continue
+		}
+		tags := make(map[string]string)
+		if res != nil {
+			for k, v := range res.Tags {
+				tags[k] = v
+			}
+		}
+		for k, v := range item.Tags {
+			tags[k] = v
+		}
+		copied := *item
+		copied.Tags = tags
+		res = &copied
+	}
+	return res
+}
+
+func equal(a *configstores.ConfigurationItem, b *configstores.ConfigurationItem) bool {
+	if a.Content != b.Content || a.Group != b.Group || a.Label != b.Label || len(a.Tags) != len(b.Tags) {
+		return false
+	}
+	for k, v := range a.Tags {
+		if tag, ok := b.Tags[k]; !ok || tag != v {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeAll merges the items of all the keys in the layers.
+func mergeAll(layered []map[string]*configstores.ConfigurationItem) map[string]*configstores.ConfigurationItem {
+	res := make(map[string]*configstores.ConfigurationItem)
+	for _, items := range layered {
+		for key := range items {
+			if _, ok := res[key]; !ok {
+				res[key] = merge(layered, key)
+			}
+		}
+	}
+	return res
+}
+
+func groupOf(layer configstores.Store, group string) string {
+	if group == "" {
+		return layer.GetDefaultGroup()
+	}
+	return group
+}
+
+func labelOf(layer configstores.Store, label string) string {
+	if label == "" {
+		return layer.GetDefaultLabel()
+	}
+	return label
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package composite
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/configstores"
+	in_memory "mosn.io/layotto/components/configstores/in-memory"
+)
+
+func newLayer(t *testing.T, name string, items ...*configstores.ConfigurationItem) *in_memory.InMemoryConfigStore {
+	s := in_memory.NewInMemoryConfigStore()
+	assert.Nil(t, s.Init(&configstores.StoreConfig{StoreName: name}))
+	if len(items) > 0 {
+		assert.Nil(t, s.Set(context.Background(), &configstores.SetRequest{AppId: "app", Items: items}))
+	}
+	return s
+}
+
+// layerSubscriber subscribes the layers like the runtime, so that a subscription can be stopped alone, and counts the subscriptions not stopped.
+// The in-memory store can't unsubscribe a subscriber alone, so the changes are discarded after stopped, which the hubs of the runtime don't need.
+type layerSubscriber struct {
+	layers []configstores.Store
+	mu     sync.Mutex
+	active int
+}
+
+func (l *layerSubscriber) subscribe(index int, req *configstores.SubscribeReq, ch chan *configstores.SubscribeResp) (func(), error) {
+	layerCh := make(chan *configstores.SubscribeResp)
+	if err := l.layers[index].Subscribe(req, layerCh); err != nil {
+		return nil, err
+	}
+	stop := make(chan struct{})
+	go func() {
+		for resp := range layerCh {
+			select {
+			case ch <- resp:
+			case <-stop:
+			}
+		}
+	}()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.active++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(stop)
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.active--
+		})
+	}, nil
+}
+
+func (l *layerSubscriber) count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.active
+}
+
+func newComposite(t *testing.T, layers ...configstores.Store) (configstores.Store, *layerSubscriber) {
+	subscriber := &layerSubscriber{layers: layers}
+	store := NewStore()
+	assert.Nil(t, store.(configstores.LayeredStore).SetLayers(layers))
+	store.(configstores.LayeredStore).SetLayerSubscriber(subscriber.subscribe)
+	assert.Nil(t, store.Init(&configstores.StoreConfig{StoreName: "merged"}))
+	return store, subscriber
+}
+
+func setupComposite(t *testing.T) (configstores.Store, *in_memory.InMemoryConfigStore, *in_memory.InMemoryConfigStore, *layerSubscriber) {
+	overrides := newLayer(t, "overrides",
+		&configstores.ConfigurationItem{Group: "default", Label: "default", Key: "timeout", Content: "5", Tags: map[string]string{"cluster": "gz"}},
+	)
+	defaults := newLayer(t, "defaults",
+		&configstores.ConfigurationItem{Group: "default", Label: "default", Key: "timeout", Content: "3", Tags: map[string]string{"owner": "alice", "cluster": "all"}},
+		&configstores.ConfigurationItem{Group: "default", Label: "default", Key: "retry", Content: "1"},
+	)
+	store, subscriber := newComposite(t, overrides, defaults)
+	return store, overrides, defaults, subscriber
+}
+
+func receive(t *testing.T, ch chan *configstores.SubscribeResp) *configstores.SubscribeResp {
+	select {
+	case resp := <-ch:
+		return resp
+	case <-time.After(time.Second):
+		t.Fatal("no update received")
+		return nil
+	}
+}
+
+func TestCompositeConfigStore_Init(t *testing.T) {
+	store := NewStore()
+	assert.Equal(t, errNoLayers, store.Init(&configstores.StoreConfig{StoreName: "merged"}))
+	assert.Equal(t, errNoLayers, store.(configstores.LayeredStore).SetLayers(nil))
+}
+
+func TestCompositeConfigStore_Get(t *testing.T) {
+	store, _, _, _ := setupComposite(t)
+	items, err := store.Get(context.Background(), &configstores.GetRequest{AppId: "app"})
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, "retry", items[0].Key)
+	assert.Equal(t, "1", items[0].Content)
+	assert.Equal(t, "timeout", items[1].Key)
+	assert.Equal(t, "5", items[1].Content)
+	assert.Equal(t, map[string]string{"owner": "alice", "cluster": "gz"}, items[1].Tags)
+
+	items, err = store.Get(context.Background(), &configstores.GetRequest{AppId: "app", Keys: []string{"retry"}})
+	assert.Nil(t, err)
+	assert.Len(t, items, 1)
+}
+
+func TestCompositeConfigStore_SetDelete(t *testing.T) {
+	store, overrides, _, _ := setupComposite(t)
+	ctx := context.Background()
+	err := store.Set(ctx, &configstores.SetRequest{AppId: "app", Items: []*configstores.ConfigurationItem{{Key: "retry", Content: "2"}}})
+	assert.Nil(t, err)
+	// it's saved into the first layer
+	items, err := overrides.Get(ctx, &configstores.GetRequest{AppId: "app", Group: "default", Label: "default", Keys: []string{"retry"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", items[0].Content)
+
+	// deleting from the first layer reveals the latter one
+	err = store.Delete(ctx, &configstores.DeleteRequest{AppId: "app", Keys: []string{"retry", "timeout"}})
+	assert.Nil(t, err)
+	items, err = store.Get(ctx, &configstores.GetRequest{AppId: "app", Keys: []string{"retry", "timeout"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "1", items[0].Content)
+	assert.Equal(t, "3", items[1].Content)
+}
+
+func TestCompositeConfigStore_Subscribe(t *testing.T) {
+	store, overrides, defaults, _ := setupComposite(t)
+	ctx := context.Background()
+	ch := make(chan *configstores.SubscribeResp)
+	err := store.Subscribe(&configstores.SubscribeReq{AppId: "app", Keys: []string{"timeout", "retry"}}, ch)
+	assert.Nil(t, err)
+
+	// the change in the latter layer is merged
+	go defaults.Set(ctx, &configstores.SetRequest{AppId: "app", Items: []*configstores.ConfigurationItem{
+		{Group: "default", Label: "default", Key: "retry", Content: "3"},
+	}})
+	resp := receive(t, ch)
+	assert.Equal(t, "merged", resp.StoreName)
+	assert.Len(t, resp.Items, 1)
+	assert.Equal(t, "3", resp.Items[0].Content)
+
+	// the change overridden by the former layer is not notified
+	assert.Nil(t, defaults.Set(ctx, &configstores.SetRequest{AppId: "app", Items: []*configstores.ConfigurationItem{
+		{Group: "default", Label: "default", Key: "timeout", Content: "4", Tags: map[string]string{"owner": "alice", "cluster": "all"}},
+	}}))
+	// wait for the change to be merged
+	time.Sleep(50 * time.Millisecond)
+
+	// deleting the item in the former layer reveals the latter one
+	go overrides.Delete(ctx, &configstores.DeleteRequest{AppId: "app", Group: "default", Label: "default", Keys: []string{"timeout"}})
+	resp = receive(t, ch)
+	assert.Len(t, resp.Items, 1)
+	assert.Equal(t, "timeout", resp.Items[0].Key)
+	assert.Equal(t, "4", resp.Items[0].Content)
+
+	// deleting the item in all the layers
+	go defaults.Delete(ctx, &configstores.DeleteRequest{AppId: "app", Group: "default", Label: "default", Keys: []string{"timeout"}})
+	resp = receive(t, ch)
+	assert.Len(t, resp.Items, 1)
+	assert.Equal(t, "", resp.Items[0].Content)
+
+	store.StopSubscribe()
+}
+
+// failingLayer fails to subscribe
+type failingLayer struct {
+	*in_memory.InMemoryConfigStore
+}
+
+func (f failingLayer) Subscribe(*configstores.SubscribeReq, chan *configstores.SubscribeResp) error {
+	return errors.New("subscribe failed")
+}
+
+func TestCompositeConfigStore_SubscribeRollback(t *testing.T) {
+	top := newLayer(t, "top")
+	store, subscriber := newComposite(t, top, failingLayer{newLayer(t, "bottom")})
+	ch := make(chan *configstores.SubscribeResp, 1)
+	err := store.Subscribe(&configstores.SubscribeReq{AppId: "app", Keys: []string{"timeout"}}, ch)
+	assert.NotNil(t, err)
+	// the layer subscribed is stopped
+	assert.Equal(t, 0, subscriber.count())
+
+	// the changes of the layer subscribed are discarded without blocking the layer
+	assert.Nil(t, top.Set(context.Background(), &configstores.SetRequest{AppId: "app", Items: []*configstores.ConfigurationItem{
+		{Group: "default", Label: "default", Key: "timeout", Content: "5"},
+	}}))
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, ch, 0)
+}
+
+func TestCompositeConfigStore_StopSubscribe(t *testing.T) {
+	store, overrides, _, subscriber := setupComposite(t)
+	ch := make(chan *configstores.SubscribeResp)
+	assert.Nil(t, store.Subscribe(&configstores.SubscribeReq{AppId: "app", Keys: []string{"timeout"}}, ch))
+	assert.Equal(t, 2, subscriber.count())
+	others := make(chan *configstores.SubscribeResp)
+	assert.Nil(t, overrides.Subscribe(&configstores.SubscribeReq{AppId: "app", Group: "default", Label: "default", Keys: []string{"timeout"}}, others))
+
+	// the subscriptions of the layers are stopped, and the other subscribers of the layers are not
+	store.StopSubscribe()
+	assert.Equal(t, 0, subscriber.count())
+	go overrides.Set(context.Background(), &configstores.SetRequest{AppId: "app", Items: []*configstores.ConfigurationItem{
+		{Group: "default", Label: "default", Key: "timeout", Content: "6"},
+	}})
+	resp := receive(t, others)
+	assert.Equal(t, "6", resp.Items[0].Content)
+	select {
+	case <-ch:
+		t.Fatal("update received after stopped")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestCompositeConfigStore_OwnedLayers(t *testing.T) {
+	layer := newLayer(t, "layer")
+	store := NewStore()
+	assert.Nil(t, store.(configstores.LayeredStore).SetLayers([]configstores.Store{layer}))
+	assert.Nil(t, store.Init(&configstores.StoreConfig{StoreName: "merged"}))
+	ch := make(chan *configstores.SubscribeResp)
+	assert.Nil(t, store.Subscribe(&configstores.SubscribeReq{AppId: "app", Keys: []string{"timeout"}}, ch))
+	set := func(content string) {
+		assert.Nil(t, layer.Set(context.Background(), &configstores.SetRequest{AppId: "app", Items: []*configstores.ConfigurationItem{
+			{Group: "default", Label: "default", Key: "timeout", Content: content},
+		}}))
+	}
+	go set("1")
+	assert.Equal(t, "1", receive(t, ch).Items[0].Content)
+
+	// the layer is stopped without the LayerSubscriber, so the changes don't block
+	store.StopSubscribe()
+	set("2")
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, ch, 0)
+}
@@ -59,3 +59,43 @@ type Store interface {
 	// GetDefaultLabel returns default label
 	GetDefaultLabel() string
 }
+
+// HistoryStore is implemented by the configuration stores which keep the revisions of configuration items
+type HistoryStore interface {
+	Store
+
+	// GetHistory gets the prior revisions of a configuration item, latest first.
+	GetHistory(context.Context, *GetHistoryRequest) ([]*ConfigurationRevision, error)
+
+	// GetRevision gets a prior revision of a configuration item with its content.
+	GetRevision(context.Context, *GetRevisionRequest) (*ConfigurationRevision, error)
+
+	// Rollback restores a configuration item to a prior revision.
+	Rollback(context.Context, *RollbackRequest) error
+}
+
+// LayeredStore is implemented by the virtual configuration stores built on other configuration stores, e.g. the composite store.
+// The layers configured in StoreConfig.Layers are injected by the runtime before Init.
+type LayeredStore interface {
+	Store
+
+	// SetLayers sets the layers in the order of precedence.
+	SetLayers(layers []Store) error
+
+	// SetLayerSubscriber sets how the layers are subscribed.
+	SetLayerSubscriber(subscribe LayerSubscriber)
+}
+
+// LayerSubscriber subscribes the layer at the index on behalf of a LayeredStore, and returns the function stopping the subscription alone.
+// The runtime subscribes the layers through their subscription hubs, so the layers shared with other subscribers are stopped after the last one stops.
+type LayerSubscriber func(index int, req *SubscribeReq, ch chan *SubscribeResp) (stop func(), err error)
+
+// RevisionStore is implemented by the configuration stores which keep the revisions of changes natively, e.g. etcd.
+// The store sets SubscribeResp.Revision, so that a subscriber can catch up from a revision even if the runtime restarts.
+type RevisionStore interface {
+	Store
+
+	// GetChanges gets the latest items changed after the revision, and the current revision of the store.
+	// The items deleted are returned with empty content.
+	GetChanges(context.Context, *GetChangesRequest) ([]*ConfigurationItem, int64, error)
+}
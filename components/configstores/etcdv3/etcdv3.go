@@ -23,6 +23,7 @@ import (
 	"mosn.io/pkg/utils"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"mosn.io/pkg/log"
 
@@ -31,8 +32,9 @@ import (
 )
 
 const (
-	defaultGroup = "default"
-	defaultLabel = "default"
+	defaultGroup        = "default"
+	defaultLabel        = "default"
+	defaultHistoryLimit = 10
 )
 
 type EtcdV3ConfigStore struct {
@@ -173,8 +175,7 @@ func (c *EtcdV3ConfigStore) Delete(ctx context.Context, req *configstores.Delete
 }
 
 func (c *EtcdV3ConfigStore) processWatchResponse(resp *clientv3.WatchResponse) {
-	res := &configstores.SubscribeResp{StoreName: c.storeName, AppId: c.appIdKey}
-	item := &configstores.ConfigurationItem{}
+	res := &configstores.SubscribeResp{StoreName: c.storeName, AppId: c.appIdKey, Revision: resp.Header.Revision}
 	if len(resp.Events) == 0 {
 		return
 	}
@@ -186,6 +187,7 @@ func (c *EtcdV3ConfigStore) processWatchResponse(resp *clientv3.WatchResponse) {
 	for _, events := range resp.Events {
 		s := strings.Split(string(events.Kv.Key), "/")[1:]
 		if key, ok := c.subscribeKey[string(events.Kv.Key)]; ok {
+			item := &configstores.ConfigurationItem{}
 			item.Group = s[configstores.Group]
 			item.Label = s[configstores.Label]
 			item.Key = key
@@ -235,6 +237,63 @@ func (c *EtcdV3ConfigStore) StopSubscribe() {
 	close(c.watchRespCh)
 }
 
+// GetChanges compares the items at the revision with the current ones, and returns the items changed.
+// It fails if the revision is compacted.
+func (c *EtcdV3ConfigStore) GetChanges(ctx context.Context, req *configstores.GetChangesRequest) ([]*configstores.ConfigurationItem, int64, error) {
+	prefix := "/" + req.AppId + "/" + req.Group + "/" + req.Label + "/"
+	current, err := c.client.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		log.DefaultLogger.Errorf("get key[%+v] failed with error: %+v", prefix, err)
+		return nil, 0, err
+	}
+	previous, err := c.client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithRev(req.SinceRevision))
+	if err != nil {
+		log.DefaultLogger.Errorf("get key[%+v] at revision %d failed with error: %+v", prefix, req.SinceRevision, err)
+		return nil, 0, err
+	}
+	targetString := []string{req.AppId, req.Group, req.Label, configstores.All}
+	previousItems := make(map[string]*configstores.ConfigurationItem)
+	for _, item := range c.GetItemsFromAllKeys(previous.Kvs, targetString) {
+		previousItems[item.Key] = item
+	}
+	keys := make(map[string]struct{}, len(req.Keys))
+	for _, key := range req.Keys {
+		keys[key] = struct{}{}
+	}
+	subscribed := func(key string) bool {
+		_, ok := keys[key]
+		return len(keys) == 0 || ok
+	}
+	res := make([]*configstores.ConfigurationItem, 0)
+	for _, item := range c.GetItemsFromAllKeys(current.Kvs, targetString) {
+		old, ok := previousItems[item.Key]
+		delete(previousItems, item.Key)
+		if !subscribed(item.Key) || (ok && sameItem(old, item)) {
+			continue
+		}
+		res = append(res, item)
+	}
+	// the items left are deleted
+	for _, item := range previousItems {
+		if subscribed(item.Key) {
+			res = append(res, &configstores.ConfigurationItem{Group: item.Group, Label: item.Label, Key: item.Key})
+		}
+	}
+	return res, current.Header.Revision, nil
+}
+
+func sameItem(a *configstores.ConfigurationItem, b *configstores.ConfigurationItem) bool {
+	if a.Content != b.Content || len(a.Tags) != len(b.Tags) {
+		return false
+	}
+	for k, v := range a.Tags {
+		if tag, ok := b.Tags[k]; !ok || tag != v {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *EtcdV3ConfigStore) ParseKey(appId string, req *configstores.ConfigurationItem) []string {
 	res := make([]string, 0, len(req.Tags))
 	res = append(res, "/"+appId+"/"+req.Group+"/"+req.Label+"/"+req.Key)
@@ -243,3 +302,84 @@ func (c *EtcdV3ConfigStore) ParseKey(appId string, req *configstores.Configurati
 	}
 	return res
 }
+
+// GetHistory gets the prior revisions of a configuration item from the mvcc history of etcd, latest first.
+// The history stops at the revision the key was created, or the revision compacted.
+func (c *EtcdV3ConfigStore) GetHistory(ctx context.Context, req *configstores.GetHistoryRequest) ([]*configstores.ConfigurationRevision, error) {
+	key := "/" + req.AppId + "/" + req.Group + "/" + req.Label + "/" + req.Key
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	res := make([]*configstores.ConfigurationRevision, 0, limit)
+	var opts []clientv3.OpOption
+	for len(res) < limit {
+		resp, err := c.client.Get(ctx, key, opts...)
+		if err == rpctypes.ErrCompacted {
+			break
+		}
+		if err != nil {
+			log.DefaultLogger.Errorf("get history of key[%+v] failed with error: %+v", key, err)
+			return nil, err
+		}
+		if len(resp.Kvs) == 0 {
+			break
+		}
+		kv := resp.Kvs[0]
+		res = append(res, &configstores.ConfigurationRevision{
+			Revision: strconv.FormatInt(kv.ModRevision, 10),
+			Content:  string(kv.Value),
+			Metadata: map[string]string{"version": strconv.FormatInt(kv.Version, 10)},
+		})
+		// it's the first version
+		if kv.ModRevision <= kv.CreateRevision {
+			break
+		}
+		opts = []clientv3.OpOption{clientv3.WithRev(kv.ModRevision - 1)}
+	}
+	return res, nil
+}
+
+// GetRevision gets the content of a configuration item at the mod revision.
+func (c *EtcdV3ConfigStore) GetRevision(ctx context.Context, req *configstores.GetRevisionRequest) (*configstores.ConfigurationRevision, error) {
+	key := "/" + req.AppId + "/" + req.Group + "/" + req.Label + "/" + req.Key
+	rev, err := strconv.ParseInt(req.Revision, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid revision %s: %v", req.Revision, err)
+	}
+	resp, err := c.client.Get(ctx, key, clientv3.WithRev(rev))
+	if err != nil {
+		log.DefaultLogger.Errorf("get revision %d of key[%+v] failed with error: %+v", rev, key, err)
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 || resp.Kvs[0].ModRevision != rev {
+		return nil, fmt.Errorf("revision %d of key %s not found", rev, req.Key)
+	}
+	kv := resp.Kvs[0]
+	return &configstores.ConfigurationRevision{
+		Revision: req.Revision,
+		Content:  string(kv.Value),
+		Metadata: map[string]string{"version": strconv.FormatInt(kv.Version, 10)},
+	}, nil
+}
+
+// Rollback restores a configuration item to the content of the mod revision.
+func (c *EtcdV3ConfigStore) Rollback(ctx context.Context, req *configstores.RollbackRequest) error {
+	revision, err := c.GetRevision(ctx, &configstores.GetRevisionRequest{
+		AppId:    req.AppId,
+		Group:    req.Group,
+		Label:    req.Label,
+		Key:      req.Key,
+		Revision: req.Revision,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		return err
+	}
+	key := "/" + req.AppId + "/" + req.Group + "/" + req.Label + "/" + req.Key
+	_, err = c.client.Put(ctx, key, revision.Content)
+	if err != nil {
+		log.DefaultLogger.Errorf("rollback key[%+v] failed with error: %+v", key, err)
+	}
+	return err
+}
@@ -256,6 +256,95 @@ func (suite *ClientTestSuite) TestEtcd() {
 	suite.store.StopSubscribe()
 }
 
+func (suite *ClientTestSuite) TestHistory() {
+	historyStore := suite.store.(configstores.HistoryStore)
+	ctx := context.Background()
+	for _, content := range []string{"v1", "v2", "v3"} {
+		err := suite.store.Set(ctx, &configstores.SetRequest{
+			AppId: appId,
+			Items: []*configstores.ConfigurationItem{{Key: "history", Content: content, Group: defaultGroup, Label: defaultLabel}},
+		})
+		assert.Nil(suite.T(), err)
+	}
+	req := &configstores.GetHistoryRequest{AppId: appId, Group: defaultGroup, Label: defaultLabel, Key: "history"}
+	revisions, err := historyStore.GetHistory(ctx, req)
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), revisions, 3)
+	assert.Equal(suite.T(), "v3", revisions[0].Content)
+	assert.Equal(suite.T(), "v1", revisions[2].Content)
+	assert.Equal(suite.T(), "1", revisions[2].Metadata["version"])
+
+	req.Limit = 2
+	revisions, err = historyStore.GetHistory(ctx, req)
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), revisions, 2)
+	assert.Equal(suite.T(), "v2", revisions[1].Content)
+
+	revision, err := historyStore.GetRevision(ctx, &configstores.GetRevisionRequest{
+		AppId: appId, Group: defaultGroup, Label: defaultLabel, Key: "history", Revision: revisions[1].Revision,
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "v2", revision.Content)
+
+	// rollback to v2
+	err = historyStore.Rollback(ctx, &configstores.RollbackRequest{
+		AppId: appId, Group: defaultGroup, Label: defaultLabel, Key: "history", Revision: revisions[1].Revision,
+	})
+	assert.Nil(suite.T(), err)
+	items, err := suite.store.Get(ctx, &configstores.GetRequest{AppId: appId, Group: defaultGroup, Label: defaultLabel, Keys: []string{"history"}})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "v2", items[0].Content)
+
+	// the revision of other keys
+	err = historyStore.Rollback(ctx, &configstores.RollbackRequest{
+		AppId: appId, Group: defaultGroup, Label: defaultLabel, Key: "history", Revision: "1",
+	})
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *ClientTestSuite) TestChanges() {
+	revisionStore := suite.store.(configstores.RevisionStore)
+	ctx := context.Background()
+	set := func(key string, content string) {
+		err := suite.store.Set(ctx, &configstores.SetRequest{
+			AppId: appId,
+			Items: []*configstores.ConfigurationItem{{Key: key, Content: content, Group: "changes", Label: defaultLabel}},
+		})
+		assert.Nil(suite.T(), err)
+	}
+	set("a", "v1")
+	set("b", "v1")
+	req := &configstores.GetChangesRequest{AppId: appId, Group: "changes", Label: defaultLabel, SinceRevision: 1}
+	_, revision, err := revisionStore.GetChanges(ctx, req)
+	assert.Nil(suite.T(), err)
+
+	set("a", "v2")
+	set("c", "v1")
+	err = suite.store.Delete(ctx, &configstores.DeleteRequest{AppId: appId, Group: "changes", Label: defaultLabel, Keys: []string{"b"}})
+	assert.Nil(suite.T(), err)
+	req.SinceRevision = revision
+	items, current, err := revisionStore.GetChanges(ctx, req)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), revision+3, current)
+	assert.Len(suite.T(), items, 3)
+	contents := make(map[string]string)
+	for _, item := range items {
+		contents[item.Key] = item.Content
+	}
+	assert.Equal(suite.T(), map[string]string{"a": "v2", "b": "", "c": "v1"}, contents)
+
+	req.Keys = []string{"a"}
+	items, _, err = revisionStore.GetChanges(ctx, req)
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), items, 1)
+
+	// nothing changed
+	req.SinceRevision = current
+	items, _, err = revisionStore.GetChanges(ctx, req)
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), items, 0)
+}
+
 func TestClientSuite(t *testing.T) {
 	suite.Run(t, &ClientTestSuite{
 		etcdConfig: struct {
@@ -0,0 +1,293 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package in_memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"mosn.io/layotto/components/configstores"
+)
+
+const (
+	defaultGroup = "default"
+	defaultLabel = "default"
+)
+
+// InMemoryConfigStore keeps configuration items in memory, which is useful in unit tests and local development.
+// Items are identified by AppId/Group/Label/Key, and the subscribers are notified every time an item changes.
+type InMemoryConfigStore struct {
+	mu        sync.RWMutex
+	storeName string
+	// items keyed by itemKey
+	items       map[itemKey]*configstores.ConfigurationItem
+	subscribers []*subscriber
+	// done is closed when the subscribers are stopped, so that pending notifications are dropped
+	done chan struct{}
+}
+
+type itemKey struct {
+	appId string
+	group string
+	label string
+	key   string
+}
+
+type subscriber struct {
+	appId string
+	group string
+	label string
+	// keys subscribed. All the keys in the group and label are subscribed if it's empty
+	keys map[string]struct{}
+	ch   chan *configstores.SubscribeResp
+}
+
+func NewStore() configstores.Store {
+	return NewInMemoryConfigStore()
+}
+
+func NewInMemoryConfigStore() *InMemoryConfigStore {
+	return &InMemoryConfigStore{
+		items: make(map[itemKey]*configstores.ConfigurationItem),
+		done:  make(chan struct{}),
+	}
+}
+
+// Init init the configuration store.
+func (s *InMemoryConfigStore) Init(config *configstores.StoreConfig) error {
+	if config == nil {
+		return errors.New("configuration illegal:no config data")
+	}
+	s.storeName = config.StoreName
+	return nil
+}
+
+func (s *InMemoryConfigStore) GetDefaultGroup() string {
+	return defaultGroup
+}
+
+func (s *InMemoryConfigStore) GetDefaultLabel() string {
+	return defaultLabel
+}
+
+// Get gets configuration items of the group and label. All the items are returned if no key is specified.
+func (s *InMemoryConfigStore) Get(ctx context.Context, req *configstores.GetRequest) ([]*configstores.ConfigurationItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]*configstores.ConfigurationItem, 0, len(req.Keys))
+	if len(req.Keys) > 0 {
+		for _, key := range req.Keys {
+			if item, ok := s.items[itemKey{req.AppId, req.Group, req.Label, key}]; ok {
+				res = append(res, copyItem(item))
+			}
+		}
+		return res, nil
+	}
+	for k, item := range s.items {
+		if k.appId == req.AppId && match(req.Group, k.group) && match(req.Label, k.label) {
+			res = append(res, copyItem(item))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+	return res, nil
+}
+
+// Set saves configuration items and notifies the subscribers.
+func (s *InMemoryConfigStore) Set(ctx context.Context, req *configstores.SetRequest) error {
+	if req.AppId == "" {
+		return errors.New("params illegal:no AppId")
+	}
+	changed := make(map[itemKey]*configstores.ConfigurationItem, len(req.Items))
+	for _, item := range req.Items {
+		if item.Key == "" {
+			return errors.New("params illegal:no Key")
+		}
+		changed[itemKey{req.AppId, item.Group, item.Label, item.Key}] = copyItem(item)
+	}
+	s.apply(changed)
+	return nil
+}
+
+// Delete deletes configuration items and notifies the subscribers with empty content.
+func (s *InMemoryConfigStore) Delete(ctx context.Context, req *configstores.DeleteRequest) error {
+	changed := make(map[itemKey]*configstores.ConfigurationItem, len(req.Keys))
+	s.mu.RLock()
+	for _, key := range req.Keys {
+		k := itemKey{req.AppId, req.Group, req.Label, key}
+		if _, ok := s.items[k]; ok {
+			changed[k] = nil
+		}
+	}
+	s.mu.RUnlock()
+	s.apply(changed)
+	return nil
+}
+
+// Reset replaces all the configuration items with the items in snapshot, which are keyed by AppId,
+// and notifies the subscribers of the items changed or removed.
+func (s *InMemoryConfigStore) Reset(snapshot map[string][]*configstores.ConfigurationItem) {
+	changed := make(map[itemKey]*configstores.ConfigurationItem)
+	s.mu.RLock()
+	for appId, items := range snapshot {
+		for _, item := range items {
+			k := itemKey{appId, item.Group, item.Label, item.Key}
+			if old, ok := s.items[k]; !ok || !equal(old, item) {
+				changed[k] = copyItem(item)
+			}
+		}
+	}
+	for k := range s.items {
+		if !contains(snapshot[k.appId], k) {
+			changed[k] = nil
+		}
+	}
+	s.mu.RUnlock()
+	s.apply(changed)
+}
+
+// apply saves the changed items, or removes the items whose value is nil,
+// then notifies the subscribers outside the lock.
+func (s *InMemoryConfigStore) apply(changed map[itemKey]*configstores.ConfigurationItem) {
+	if len(changed) == 0 {
+		return
+	}
+	s.mu.Lock()
+	for k, item := range changed {
+		if item == nil {
+			delete(s.items, k)
+		} else {
+			s.items[k] = item
+		}
+	}
+	subscribers := s.subscribers
+	done := s.done
+	s.mu.Unlock()
+
+	for _, sub := range subscribers {
+		resp := &configstores.SubscribeResp{StoreName: s.storeName, AppId: sub.appId}
+		for k, item := range changed {
+			if !sub.match(k) {
+				continue
+			}
+			if item == nil {
+				// the content is empty if the item is removed
+				item = &configstores.ConfigurationItem{Group: k.group, Label: k.label, Key: k.key}
+			}
+			resp.Items = append(resp.Items, copyItem(item))
+		}
+		if len(resp.Items) == 0 {
+			continue
+		}
+		sort.Slice(resp.Items, func(i, j int) bool {
+			return resp.Items[i].Key < resp.Items[j].Key
+		})
+		select {
+		case sub.ch <- resp:
+		case <-done:
+			return
+		}
+	}
+}
+
+// Subscribe subscribes the updates of the keys. All the keys in the group and label are subscribed if no key is specified.
+func (s *InMemoryConfigStore) Subscribe(req *configstores.SubscribeReq, ch chan *configstores.SubscribeResp) error {
+	sub := &subscriber{
+		appId: req.AppId,
+		group: req.Group,
+		label: req.Label,
+		keys:  make(map[string]struct{}, len(req.Keys)),
+		ch:    ch,
+	}
+	for _, key := range req.Keys {
+		sub.keys[key] = struct{}{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers = append(s.subscribers, sub)
+	return nil
+}
+
+// StopSubscribe stops all the subscribers.
+func (s *InMemoryConfigStore) StopSubscribe() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers = nil
+	close(s.done)
+	s.done = make(chan struct{})
+}
+
+func (sub *subscriber) match(k itemKey) bool {
+	if sub.appId != k.appId || !match(sub.group, k.group) || !match(sub.label, k.label) {
+		return false
+	}
+	if len(sub.keys) == 0 {
+		return true
+	}
+	_, ok := sub.keys[k.key]
+	return ok
+}
+
+func match(pattern string, s string) bool {
+	return pattern == configstores.All || pattern == s
+}
+
+func contains(items []*configstores.ConfigurationItem, k itemKey) bool {
+	for _, item := range items {
+		if item.Group == k.group && item.Label == k.label && item.Key == k.key {
+			return true
+		}
+	}
+	return false
+}
+
+func equal(a *configstores.ConfigurationItem, b *configstores.ConfigurationItem) bool {
+	if a.Content != b.Content || len(a.Tags) != len(b.Tags) {
+		return false
+	}
+	for k, v := range a.Tags {
+		if tag, ok := b.Tags[k]; !ok || tag != v {
+			return false
+		}
+	}
+	return true
+}
+
+func copyItem(item *configstores.ConfigurationItem) *configstores.ConfigurationItem {
+	res := &configstores.ConfigurationItem{
+		Key:     item.Key,
+		Content: item.Content,
+		Group:   item.Group,
+		Label:   item.Label,
+	}
+	if item.Tags != nil {
+		res.Tags = make(map[string]string, len(item.Tags))
+		for k, v := range item.Tags {
+			res.Tags[k] = v
+		}
+	}
+	if item.Metadata != nil {
+		res.Metadata = make(map[string]string, len(item.Metadata))
+		for k, v := range item.Metadata {
+			res.Metadata[k] = v
+		}
+	}
+	return res
+}
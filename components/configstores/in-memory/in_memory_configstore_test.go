@@ -0,0 +1,142 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package in_memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/configstores"
+)
+
+func newStore(t *testing.T) *InMemoryConfigStore {
+	s := NewInMemoryConfigStore()
+	err := s.Init(&configstores.StoreConfig{StoreName: "in-memory"})
+	assert.Nil(t, err)
+	return s
+}
+
+func receive(t *testing.T, ch chan *configstores.SubscribeResp) *configstores.SubscribeResp {
+	select {
+	case resp := <-ch:
+		return resp
+	case <-time.After(time.Second):
+		t.Fatal("no update received")
+		return nil
+	}
+}
+
+func TestInMemoryConfigStore_GetSetDelete(t *testing.T) {
+	s := newStore(t)
+	ctx := context.Background()
+	err := s.Set(ctx, &configstores.SetRequest{AppId: "app1", Items: []*configstores.ConfigurationItem{
+		{Group: "default", Label: "default", Key: "k1", Content: "v1", Tags: map[string]string{"owner": "alice"}},
+		{Group: "default", Label: "default", Key: "k2", Content: "v2"},
+		{Group: "default", Label: "gray", Key: "k1", Content: "gray-v1"},
+	}})
+	assert.Nil(t, err)
+
+	items, err := s.Get(ctx, &configstores.GetRequest{AppId: "app1", Group: "default", Label: "default", Keys: []string{"k1", "k3"}})
+	assert.Nil(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "v1", items[0].Content)
+	assert.Equal(t, "alice", items[0].Tags["owner"])
+
+	items, err = s.Get(ctx, &configstores.GetRequest{AppId: "app1", Group: "default", Label: "default"})
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, "k1", items[0].Key)
+	assert.Equal(t, "k2", items[1].Key)
+
+	items, err = s.Get(ctx, &configstores.GetRequest{AppId: "app1", Group: "default", Label: configstores.All})
+	assert.Nil(t, err)
+	assert.Len(t, items, 3)
+
+	items, err = s.Get(ctx, &configstores.GetRequest{AppId: "app2", Group: "default", Label: "default"})
+	assert.Nil(t, err)
+	assert.Len(t, items, 0)
+
+	err = s.Delete(ctx, &configstores.DeleteRequest{AppId: "app1", Group: "default", Label: "default", Keys: []string{"k1"}})
+	assert.Nil(t, err)
+	items, err = s.Get(ctx, &configstores.GetRequest{AppId: "app1", Group: "default", Label: "default", Keys: []string{"k1"}})
+	assert.Nil(t, err)
+	assert.Len(t, items, 0)
+
+	err = s.Set(ctx, &configstores.SetRequest{Items: []*configstores.ConfigurationItem{{Key: "k1"}}})
+	assert.NotNil(t, err)
+}
+
+func TestInMemoryConfigStore_Subscribe(t *testing.T) {
+	s := newStore(t)
+	ctx := context.Background()
+	ch := make(chan *configstores.SubscribeResp)
+	err := s.Subscribe(&configstores.SubscribeReq{AppId: "app1", Group: "default", Label: "default", Keys: []string{"k1"}}, ch)
+	assert.Nil(t, err)
+
+	go s.Set(ctx, &configstores.SetRequest{AppId: "app1", Items: []*configstores.ConfigurationItem{
+		{Group: "default", Label: "default", Key: "k1", Content: "v1"},
+		{Group: "default", Label: "default", Key: "k2", Content: "v2"},
+	}})
+	resp := receive(t, ch)
+	assert.Equal(t, "in-memory", resp.StoreName)
+	assert.Equal(t, "app1", resp.AppId)
+	assert.Len(t, resp.Items, 1)
+	assert.Equal(t, "v1", resp.Items[0].Content)
+
+	go s.Delete(ctx, &configstores.DeleteRequest{AppId: "app1", Group: "default", Label: "default", Keys: []string{"k1"}})
+	resp = receive(t, ch)
+	assert.Len(t, resp.Items, 1)
+	assert.Equal(t, "k1", resp.Items[0].Key)
+	assert.Equal(t, "", resp.Items[0].Content)
+
+	// no more updates after the subscribers are stopped, and Set doesn't block
+	s.StopSubscribe()
+	err = s.Set(ctx, &configstores.SetRequest{AppId: "app1", Items: []*configstores.ConfigurationItem{
+		{Group: "default", Label: "default", Key: "k1", Content: "v1"},
+	}})
+	assert.Nil(t, err)
+}
+
+func TestInMemoryConfigStore_Reset(t *testing.T) {
+	s := newStore(t)
+	ctx := context.Background()
+	err := s.Set(ctx, &configstores.SetRequest{AppId: "app1", Items: []*configstores.ConfigurationItem{
+		{Group: "default", Label: "default", Key: "k1", Content: "v1"},
+		{Group: "default", Label: "default", Key: "k2", Content: "v2"},
+	}})
+	assert.Nil(t, err)
+	ch := make(chan *configstores.SubscribeResp)
+	err = s.Subscribe(&configstores.SubscribeReq{AppId: "app1", Group: "default", Label: "default"}, ch)
+	assert.Nil(t, err)
+
+	go s.Reset(map[string][]*configstores.ConfigurationItem{
+		"app1": {
+			{Group: "default", Label: "default", Key: "k1", Content: "v1"},
+			{Group: "default", Label: "default", Key: "k3", Content: "v3"},
+		},
+	})
+	resp := receive(t, ch)
+	// k1 is unchanged, k2 is removed and k3 is added
+	assert.Len(t, resp.Items, 2)
+	assert.Equal(t, "k2", resp.Items[0].Key)
+	assert.Equal(t, "", resp.Items[0].Content)
+	assert.Equal(t, "k3", resp.Items[1].Key)
+	assert.Equal(t, "v3", resp.Items[1].Content)
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"mosn.io/pkg/log"
+	"mosn.io/pkg/utils"
+
+	"mosn.io/layotto/components/configstores"
+	in_memory "mosn.io/layotto/components/configstores/in-memory"
+)
+
+const (
+	pathKey = "path"
+	// the files are reloaded after no more events happen in reloadDelay
+	reloadDelay = 100 * time.Millisecond
+)
+
+var errReadOnly = errors.New("local config store is read-only, please modify the files instead")
+
+// LocalConfigStore reads configuration items from the files in a directory, which are laid out as
+//
+//	<path>/<app_id>/<group>/<label>.yaml|yml|json|properties
+//
+// The files are watched, and the subscribers are notified when the items in the files change.
+type LocalConfigStore struct {
+	*in_memory.InMemoryConfigStore
+	path    string
+	watcher *fsnotify.Watcher
+	// timer delays the reload, so that a burst of events only triggers one reload
+	timer   *time.Timer
+	timerMu sync.Mutex
+}
+
+func NewStore() configstores.Store {
+	return &LocalConfigStore{InMemoryConfigStore: in_memory.NewInMemoryConfigStore()}
+}
+
+// Init loads the files and starts watching them.
+func (s *LocalConfigStore) Init(config *configstores.StoreConfig) error {
+	if err := s.InMemoryConfigStore.Init(config); err != nil {
+		return err
+	}
+	s.path = config.Metadata[pathKey]
+	if s.path == "" {
+		return errors.New("configuration illegal:no path")
+	}
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return errors.New("configuration illegal:path is not a directory")
+	}
+	s.watcher, err = fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := s.reload(); err != nil {
+		s.watcher.Close()
+		return err
+	}
+	utils.GoWithRecover(s.watch, nil)
+	return nil
+}
+
+// Set is not supported, because the files are the source of truth.
+func (s *LocalConfigStore) Set(ctx context.Context, req *configstores.SetRequest) error {
+	return errReadOnly
+}
+
+// Delete is not supported, because the files are the source of truth.
+func (s *LocalConfigStore) Delete(ctx context.Context, req *configstores.DeleteRequest) error {
+	return errReadOnly
+}
+
+func (s *LocalConfigStore) watch() {
+	for {
+		select {
+		case event, ok := <-s.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&fsnotify.Chmod == fsnotify.Chmod {
+				continue
+			}
+			log.DefaultLogger.Debugf("[configstores] [local] got event: %s", event)
+			s.scheduleReload()
+		case err, ok := <-s.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.DefaultLogger.Errorf("[configstores] [local] watch %s error: %v", s.path, err)
+		}
+	}
+}
+
+func (s *LocalConfigStore) scheduleReload() {
+	s.timerMu.Lock()
+	defer s.timerMu.Unlock()
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.timer = time.AfterFunc(reloadDelay, func() {
+		if err := s.reload(); err != nil {
+			log.DefaultLogger.Errorf("[configstores] [local] reload %s error: %v", s.path, err)
+		}
+	})
+}
+
+// reload reads all the files, watches the directories newly created,
+// and replaces the items in memory with the items in the files.
+func (s *LocalConfigStore) reload() error {
+	snapshot := make(map[string][]*configstores.ConfigurationItem)
+	if err := s.watcher.Add(s.path); err != nil {
+		return err
+	}
+	apps, err := os.ReadDir(s.path)
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		if !app.IsDir() {
+			continue
+		}
+		appDir := filepath.Join(s.path, app.Name())
+		if err := s.watcher.Add(appDir); err != nil {
+			return err
+		}
+		groups, err := os.ReadDir(appDir)
+		if err != nil {
+			return err
+		}
+		for _, group := range groups {
+			if !group.IsDir() {
+				continue
+			}
+			groupDir := filepath.Join(appDir, group.Name())
+			if err := s.watcher.Add(groupDir); err != nil {
+				return err
+			}
+			files, err := os.ReadDir(groupDir)
+			if err != nil {
+				return err
+			}
+			for _, file := range files {
+				ext := filepath.Ext(file.Name())
+				if file.IsDir() || parsers[ext] == nil {
+					continue
+				}
+				items, err := parseFile(filepath.Join(groupDir, file.Name()), ext)
+				if err != nil {
+					return err
+				}
+				label := strings.TrimSuffix(file.Name(), ext)
+				for _, item := range items {
+					item.Group = group.Name()
+					item.Label = label
+				}
+				snapshot[app.Name()] = append(snapshot[app.Name()], items...)
+			}
+		}
+	}
+	s.Reset(snapshot)
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/configstores"
+)
+
+func writeFile(t *testing.T, path string, content string) {
+	assert.Nil(t, os.MkdirAll(filepath.Dir(path), 0755))
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestLocalConfigStore(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "app1", "default", "default.yaml"), `
+k1: v1
+k2:
+  content: v2
+  tags:
+    owner: alice
+k3:
+  timeout: 3
+`)
+	writeFile(t, filepath.Join(dir, "app1", "default", "gray.json"), `{"k1": "gray-v1", "port": 8080}`)
+	writeFile(t, filepath.Join(dir, "app1", "db", "default.properties"), `
+# comments are skipped
+url=jdbc:mysql://localhost:3306
+url#owner=bob
+`)
+
+	store := NewStore()
+	err := store.Init(&configstores.StoreConfig{StoreName: "local", Metadata: map[string]string{"path": dir}})
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	t.Run("get", func(t *testing.T) {
+		items, err := store.Get(ctx, &configstores.GetRequest{AppId: "app1", Group: "default", Label: "default"})
+		assert.Nil(t, err)
+		assert.Len(t, items, 3)
+		assert.Equal(t, "v1", items[0].Content)
+		assert.Equal(t, "v2", items[1].Content)
+		assert.Equal(t, "alice", items[1].Tags["owner"])
+		assert.Equal(t, `{"timeout":3}`, items[2].Content)
+
+		items, err = store.Get(ctx, &configstores.GetRequest{AppId: "app1", Group: "default", Label: "gray", Keys: []string{"k1", "port"}})
+		assert.Nil(t, err)
+		assert.Len(t, items, 2)
+		assert.Equal(t, "gray-v1", items[0].Content)
+		assert.Equal(t, "8080", items[1].Content)
+
+		items, err = store.Get(ctx, &configstores.GetRequest{AppId: "app1", Group: "db", Label: "default", Keys: []string{"url"}})
+		assert.Nil(t, err)
+		assert.Len(t, items, 1)
+		assert.Equal(t, "jdbc:mysql://localhost:3306", items[0].Content)
+		assert.Equal(t, "bob", items[0].Tags["owner"])
+	})
+
+	t.Run("read only", func(t *testing.T) {
+		err := store.Set(ctx, &configstores.SetRequest{AppId: "app1", Items: []*configstores.ConfigurationItem{{Key: "k1"}}})
+		assert.Equal(t, errReadOnly, err)
+		err = store.Delete(ctx, &configstores.DeleteRequest{AppId: "app1", Keys: []string{"k1"}})
+		assert.Equal(t, errReadOnly, err)
+	})
+
+	t.Run("subscribe", func(t *testing.T) {
+		ch := make(chan *configstores.SubscribeResp)
+		err := store.Subscribe(&configstores.SubscribeReq{AppId: "app1", Group: "default", Label: "default", Keys: []string{"k1"}}, ch)
+		assert.Nil(t, err)
+		writeFile(t, filepath.Join(dir, "app1", "default", "default.yaml"), `
+k1: v1-new
+k2: v2
+`)
+		select {
+		case resp := <-ch:
+			assert.Equal(t, "local", resp.StoreName)
+			assert.Len(t, resp.Items, 1)
+			assert.Equal(t, "k1", resp.Items[0].Key)
+			assert.Equal(t, "v1-new", resp.Items[0].Content)
+		case <-time.After(3 * time.Second):
+			t.Fatal("no update received")
+		}
+		store.StopSubscribe()
+	})
+
+	t.Run("new directory", func(t *testing.T) {
+		writeFile(t, filepath.Join(dir, "app2", "default", "default.yml"), `k1: v1`)
+		assert.Eventually(t, func() bool {
+			items, err := store.Get(ctx, &configstores.GetRequest{AppId: "app2", Group: "default", Label: "default"})
+			return err == nil && len(items) == 1
+		}, 3*time.Second, 50*time.Millisecond)
+	})
+}
+
+func TestLocalConfigStore_Init(t *testing.T) {
+	store := NewStore()
+	err := store.Init(&configstores.StoreConfig{StoreName: "local", Metadata: map[string]string{}})
+	assert.NotNil(t, err)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "app1", "default", "default.json"), `{illegal`)
+	err = store.Init(&configstores.StoreConfig{StoreName: "local", Metadata: map[string]string{"path": dir}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"mosn.io/layotto/components/configstores"
+)
+
+const (
+	contentField = "content"
+	tagsField    = "tags"
+	// tagSeparator separates the key and the tag name in properties files, e.g. key#owner=alice
+	tagSeparator = "#"
+)
+
+// parser parses a file into a map from key to value
+type parser func(data []byte) (map[string]interface{}, error)
+
+var parsers = map[string]parser{
+	".yaml":       parseYaml,
+	".yml":        parseYaml,
+	".json":       parseJson,
+	".properties": parseProperties,
+}
+
+func parseYaml(data []byte) (map[string]interface{}, error) {
+	res := make(map[string]interface{})
+	err := yaml.Unmarshal(data, &res)
+	return res, err
+}
+
+func parseJson(data []byte) (map[string]interface{}, error) {
+	res := make(map[string]interface{})
+	if len(bytes.TrimSpace(data)) == 0 {
+		return res, nil
+	}
+	// keep the numbers as they are written
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err := decoder.Decode(&res)
+	return res, err
+}
+
+// parseProperties parses the lines in the format of key=value or key:value.
+// The tags of a key are in the format of key#tag=value.
+func parseProperties(data []byte) (map[string]interface{}, error) {
+	res := make(map[string]interface{})
+	tags := make(map[string]map[string]interface{})
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		i := strings.IndexAny(line, "=:")
+		if i < 0 {
+			return nil, fmt.Errorf("illegal line in properties: %s", line)
+		}
+		key := strings.TrimSpace(line[:i])
+		value := strings.TrimSpace(line[i+1:])
+		if j := strings.Index(key, tagSeparator); j > 0 {
+			k := key[:j]
+			if tags[k] == nil {
+				tags[k] = make(map[string]interface{})
+			}
+			tags[k][key[j+1:]] = value
+			continue
+		}
+		res[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for k, t := range tags {
+		content, _ := res[k].(string)
+		res[k] = map[string]interface{}{contentField: content, tagsField: t}
+	}
+	return res, nil
+}
+
+// parseFile reads the configuration items in a file. The value of a key is the content of the item,
+// unless it's an object with the content and tags fields, e.g.
+//
+//	key1: value1
+//	key2:
+//	  content: value2
+//	  tags:
+//	    owner: alice
+//
+// Other objects and arrays are encoded as json strings.
+func parseFile(path string, ext string) ([]*configstores.ConfigurationItem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	values, err := parsers[ext](data)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s error: %v", path, err)
+	}
+	items := make([]*configstores.ConfigurationItem, 0, len(values))
+	for key, value := range values {
+		item := &configstores.ConfigurationItem{Key: key}
+		obj, ok := value.(map[string]interface{})
+		if _, hasContent := obj[contentField]; ok && hasContent {
+			item.Content = toString(obj[contentField])
+			if tags, ok := obj[tagsField].(map[string]interface{}); ok {
+				item.Tags = make(map[string]string, len(tags))
+				for k, v := range tags {
+					item.Tags[k] = toString(v)
+				}
+			}
+		} else {
+			item.Content = toString(value)
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Key < items[j].Key
+	})
+	return items, nil
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		data, _ := json.Marshal(v)
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
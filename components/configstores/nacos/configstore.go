@@ -38,6 +38,8 @@ type ConfigStore struct {
 	appId       string
 	namespaceId string
 	listener    sync.Map
+	// history is nil in acm mode
+	history *historyClient
 }
 
 func NewStore() configstores.Store {
@@ -90,6 +92,7 @@ func (n *ConfigStore) Init(config *configstores.StoreConfig) (err error) {
 		client, err = n.initWithACM(timeoutMs, metadata)
 	} else {
 		client, err = n.init(config.Address, timeoutMs, metadata)
+		n.history = newHistoryClient(config.Address[0], time.Duration(timeout)*time.Second, metadata)
 	}
 	if err != nil {
 		return err
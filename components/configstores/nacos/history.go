@@ -0,0 +1,285 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nacos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/configstores"
+)
+
+const (
+	defaultHistoryLimit = 10
+	historyPath         = "/nacos/v1/cs/history"
+	loginPath           = "/nacos/v1/auth/login"
+	opTypeKey           = "opType"
+)
+
+var errHistoryNotSupported = errors.New("configuration history is not supported in acm mode")
+
+// historyClient queries the history of configurations through the open api of nacos,
+// because the nacos sdk doesn't support it.
+type historyClient struct {
+	address    string
+	namespace  string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+type historyItem struct {
+	Id               json.Number     `json:"id"`
+	DataId           string          `json:"dataId"`
+	Group            string          `json:"group"`
+	Content          string          `json:"content"`
+	OpType           string          `json:"opType"`
+	LastModifiedTime json.RawMessage `json:"lastModifiedTime"`
+}
+
+type historyPage struct {
+	PageItems []*historyItem `json:"pageItems"`
+}
+
+func newHistoryClient(address string, timeout time.Duration, metadata *Metadata) *historyClient {
+	return &historyClient{
+		address:    "http://" + address,
+		namespace:  metadata.NameSpaceId,
+		username:   metadata.Username,
+		password:   metadata.Password,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// list returns the latest limit revisions of the config, the newest first.
+func (h *historyClient) list(ctx context.Context, dataId string, group string, limit int) ([]*historyItem, error) {
+	params := url.Values{}
+	params.Set("search", "accurate")
+	params.Set("dataId", dataId)
+	params.Set("group", group)
+	params.Set("tenant", h.namespace)
+	params.Set("pageNo", "1")
+	params.Set("pageSize", strconv.Itoa(limit))
+	page := &historyPage{}
+	if err := h.get(ctx, params, page); err != nil {
+		return nil, err
+	}
+	return page.PageItems, nil
+}
+
+// detail returns a revision of the config with its content.
+func (h *historyClient) detail(ctx context.Context, dataId string, group string, nid string) (*historyItem, error) {
+	params := url.Values{}
+	params.Set("nid", nid)
+	params.Set("dataId", dataId)
+	params.Set("group", group)
+	params.Set("tenant", h.namespace)
+	item := &historyItem{}
+	if err := h.get(ctx, params, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (h *historyClient) get(ctx context.Context, params url.Values, out interface{}) error {
+	token, err := h.login(ctx)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		params.Set("accessToken", token)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.address+historyPath+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	return h.do(req, out)
+}
+
+// login gets an access token if the auth of nacos is enabled.
+func (h *historyClient) login(ctx context.Context) (string, error) {
+	if h.username == "" {
+		return "", nil
+	}
+	form := url.Values{}
+	form.Set("username", h.username)
+	form.Set("password", h.password)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.address+loginPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	result := &struct {
+		AccessToken string `json:"accessToken"`
+	}{}
+	if err := h.do(req, result); err != nil {
+		return "", err
+	}
+	return result.AccessToken, nil
+}
+
+func (h *historyClient) do(req *http.Request, out interface{}) error {
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("nacos responds %d: %s", resp.StatusCode, string(body))
+	}
+	return json.Unmarshal(body, out)
+}
+
+// parseModifiedTime parses the time in the open api,
+// which is a unix timestamp in milliseconds or a formatted string depending on the version of nacos.
+func parseModifiedTime(raw json.RawMessage) time.Time {
+	if len(raw) == 0 {
+		return time.Time{}
+	}
+	if ms, err := strconv.ParseInt(string(raw), 10, 64); err == nil {
+		return time.UnixMilli(ms)
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return time.Time{}
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.000-0700", "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// GetHistory gets the revisions of a config from the history of nacos, the newest first.
+func (n *ConfigStore) GetHistory(ctx context.Context, request *configstores.GetHistoryRequest) ([]*configstores.ConfigurationRevision, error) {
+	if n.history == nil {
+		return nil, errHistoryNotSupported
+	}
+	if request.Key == "" {
+		return nil, errParamsMissingField("Key")
+	}
+	if request.Group == "" {
+		return nil, errParamsMissingField("Group")
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+
+	items, err := n.history.list(ctx, request.Key, request.Group, limit)
+	if err != nil {
+		log.DefaultLogger.Errorf("get history of key[%+v] failed with error: %+v", request.Key, err)
+		return nil, err
+	}
+	res := make([]*configstores.ConfigurationRevision, 0, len(items))
+	for _, item := range items {
+		// the list api doesn't return the content
+		detail, err := n.history.detail(ctx, request.Key, request.Group, item.Id.String())
+		if err != nil {
+			log.DefaultLogger.Errorf("get revision[%+v] of key[%+v] failed with error: %+v", item.Id, request.Key, err)
+			return nil, err
+		}
+		res = append(res, &configstores.ConfigurationRevision{
+			Revision:   item.Id.String(),
+			Content:    detail.Content,
+			ModifiedAt: parseModifiedTime(item.LastModifiedTime),
+			Metadata:   map[string]string{opTypeKey: strings.TrimSpace(item.OpType)},
+		})
+	}
+	return res, nil
+}
+
+// GetRevision gets a revision in the history of nacos with its content.
+func (n *ConfigStore) GetRevision(ctx context.Context, request *configstores.GetRevisionRequest) (*configstores.ConfigurationRevision, error) {
+	if n.history == nil {
+		return nil, errHistoryNotSupported
+	}
+	if request.Key == "" {
+		return nil, errParamsMissingField("Key")
+	}
+	if request.Group == "" {
+		return nil, errParamsMissingField("Group")
+	}
+	if request.Revision == "" {
+		return nil, errParamsMissingField("Revision")
+	}
+
+	item, err := n.history.detail(ctx, request.Key, request.Group, request.Revision)
+	if err != nil {
+		log.DefaultLogger.Errorf("get revision[%+v] of key[%+v] failed with error: %+v", request.Revision, request.Key, err)
+		return nil, err
+	}
+	// make sure the revision belongs to the config
+	if item.DataId != request.Key || item.Group != request.Group {
+		return nil, IllegalParam
+	}
+	return &configstores.ConfigurationRevision{
+		Revision:   request.Revision,
+		Content:    item.Content,
+		ModifiedAt: parseModifiedTime(item.LastModifiedTime),
+		Metadata:   map[string]string{opTypeKey: strings.TrimSpace(item.OpType)},
+	}, nil
+}
+
+// Rollback publishes the content of a revision in the history of nacos as the latest one.
+func (n *ConfigStore) Rollback(ctx context.Context, request *configstores.RollbackRequest) error {
+	if n.history == nil {
+		return errHistoryNotSupported
+	}
+	if request.AppId == "" {
+		return errParamsMissingField("AppId")
+	}
+
+	revision, err := n.GetRevision(ctx, &configstores.GetRevisionRequest{
+		AppId:    request.AppId,
+		Group:    request.Group,
+		Label:    request.Label,
+		Key:      request.Key,
+		Revision: request.Revision,
+		Metadata: request.Metadata,
+	})
+	if err != nil {
+		return err
+	}
+	ok, err := n.client.PublishConfig(vo.ConfigParam{
+		DataId:  request.Key,
+		Group:   request.Group,
+		AppName: request.AppId,
+		Content: revision.Content,
+	})
+	if err != nil {
+		log.DefaultLogger.Errorf("rollback key[%+v] to revision[%+v] failed with error: %+v", request.Key, request.Revision, err)
+		return err
+	}
+	if !ok {
+		return IllegalParam
+	}
+	return nil
+}
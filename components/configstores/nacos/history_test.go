@@ -0,0 +1,177 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nacos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/configstores"
+)
+
+func newHistoryServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case loginPath:
+			assert.Equal(t, "nacos", r.FormValue("username"))
+			w.Write([]byte(`{"accessToken":"token","tokenTtl":18000}`))
+		case historyPath:
+			assert.Equal(t, "token", r.URL.Query().Get("accessToken"))
+			assert.Equal(t, "key", r.URL.Query().Get("dataId"))
+			assert.Equal(t, "group", r.URL.Query().Get("group"))
+			switch r.URL.Query().Get("nid") {
+			case "":
+				assert.Equal(t, "2", r.URL.Query().Get("pageSize"))
+				w.Write([]byte(`{"totalCount":2,"pageNumber":1,"pagesAvailable":1,"pageItems":[
+{"id":"12","dataId":"key","group":"group","content":null,"opType":"U         ","lastModifiedTime":1672531200000},
+{"id":"11","dataId":"key","group":"group","content":null,"opType":"I         ","lastModifiedTime":"2022-12-31T00:00:00.000+08:00"}]}`))
+			case "12":
+				w.Write([]byte(`{"id":12,"dataId":"key","group":"group","content":"v2","opType":"U"}`))
+			case "11":
+				w.Write([]byte(`{"id":11,"dataId":"key","group":"group","content":"v1","opType":"I"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`history not found`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func setupHistory(t *testing.T, client Client, server *httptest.Server) *ConfigStore {
+	store := setup(t, client)
+	store.history = newHistoryClient(strings.TrimPrefix(server.URL, "http://"), time.Second, &Metadata{Username: "nacos", Password: "nacos"})
+	return store
+}
+
+func TestNacosConfigStore_GetHistory(t *testing.T) {
+	server := newHistoryServer(t)
+	defer server.Close()
+	store := setupHistory(t, nil, server)
+
+	t.Run("get history", func(t *testing.T) {
+		revisions, err := store.GetHistory(context.Background(), &configstores.GetHistoryRequest{
+			Group: "group",
+			Key:   "key",
+			Limit: 2,
+		})
+		assert.Nil(t, err)
+		assert.Len(t, revisions, 2)
+		assert.Equal(t, "12", revisions[0].Revision)
+		assert.Equal(t, "v2", revisions[0].Content)
+		assert.Equal(t, int64(1672531200000), revisions[0].ModifiedAt.UnixMilli())
+		assert.Equal(t, "U", revisions[0].Metadata[opTypeKey])
+		assert.Equal(t, "11", revisions[1].Revision)
+		assert.Equal(t, "v1", revisions[1].Content)
+		assert.Equal(t, int64(1672416000000), revisions[1].ModifiedAt.UnixMilli())
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := store.GetHistory(context.Background(), &configstores.GetHistoryRequest{Group: "group"})
+		assert.Equal(t, errParamsMissingField("Key"), err)
+	})
+
+	t.Run("acm mode", func(t *testing.T) {
+		acm := setup(t, nil)
+		acm.history = nil
+		_, err := acm.GetHistory(context.Background(), &configstores.GetHistoryRequest{Group: "group", Key: "key"})
+		assert.Equal(t, errHistoryNotSupported, err)
+	})
+}
+
+func TestNacosConfigStore_GetRevision(t *testing.T) {
+	server := newHistoryServer(t)
+	defer server.Close()
+	store := setupHistory(t, nil, server)
+
+	t.Run("get revision", func(t *testing.T) {
+		revision, err := store.GetRevision(context.Background(), &configstores.GetRevisionRequest{
+			Group:    "group",
+			Key:      "key",
+			Revision: "11",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "11", revision.Revision)
+		assert.Equal(t, "v1", revision.Content)
+		assert.Equal(t, "I", revision.Metadata[opTypeKey])
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		_, err := store.GetRevision(context.Background(), &configstores.GetRevisionRequest{
+			Group:    "group",
+			Key:      "key",
+			Revision: "10",
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("missing revision", func(t *testing.T) {
+		_, err := store.GetRevision(context.Background(), &configstores.GetRevisionRequest{Group: "group", Key: "key"})
+		assert.Equal(t, errParamsMissingField("Revision"), err)
+	})
+}
+
+func TestNacosConfigStore_Rollback(t *testing.T) {
+	server := newHistoryServer(t)
+	defer server.Close()
+
+	t.Run("rollback success", func(t *testing.T) {
+		mockClient := getMockNacosClient(t)
+		mockClient.EXPECT().PublishConfig(gomock.Eq(vo.ConfigParam{
+			DataId:  "key",
+			Group:   "group",
+			AppName: appName,
+			Content: "v1",
+		})).Return(true, nil)
+		store := setupHistory(t, mockClient, server)
+
+		err := store.Rollback(context.Background(), &configstores.RollbackRequest{
+			AppId:    appName,
+			Group:    "group",
+			Key:      "key",
+			Revision: "11",
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		store := setupHistory(t, getMockNacosClient(t), server)
+		err := store.Rollback(context.Background(), &configstores.RollbackRequest{
+			AppId:    appName,
+			Group:    "group",
+			Key:      "key",
+			Revision: "10",
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("missing revision", func(t *testing.T) {
+		store := setupHistory(t, getMockNacosClient(t), server)
+		err := store.Rollback(context.Background(), &configstores.RollbackRequest{
+			AppId: appName,
+			Group: "group",
+			Key:   "key",
+		})
+		assert.Equal(t, errParamsMissingField("Revision"), err)
+	})
+}
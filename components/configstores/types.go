@@ -16,6 +16,11 @@
 
 package configstores
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // StoreConfig wraps configuration for a store implementation
 type StoreConfig struct {
 	Type      string            `json:"type"`
@@ -24,6 +29,11 @@ type StoreConfig struct {
 	Address   []string          `json:"address"`
 	TimeOut   string            `json:"timeout"`
 	Metadata  map[string]string `json:"metadata"`
+	// Schemas are the JSON Schemas of the configuration contents keyed by configuration key.
+	// The contents are validated by the runtime, and it's transparent to the store.
+	Schemas map[string]json.RawMessage `json:"schemas,omitempty"`
+	// Layers are the names of the config stores layered by a LayeredStore, in the order of precedence.
+	Layers []string `json:"layers,omitempty"`
 }
 
 // GetRequest is the object describing a get configuration request
@@ -75,4 +85,57 @@ type SubscribeResp struct {
 	StoreName string
 	AppId     string
 	Items     []*ConfigurationItem
+	// Revision is the revision of the store after the change. It's only set by the RevisionStore.
+	Revision int64
+}
+
+// GetChangesRequest is the object describing a request getting the items changed after a revision
+type GetChangesRequest struct {
+	AppId string
+	Group string
+	Label string
+	// Keys are all the keys in the group and label if empty
+	Keys          []string
+	SinceRevision int64
+	Metadata      map[string]string
+}
+
+// GetHistoryRequest is the object describing a get configuration history request
+type GetHistoryRequest struct {
+	AppId    string
+	Group    string
+	Label    string
+	Key      string
+	Limit    int32
+	Metadata map[string]string
+}
+
+// ConfigurationRevision represents a revision of a configuration item.
+type ConfigurationRevision struct {
+	// Revision is the revision id specific to the store
+	Revision string
+	Content  string
+	// ModifiedAt is zero if the store doesn't record it
+	ModifiedAt time.Time
+	Metadata   map[string]string
+}
+
+// GetRevisionRequest is the object describing a request to get a revision of a configuration item
+type GetRevisionRequest struct {
+	AppId    string
+	Group    string
+	Label    string
+	Key      string
+	Revision string
+	Metadata map[string]string
+}
+
+// RollbackRequest is the object describing a rollback configuration request
+type RollbackRequest struct {
+	AppId    string
+	Group    string
+	Label    string
+	Key      string
+	Revision string
+	Metadata map[string]string
 }
@@ -21,7 +21,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 
@@ -32,6 +34,8 @@ import (
 
 const (
 	storageTypeKey = "storageType"
+	// copyPartSize is the part size of the multipart copy
+	copyPartSize int64 = 100 * 1024 * 1024
 )
 
 // AliyunFile is a binding for an AliCloud OSS storage bucketKey
@@ -99,6 +103,47 @@ func (s *AliyunFile) Get(ctx context.Context, st *file.GetFileStu) (io.ReadClose
 	return bucket.GetObject(fileNameWithoutBucket)
 }
 
+// GetRange gets a range of the object, or nothing if it is not modified.
+func (s *AliyunFile) GetRange(ctx context.Context, st *file.GetRangeRequest) (*file.GetRangeResp, error) {
+	bucket, err := s.getBucket(st.FileName, st.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("get file[%s] fail, err: %s", st.FileName, err.Error())
+	}
+	fileNameWithoutBucket, err := util.GetFileName(st.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("get file[%s] fail, err: %s", st.FileName, err.Error())
+	}
+
+	options := make([]oss.Option, 0)
+	if r := util.FormatRange(st.Offset, st.Length); r != "" {
+		options = append(options, oss.NormalizedRange(strings.TrimPrefix(r, "bytes=")))
+	}
+	if st.IfNoneMatch != "" {
+		options = append(options, oss.IfNoneMatch(st.IfNoneMatch))
+	}
+	if !st.IfModifiedSince.IsZero() {
+		options = append(options, oss.IfModifiedSince(st.IfModifiedSince))
+	}
+	result, err := bucket.DoGetObject(&oss.GetObjectRequest{ObjectKey: fileNameWithoutBucket}, options)
+	if err != nil {
+		if serviceErr, ok := err.(oss.ServiceError); ok {
+			switch serviceErr.StatusCode {
+			case http.StatusNotModified:
+				return &file.GetRangeResp{ETag: st.IfNoneMatch, NotModified: true}, nil
+			case http.StatusRequestedRangeNotSatisfiable:
+				return nil, file.ErrRange
+			}
+		}
+		return nil, err
+	}
+	contentLength, _ := strconv.ParseInt(result.Response.Headers.Get(oss.HTTPHeaderContentLength), 10, 64)
+	return &file.GetRangeResp{
+		DataStream: result.Response.Body,
+		ETag:       result.Response.Headers.Get(oss.HTTPHeaderEtag),
+		Size:       util.GetTotalSize(result.Response.Headers.Get("Content-Range"), contentLength),
+	}, nil
+}
+
 func (s *AliyunFile) List(ctx context.Context, request *file.ListRequest) (*file.ListResp, error) {
 	bucket, err := s.getBucket(request.DirectoryName, request.Metadata)
 	if err != nil {
@@ -182,6 +227,183 @@ func (s *AliyunFile) Stat(ctx context.Context, request *file.FileMetaRequest) (*
 	return resp, nil
 }
 
+// Copy copies the object by server-side multipart copy, so the object larger than 1GB is supported.
+func (s *AliyunFile) Copy(ctx context.Context, request *file.CopyRequest) error {
+	srcBucketName, err := util.GetBucketName(request.SourceName)
+	if err != nil {
+		return fmt.Errorf("copy file[%s] fail, err: %s", request.SourceName, err.Error())
+	}
+	srcKey, err := util.GetFileName(request.SourceName)
+	if err != nil {
+		return fmt.Errorf("copy file[%s] fail, err: %s", request.SourceName, err.Error())
+	}
+	bucket, err := s.getBucket(request.TargetName, request.Metadata)
+	if err != nil {
+		return fmt.Errorf("copy file[%s] fail, err: %s", request.TargetName, err.Error())
+	}
+	dstKey, err := util.GetFileName(request.TargetName)
+	if err != nil {
+		return fmt.Errorf("copy file[%s] fail, err: %s", request.TargetName, err.Error())
+	}
+	err = bucket.CopyFile(srcBucketName, srcKey, dstKey, copyPartSize)
+	if err != nil {
+		if serviceErr, ok := err.(oss.ServiceError); ok && serviceErr.StatusCode == http.StatusNotFound {
+			return file.ErrNotExist
+		}
+		return fmt.Errorf("copy file[%s] fail, err: %s", request.SourceName, err.Error())
+	}
+	return nil
+}
+
+// Rename copies the object to the target and then deletes the source.
+func (s *AliyunFile) Rename(ctx context.Context, request *file.RenameRequest) error {
+	err := s.Copy(ctx, &file.CopyRequest{SourceName: request.SourceName, TargetName: request.TargetName, Metadata: request.Metadata})
+	if err != nil {
+		return err
+	}
+	return s.Del(ctx, &file.DelRequest{FileName: request.SourceName, Metadata: request.Metadata})
+}
+
+// InitiateUpload creates a multipart upload.
+func (s *AliyunFile) InitiateUpload(ctx context.Context, request *file.InitiateUploadRequest) (*file.InitiateUploadResp, error) {
+	bucket, err := s.getBucket(request.FileName, request.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("initiate upload file[%s] fail, err: %s", request.FileName, err.Error())
+	}
+	fileNameWithoutBucket, err := util.GetFileName(request.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("initiate upload file[%s] fail, err: %s", request.FileName, err.Error())
+	}
+	imur, err := bucket.InitiateMultipartUpload(fileNameWithoutBucket)
+	if err != nil {
+		return nil, fmt.Errorf("initiate upload file[%s] fail, err: %s", request.FileName, err.Error())
+	}
+	return &file.InitiateUploadResp{UploadId: imur.UploadID}, nil
+}
+
+// UploadPart uploads the part as the next part of the multipart upload.
+func (s *AliyunFile) UploadPart(ctx context.Context, request *file.UploadPartRequest) (*file.UploadStatusResp, error) {
+	bucket, imur, err := s.getUpload(request.FileName, request.UploadId, request.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("upload part of file[%s] fail, err: %s", request.FileName, err.Error())
+	}
+	// the committed size is summed from the part sizes, so the size must be known
+	if request.Size <= 0 {
+		return nil, file.ErrPartSize
+	}
+	parts, err := s.listParts(bucket, imur)
+	if err != nil {
+		return nil, err
+	}
+	committed := committedSize(parts)
+	if request.Offset != committed {
+		return nil, file.ErrOffset
+	}
+	_, err = bucket.UploadPart(imur, request.DataStream, request.Size, len(parts)+1)
+	if err != nil {
+		return nil, fmt.Errorf("upload part of file[%s] fail, err: %s", request.FileName, err.Error())
+	}
+	return &file.UploadStatusResp{CommittedSize: committed + request.Size}, nil
+}
+
+// GetUploadStatus sums the sizes of the parts uploaded.
+func (s *AliyunFile) GetUploadStatus(ctx context.Context, request *file.UploadRequest) (*file.UploadStatusResp, error) {
+	bucket, imur, err := s.getUpload(request.FileName, request.UploadId, request.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("get upload status of file[%s] fail, err: %s", request.FileName, err.Error())
+	}
+	parts, err := s.listParts(bucket, imur)
+	if err != nil {
+		return nil, err
+	}
+	return &file.UploadStatusResp{CommittedSize: committedSize(parts)}, nil
+}
+
+// CompleteUpload completes the multipart upload with all the parts uploaded.
+func (s *AliyunFile) CompleteUpload(ctx context.Context, request *file.UploadRequest) error {
+	bucket, imur, err := s.getUpload(request.FileName, request.UploadId, request.Metadata)
+	if err != nil {
+		return fmt.Errorf("complete upload file[%s] fail, err: %s", request.FileName, err.Error())
+	}
+	parts, err := s.listParts(bucket, imur)
+	if err != nil {
+		return err
+	}
+	completed := make([]oss.UploadPart, 0, len(parts))
+	for _, part := range parts {
+		completed = append(completed, oss.UploadPart{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+	_, err = bucket.CompleteMultipartUpload(imur, completed)
+	if err != nil {
+		return fmt.Errorf("complete upload file[%s] fail, err: %s", request.FileName, err.Error())
+	}
+	return nil
+}
+
+// AbortUpload aborts the multipart upload, and the parts uploaded are removed.
+func (s *AliyunFile) AbortUpload(ctx context.Context, request *file.UploadRequest) error {
+	bucket, imur, err := s.getUpload(request.FileName, request.UploadId, request.Metadata)
+	if err != nil {
+		return fmt.Errorf("abort upload file[%s] fail, err: %s", request.FileName, err.Error())
+	}
+	err = bucket.AbortMultipartUpload(imur)
+	if isNoSuchUpload(err) {
+		return file.ErrNotExist
+	}
+	return err
+}
+
+func (s *AliyunFile) getUpload(fileName string, uploadId string, metaData map[string]string) (*oss.Bucket, oss.InitiateMultipartUploadResult, error) {
+	imur := oss.InitiateMultipartUploadResult{UploadID: uploadId}
+	bucket, err := s.getBucket(fileName, metaData)
+	if err != nil {
+		return nil, imur, err
+	}
+	fileNameWithoutBucket, err := util.GetFileName(fileName)
+	if err != nil {
+		return nil, imur, err
+	}
+	imur.Bucket = bucket.BucketName
+	imur.Key = fileNameWithoutBucket
+	return bucket, imur, nil
+}
+
+// listParts lists all the parts uploaded, in the order of part number.
+func (s *AliyunFile) listParts(bucket *oss.Bucket, imur oss.InitiateMultipartUploadResult) ([]oss.UploadedPart, error) {
+	parts := make([]oss.UploadedPart, 0)
+	marker := 0
+	for {
+		result, err := bucket.ListUploadedParts(imur, oss.PartNumberMarker(marker))
+		if err != nil {
+			if isNoSuchUpload(err) {
+				return nil, file.ErrNotExist
+			}
+			return nil, fmt.Errorf("list parts of upload[%s] fail, err: %s", imur.UploadID, err.Error())
+		}
+		parts = append(parts, result.UploadedParts...)
+		if !result.IsTruncated {
+			return parts, nil
+		}
+		marker, err = strconv.Atoi(result.NextPartNumberMarker)
+		if err != nil {
+			return nil, fmt.Errorf("list parts of upload[%s] fail, err: %s", imur.UploadID, err.Error())
+		}
+	}
+}
+
+func committedSize(parts []oss.UploadedPart) int64 {
+	var size int64
+	for _, part := range parts {
+		size += int64(part.Size)
+	}
+	return size
+}
+
+func isNoSuchUpload(err error) bool {
+	serviceErr, ok := err.(oss.ServiceError)
+	return ok && serviceErr.Code == "NoSuchUpload"
+}
+
 func (s *AliyunFile) checkMetadata(m *utils.OssMetadata) bool {
 	if m.AccessKeySecret == "" || m.Endpoint == "" || m.AccessKeyID == "" {
 		return false
@@ -19,6 +19,7 @@ package aliyun
 import (
 	"context"
 	"io"
+	"strings"
 	"testing"
 
 	"mosn.io/layotto/components/pkg/utils"
@@ -205,3 +206,27 @@ func TestDel(t *testing.T) {
 	err = oss.Del(context.Background(), req)
 	assert.Error(t, err)
 }
+
+func TestResumableUpload(t *testing.T) {
+	fc := file.FileConfig{}
+	oss := NewAliyunFile()
+	fc.Metadata = []byte(data)
+	err := oss.Init(context.TODO(), &fc)
+	assert.Nil(t, err)
+	ac := oss.(*AliyunFile)
+
+	_, err = ac.InitiateUpload(context.Background(), &file.InitiateUploadRequest{FileName: fileName})
+	assert.Error(t, err)
+
+	_, imur, err := ac.getUpload("bbbb/a.txt", "id", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "bbbb", imur.Bucket)
+	assert.Equal(t, "a.txt", imur.Key)
+	assert.Equal(t, "id", imur.UploadID)
+
+	_, err = ac.UploadPart(context.Background(), &file.UploadPartRequest{FileName: "bbbb/a.txt", UploadId: "id", DataStream: strings.NewReader("aaaa")})
+	assert.Equal(t, file.ErrPartSize, err)
+
+	err = ac.CompleteUpload(context.Background(), &file.UploadRequest{FileName: "/", UploadId: "id"})
+	assert.Equal(t, err.Error(), "complete upload file[/] fail, err: invalid fileName format")
+}
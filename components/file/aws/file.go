@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"strings"
 
 	"mosn.io/layotto/components/pkg/utils"
@@ -31,6 +33,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"mosn.io/layotto/components/file"
 	"mosn.io/layotto/components/file/util"
@@ -38,6 +41,9 @@ import (
 
 const (
 	defaultCredentialsSource = "provider"
+	// maxCopySize is the max size of the object copied by a single CopyObject
+	maxCopySize  int64 = 5 << 30
+	copyPartSize int64 = 1 << 30
 )
 
 // AwsOss is a binding for aws oss storage.
@@ -151,6 +157,53 @@ func (a *AwsOss) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser, e
 	return ob.Body, nil
 }
 
+// GetRange gets a range of the object from aws oss, or nothing if it is not modified.
+func (a *AwsOss) GetRange(ctx context.Context, st *file.GetRangeRequest) (*file.GetRangeResp, error) {
+	bucket, err := util.GetBucketName(st.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("aws.s3 get file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	key, err := util.GetFileName(st.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("aws.s3 get file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	input := &s3.GetObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	}
+	if r := util.FormatRange(st.Offset, st.Length); r != "" {
+		input.Range = &r
+	}
+	if st.IfNoneMatch != "" {
+		input.IfNoneMatch = &st.IfNoneMatch
+	}
+	if !st.IfModifiedSince.IsZero() {
+		input.IfModifiedSince = &st.IfModifiedSince
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return nil, err
+	}
+	ob, err := client.GetObject(context.TODO(), input)
+	if err != nil {
+		var re interface{ HTTPStatusCode() int }
+		if errors.As(err, &re) {
+			switch re.HTTPStatusCode() {
+			case http.StatusNotModified:
+				return &file.GetRangeResp{ETag: st.IfNoneMatch, NotModified: true}, nil
+			case http.StatusRequestedRangeNotSatisfiable:
+				return nil, file.ErrRange
+			}
+		}
+		return nil, err
+	}
+	return &file.GetRangeResp{
+		DataStream: ob.Body,
+		ETag:       aws.ToString(ob.ETag),
+		Size:       util.GetTotalSize(aws.ToString(ob.ContentRange), ob.ContentLength),
+	}, nil
+}
+
 // List objects from aws oss.
 func (a *AwsOss) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
 	bucket, err := util.GetBucketName(st.DirectoryName)
@@ -240,3 +293,245 @@ func (a *AwsOss) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.File
 	}
 	return resp, nil
 }
+
+// Copy object in aws oss by server-side copy, and the object larger than 5GB is copied in parts.
+func (a *AwsOss) Copy(ctx context.Context, req *file.CopyRequest) error {
+	srcBucket, srcKey, err := a.parseFileName(req.SourceName)
+	if err != nil {
+		return fmt.Errorf("aws.s3 copy file[%s] fail,err: %s", req.SourceName, err.Error())
+	}
+	dstBucket, dstKey, err := a.parseFileName(req.TargetName)
+	if err != nil {
+		return fmt.Errorf("aws.s3 copy file[%s] fail,err: %s", req.TargetName, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return err
+	}
+	head, err := client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: &srcBucket, Key: &srcKey})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return file.ErrNotExist
+		}
+		return fmt.Errorf("aws.s3 copy file[%s] fail,err: %s", req.SourceName, err.Error())
+	}
+	source := url.PathEscape(srcBucket + "/" + srcKey)
+	if head.ContentLength <= maxCopySize {
+		_, err = client.CopyObject(ctx, &s3.CopyObjectInput{Bucket: &dstBucket, Key: &dstKey, CopySource: &source})
+	} else {
+		err = a.copyInParts(ctx, client, source, head.ContentLength, dstBucket, dstKey)
+	}
+	if err != nil {
+		return fmt.Errorf("aws.s3 copy file[%s] fail,err: %s", req.SourceName, err.Error())
+	}
+	return nil
+}
+
+// Rename object in aws oss, which is a copy followed by deleting the source.
+func (a *AwsOss) Rename(ctx context.Context, req *file.RenameRequest) error {
+	err := a.Copy(ctx, &file.CopyRequest{SourceName: req.SourceName, TargetName: req.TargetName, Metadata: req.Metadata})
+	if err != nil {
+		return err
+	}
+	return a.Del(ctx, &file.DelRequest{FileName: req.SourceName, Metadata: req.Metadata})
+}
+
+// copyInParts copies the source object by multipart upload, as CopyObject is limited to 5GB.
+func (a *AwsOss) copyInParts(ctx context.Context, client *s3.Client, source string, size int64, bucket string, key string) error {
+	out, err := client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{Bucket: &bucket, Key: &key})
+	if err != nil {
+		return err
+	}
+	completed := make([]types.CompletedPart, 0, size/copyPartSize+1)
+	for offset := int64(0); offset < size; offset += copyPartSize {
+		end := offset + copyPartSize - 1
+		if end >= size {
+			end = size - 1
+		}
+		copyRange := fmt.Sprintf("bytes=%d-%d", offset, end)
+		partNumber := int32(len(completed) + 1)
+		part, err := client.UploadPartCopy(ctx, &s3.UploadPartCopyInput{
+			Bucket:          &bucket,
+			Key:             &key,
+			UploadId:        out.UploadId,
+			PartNumber:      partNumber,
+			CopySource:      &source,
+			CopySourceRange: &copyRange,
+		})
+		if err != nil {
+			client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{Bucket: &bucket, Key: &key, UploadId: out.UploadId})
+			return err
+		}
+		completed = append(completed, types.CompletedPart{ETag: part.CopyPartResult.ETag, PartNumber: partNumber})
+	}
+	_, err = client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          &bucket,
+		Key:             &key,
+		UploadId:        out.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	return err
+}
+
+// InitiateUpload creates a multipart upload.
+func (a *AwsOss) InitiateUpload(ctx context.Context, req *file.InitiateUploadRequest) (*file.InitiateUploadResp, error) {
+	bucket, key, err := a.parseFileName(req.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("aws.s3 initiate upload file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return nil, err
+	}
+	out, err := client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{Bucket: &bucket, Key: &key})
+	if err != nil {
+		return nil, fmt.Errorf("aws.s3 initiate upload file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	return &file.InitiateUploadResp{UploadId: *out.UploadId}, nil
+}
+
+// UploadPart uploads the part as the next part of the multipart upload.
+func (a *AwsOss) UploadPart(ctx context.Context, req *file.UploadPartRequest) (*file.UploadStatusResp, error) {
+	bucket, key, err := a.parseFileName(req.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("aws.s3 upload part of file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return nil, err
+	}
+	// the committed size is summed from the part sizes, so the size must be known
+	if req.Size <= 0 {
+		return nil, file.ErrPartSize
+	}
+	parts, err := a.listParts(ctx, client, bucket, key, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	committed := committedSize(parts)
+	if req.Offset != committed {
+		return nil, file.ErrOffset
+	}
+	_, err = client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:        &bucket,
+		Key:           &key,
+		UploadId:      &req.UploadId,
+		PartNumber:    int32(len(parts) + 1),
+		Body:          req.DataStream,
+		ContentLength: req.Size,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aws.s3 upload part of file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	return &file.UploadStatusResp{CommittedSize: committed + req.Size}, nil
+}
+
+// GetUploadStatus sums the sizes of the parts uploaded.
+func (a *AwsOss) GetUploadStatus(ctx context.Context, req *file.UploadRequest) (*file.UploadStatusResp, error) {
+	bucket, key, err := a.parseFileName(req.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("aws.s3 get upload status of file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return nil, err
+	}
+	parts, err := a.listParts(ctx, client, bucket, key, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	return &file.UploadStatusResp{CommittedSize: committedSize(parts)}, nil
+}
+
+// CompleteUpload completes the multipart upload with all the parts uploaded.
+func (a *AwsOss) CompleteUpload(ctx context.Context, req *file.UploadRequest) error {
+	bucket, key, err := a.parseFileName(req.FileName)
+	if err != nil {
+		return fmt.Errorf("aws.s3 complete upload file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return err
+	}
+	parts, err := a.listParts(ctx, client, bucket, key, req.UploadId)
+	if err != nil {
+		return err
+	}
+	completed := make([]types.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completed = append(completed, types.CompletedPart{ETag: part.ETag, PartNumber: part.PartNumber})
+	}
+	_, err = client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          &bucket,
+		Key:             &key,
+		UploadId:        &req.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		return fmt.Errorf("aws.s3 complete upload file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	return nil
+}
+
+// AbortUpload aborts the multipart upload, and the parts uploaded are removed.
+func (a *AwsOss) AbortUpload(ctx context.Context, req *file.UploadRequest) error {
+	bucket, key, err := a.parseFileName(req.FileName)
+	if err != nil {
+		return fmt.Errorf("aws.s3 abort upload file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	client, err := a.selectClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{Bucket: &bucket, Key: &key, UploadId: &req.UploadId})
+	if err != nil {
+		var noSuchUpload *types.NoSuchUpload
+		if errors.As(err, &noSuchUpload) {
+			return file.ErrNotExist
+		}
+		return fmt.Errorf("aws.s3 abort upload file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	return nil
+}
+
+func (a *AwsOss) parseFileName(fileName string) (string, string, error) {
+	bucket, err := util.GetBucketName(fileName)
+	if err != nil {
+		return "", "", err
+	}
+	key, err := util.GetFileName(fileName)
+	if err != nil {
+		return "", "", err
+	}
+	return bucket, key, nil
+}
+
+// listParts lists all the parts uploaded, in the order of part number.
+func (a *AwsOss) listParts(ctx context.Context, client *s3.Client, bucket string, key string, uploadId string) ([]types.Part, error) {
+	parts := make([]types.Part, 0)
+	input := &s3.ListPartsInput{Bucket: &bucket, Key: &key, UploadId: &uploadId}
+	for {
+		out, err := client.ListParts(ctx, input)
+		if err != nil {
+			var noSuchUpload *types.NoSuchUpload
+			if errors.As(err, &noSuchUpload) {
+				return nil, file.ErrNotExist
+			}
+			return nil, fmt.Errorf("aws.s3 list parts of upload[%s] fail,err: %s", uploadId, err.Error())
+		}
+		parts = append(parts, out.Parts...)
+		if !out.IsTruncated {
+			return parts, nil
+		}
+		input.PartNumberMarker = out.NextPartNumberMarker
+	}
+}
+
+func committedSize(parts []types.Part) int64 {
+	var size int64
+	for _, part := range parts {
+		size += part.Size
+	}
+	return size
+}
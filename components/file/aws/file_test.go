@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"mosn.io/layotto/components/pkg/utils"
@@ -104,6 +105,22 @@ func TestAwsOss_Get(t *testing.T) {
 	assert.Equal(t, err.Error(), "aws.s3 get file[/a.txt] fail,err: invalid fileName format")
 }
 
+func TestAwsOss_ResumableUpload(t *testing.T) {
+	oss := NewAwsFile()
+	err := oss.Init(context.TODO(), &file.FileConfig{Metadata: []byte(cfg)})
+	assert.Equal(t, nil, err)
+	uploader := oss.(file.ResumableUploader)
+
+	_, err = uploader.InitiateUpload(context.Background(), &file.InitiateUploadRequest{FileName: "/a.txt"})
+	assert.Equal(t, err.Error(), "aws.s3 initiate upload file[/a.txt] fail,err: invalid fileName format")
+	_, err = uploader.UploadPart(context.Background(), &file.UploadPartRequest{FileName: "a.txt"})
+	assert.Equal(t, err.Error(), "aws.s3 upload part of file[a.txt] fail,err: invalid fileName format")
+	_, err = uploader.UploadPart(context.Background(), &file.UploadPartRequest{FileName: "a/b.txt", UploadId: "id", DataStream: strings.NewReader("aaaa")})
+	assert.Equal(t, file.ErrPartSize, err)
+	err = uploader.CompleteUpload(context.Background(), &file.UploadRequest{FileName: "a/"})
+	assert.Equal(t, err.Error(), "aws.s3 complete upload file[a/] fail,err: file name is empty")
+}
+
 type fun = func() (string, error)
 
 func TestCopier(t *testing.T) {
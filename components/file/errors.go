@@ -26,4 +26,7 @@ var (
 	ErrExist      = errors.New("file already exists")
 	ErrNotExist   = errors.New("file does not exist")
 	ErrExpired    = errors.New("file expired")
+	ErrOffset     = errors.New("offset mismatches the committed size")
+	ErrRange      = errors.New("range not satisfiable")
+	ErrPartSize   = errors.New("the size of the part must be > 0")
 )
@@ -30,4 +30,23 @@ type File interface {
 	List(context.Context, *ListRequest) (*ListResp, error)
 	Del(context.Context, *DelRequest) error
 	Stat(context.Context, *FileMetaRequest) (*FileMetaResp, error)
+	Copy(context.Context, *CopyRequest) error
+	Rename(context.Context, *RenameRequest) error
+}
+
+// RangeReader is implemented by the file stores supporting ranged and conditional reads.
+// The ETag and the size of the whole file are returned too, so that a client can resume a broken download.
+type RangeReader interface {
+	GetRange(context.Context, *GetRangeRequest) (*GetRangeResp, error)
+}
+
+// ResumableUploader is implemented by the file stores supporting resumable uploads.
+// A file is uploaded in parts after initiating an upload, and every part starts at the committed size.
+// A part broken half-way is not committed, so the client can query the committed size and upload it again.
+type ResumableUploader interface {
+	InitiateUpload(context.Context, *InitiateUploadRequest) (*InitiateUploadResp, error)
+	UploadPart(context.Context, *UploadPartRequest) (*UploadStatusResp, error)
+	GetUploadStatus(context.Context, *UploadRequest) (*UploadStatusResp, error)
+	CompleteUpload(context.Context, *UploadRequest) error
+	AbortUpload(context.Context, *UploadRequest) error
 }
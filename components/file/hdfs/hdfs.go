@@ -27,9 +27,11 @@ import (
 	"strconv"
 
 	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/util"
 
 	store "go.beyondstorage.io/services/hdfs"
 	"go.beyondstorage.io/v5/pairs"
+	"go.beyondstorage.io/v5/services"
 	"go.beyondstorage.io/v5/types"
 )
 
@@ -131,6 +133,55 @@ func (h *hdfs) Get(ctx context.Context, stu *file.GetFileStu) (io.ReadCloser, er
 	return r, nil
 }
 
+// GetRange reads a range of the file by the offset and size pairs.
+// Hdfs has no ETags, so the ETag is generated from the modified time and the size of the file.
+func (h *hdfs) GetRange(ctx context.Context, stu *file.GetRangeRequest) (*file.GetRangeResp, error) {
+	if _, ok := stu.Metadata[endpointKey]; !ok {
+		return nil, ErrMissingEndPoint
+	}
+	client, err := h.selectClient(stu.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := client.Stat(stu.FileName)
+	if err != nil {
+		if errors.Is(err, services.ErrObjectNotExist) {
+			return nil, file.ErrNotExist
+		}
+		return nil, err
+	}
+	size, ok := o.GetContentLength()
+	if !ok {
+		return nil, fmt.Errorf("Hdfs stat file[%s] size fail", stu.FileName)
+	}
+	modTime, _ := o.GetLastModified()
+	resp := &file.GetRangeResp{ETag: util.GenerateETag(modTime, size), Size: size}
+	if util.IsNotModified(resp.ETag, modTime, stu.IfNoneMatch, stu.IfModifiedSince) {
+		resp.NotModified = true
+		return resp, nil
+	}
+	if stu.Offset < 0 || stu.Length < 0 || stu.Offset > size {
+		return nil, file.ErrRange
+	}
+
+	ps := make([]types.Pair, 0)
+	if stu.Offset != 0 {
+		ps = append(ps, pairs.WithOffset(stu.Offset))
+	}
+	if stu.Length != 0 && stu.Offset+stu.Length < size {
+		ps = append(ps, pairs.WithSize(stu.Length))
+	}
+	var w bytes.Buffer
+	_, err = client.Read(stu.FileName, &w, ps...)
+	if err != nil {
+		return nil, err
+	}
+	resp.DataStream = ioutil.NopCloser(bytes.NewReader(w.Bytes()))
+
+	return resp, nil
+}
+
 func (h *hdfs) List(ctx context.Context, request *file.ListRequest) (*file.ListResp, error) {
 	if _, ok := request.Metadata[endpointKey]; !ok {
 		return nil, ErrMissingEndPoint
@@ -228,6 +279,174 @@ func (h *hdfs) Stat(ctx context.Context, request *file.FileMetaRequest) (*file.F
 	return resp, nil
 }
 
+// Copy streams the source file to a staging file beside the target file, and moves it to the target file,
+// as hdfs has no server-side copy.
+func (h *hdfs) Copy(ctx context.Context, req *file.CopyRequest) error {
+	if _, ok := req.Metadata[endpointKey]; !ok {
+		return ErrMissingEndPoint
+	}
+	client, err := h.selectClient(req.Metadata)
+	if err != nil {
+		return err
+	}
+	_, size, err := statFile(client, req.SourceName)
+	if err != nil {
+		return err
+	}
+	id, err := util.GenerateUploadId()
+	if err != nil {
+		return err
+	}
+	name, err := util.GetStagingFileName(req.TargetName, id)
+	if err != nil {
+		return file.ErrInvalid
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		_, err := client.Read(req.SourceName, w)
+		w.CloseWithError(err)
+	}()
+	_, err = client.Write(name, r, size)
+	r.CloseWithError(err)
+	if err == nil {
+		err = client.Move(name, req.TargetName)
+	}
+	if err != nil {
+		client.Delete(name)
+		return fmt.Errorf("Hdfs copy file[%s] fail, err: %s", req.SourceName, err.Error())
+	}
+	return nil
+}
+
+// Rename moves the file, which is atomic in hdfs.
+func (h *hdfs) Rename(ctx context.Context, req *file.RenameRequest) error {
+	if _, ok := req.Metadata[endpointKey]; !ok {
+		return ErrMissingEndPoint
+	}
+	client, err := h.selectClient(req.Metadata)
+	if err != nil {
+		return err
+	}
+	if _, _, err = statFile(client, req.SourceName); err != nil {
+		return err
+	}
+	return client.Move(req.SourceName, req.TargetName)
+}
+
+// InitiateUpload creates a staging file beside the target file, and the parts are appended to it.
+// The upload can be resumed after the runtime restarts, as the committed size is the size of the staging file.
+func (h *hdfs) InitiateUpload(ctx context.Context, req *file.InitiateUploadRequest) (*file.InitiateUploadResp, error) {
+	if _, ok := req.Metadata[endpointKey]; !ok {
+		return nil, ErrMissingEndPoint
+	}
+	client, err := h.selectClient(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	uploadId, err := util.GenerateUploadId()
+	if err != nil {
+		return nil, err
+	}
+	name, err := util.GetStagingFileName(req.FileName, uploadId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = client.CreateAppend(name); err != nil {
+		return nil, fmt.Errorf("Hdfs initiate upload file[%s] fail, err: %s", req.FileName, err.Error())
+	}
+	return &file.InitiateUploadResp{UploadId: uploadId}, nil
+}
+
+// UploadPart appends the part to the staging file.
+// Hdfs can't truncate the data appended, so the data of a broken part written is committed,
+// and the client resumes from the committed size.
+func (h *hdfs) UploadPart(ctx context.Context, req *file.UploadPartRequest) (*file.UploadStatusResp, error) {
+	client, name, err := h.selectStagingFile(req.FileName, req.UploadId, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	o, size, err := statFile(client, name)
+	if err != nil {
+		return nil, err
+	}
+	if size != req.Offset {
+		return nil, file.ErrOffset
+	}
+	n, err := client.WriteAppend(o, req.DataStream, req.Size)
+	if err != nil {
+		return nil, fmt.Errorf("Hdfs upload part of file[%s] fail, err: %s", req.FileName, err.Error())
+	}
+	return &file.UploadStatusResp{CommittedSize: size + n}, nil
+}
+
+func (h *hdfs) GetUploadStatus(ctx context.Context, req *file.UploadRequest) (*file.UploadStatusResp, error) {
+	client, name, err := h.selectStagingFile(req.FileName, req.UploadId, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	_, size, err := statFile(client, name)
+	if err != nil {
+		return nil, err
+	}
+	return &file.UploadStatusResp{CommittedSize: size}, nil
+}
+
+// CompleteUpload moves the staging file to the target file.
+func (h *hdfs) CompleteUpload(ctx context.Context, req *file.UploadRequest) error {
+	client, name, err := h.selectStagingFile(req.FileName, req.UploadId, req.Metadata)
+	if err != nil {
+		return err
+	}
+	if _, _, err = statFile(client, name); err != nil {
+		return err
+	}
+	return client.Move(name, req.FileName)
+}
+
+// AbortUpload deletes the staging file.
+func (h *hdfs) AbortUpload(ctx context.Context, req *file.UploadRequest) error {
+	client, name, err := h.selectStagingFile(req.FileName, req.UploadId, req.Metadata)
+	if err != nil {
+		return err
+	}
+	if _, _, err = statFile(client, name); err != nil {
+		return err
+	}
+	return client.Delete(name)
+}
+
+func (h *hdfs) selectStagingFile(fileName string, uploadId string, meta map[string]string) (types.Storager, string, error) {
+	if _, ok := meta[endpointKey]; !ok {
+		return nil, "", ErrMissingEndPoint
+	}
+	client, err := h.selectClient(meta)
+	if err != nil {
+		return nil, "", err
+	}
+	name, err := util.GetStagingFileName(fileName, uploadId)
+	if err != nil {
+		return nil, "", file.ErrInvalid
+	}
+	return client, name, nil
+}
+
+// statFile returns the file with its size
+func statFile(client types.Storager, name string) (*types.Object, int64, error) {
+	o, err := client.Stat(name)
+	if err != nil {
+		if errors.Is(err, services.ErrObjectNotExist) {
+			return nil, 0, file.ErrNotExist
+		}
+		return nil, 0, err
+	}
+	size, ok := o.GetContentLength()
+	if !ok {
+		return nil, 0, fmt.Errorf("Hdfs stat file[%s] size fail", name)
+	}
+	return o, size, nil
+}
+
 func (h *hdfs) selectClient(meta map[string]string) (client types.Storager, err error) {
 	var endpoint string
 	var ok bool
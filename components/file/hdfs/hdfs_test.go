@@ -273,3 +273,39 @@ func TestHdfs_IsHdfsMetaValid(t *testing.T) {
 	mt.EndPoint = "a"
 	assert.True(t, mt.isHdfsMetaValid())
 }
+
+func TestHdfs_ResumableUpload(t *testing.T) {
+	hdfs := NewHdfs().(file.ResumableUploader)
+
+	req := &file.InitiateUploadRequest{
+		FileName: "test_put",
+		Metadata: map[string]string{"": ""},
+	}
+	_, err := hdfs.InitiateUpload(context.TODO(), req)
+	assert.Equal(t, ErrMissingEndPoint, err)
+
+	// client not exist
+	req.Metadata["endpoint"] = endpoint
+	_, err = hdfs.InitiateUpload(context.TODO(), req)
+	assert.Equal(t, ErrClientNotExist, err)
+
+	partReq := &file.UploadPartRequest{
+		FileName: "test_put",
+		UploadId: "id",
+		Metadata: map[string]string{"endpoint": endpoint},
+	}
+	_, err = hdfs.UploadPart(context.TODO(), partReq)
+	assert.Equal(t, ErrClientNotExist, err)
+
+	uploadReq := &file.UploadRequest{
+		FileName: "test_put",
+		UploadId: "id",
+		Metadata: map[string]string{"": ""},
+	}
+	_, err = hdfs.GetUploadStatus(context.TODO(), uploadReq)
+	assert.Equal(t, ErrMissingEndPoint, err)
+	err = hdfs.CompleteUpload(context.TODO(), uploadReq)
+	assert.Equal(t, ErrMissingEndPoint, err)
+	err = hdfs.AbortUpload(context.TODO(), uploadReq)
+	assert.Equal(t, ErrMissingEndPoint, err)
+}
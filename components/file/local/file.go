@@ -26,12 +26,15 @@ import (
 	"strings"
 
 	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/util"
 )
 
 const (
 	FileMode  = "FileMode"
 	FileFlag  = "FileFlag"
 	FileIsDir = "IsDir"
+
+	defaultUploadFileMode = 0644
 )
 
 type LocalStore struct {
@@ -96,6 +99,49 @@ func (lf *LocalStore) Get(ctx context.Context, f *file.GetFileStu) (io.ReadClose
 	}
 	return fileObj, nil
 }
+
+// GetRange reads a range of the file, whose ETag is generated from the modified time and the size.
+func (lf *LocalStore) GetRange(ctx context.Context, f *file.GetRangeRequest) (*file.GetRangeResp, error) {
+	fileObj, err := os.Open(f.FileName)
+	if err != nil {
+		return nil, err
+	}
+	info, err := fileObj.Stat()
+	if err != nil {
+		fileObj.Close()
+		return nil, err
+	}
+	resp := &file.GetRangeResp{ETag: util.GenerateETag(info.ModTime(), info.Size()), Size: info.Size()}
+	if util.IsNotModified(resp.ETag, info.ModTime(), f.IfNoneMatch, f.IfModifiedSince) {
+		fileObj.Close()
+		resp.NotModified = true
+		return resp, nil
+	}
+	if f.Offset < 0 || f.Length < 0 || f.Offset > info.Size() {
+		fileObj.Close()
+		return nil, file.ErrRange
+	}
+	if f.Offset == 0 && f.Length == 0 {
+		resp.DataStream = fileObj
+		return resp, nil
+	}
+	if _, err = fileObj.Seek(f.Offset, io.SeekStart); err != nil {
+		fileObj.Close()
+		return nil, err
+	}
+	length := f.Length
+	if length == 0 {
+		length = info.Size() - f.Offset
+	}
+	resp.DataStream = &rangeReader{Reader: io.LimitReader(fileObj, length), Closer: fileObj}
+	return resp, nil
+}
+
+type rangeReader struct {
+	io.Reader
+	io.Closer
+}
+
 func (lf *LocalStore) List(ctx context.Context, f *file.ListRequest) (*file.ListResp, error) {
 	res := &file.ListResp{}
 	files, err := ioutil.ReadDir(f.DirectoryName)
@@ -166,3 +212,161 @@ func (lf *LocalStore) Stat(ctx context.Context, f *file.FileMetaRequest) (*file.
 	resp.Metadata[FileIsDir] = append(resp.Metadata[FileIsDir], isDir)
 	return resp, nil
 }
+
+// Copy writes the source file to a staging file beside the target file, and renames it to the target file,
+// so the target file is never partially copied.
+func (lf *LocalStore) Copy(ctx context.Context, req *file.CopyRequest) error {
+	src, err := os.Open(req.SourceName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return file.ErrNotExist
+		}
+		return err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return file.ErrInvalid
+	}
+	id, err := util.GenerateUploadId()
+	if err != nil {
+		return err
+	}
+	name, err := util.GetStagingFileName(req.TargetName, id)
+	if err != nil {
+		return file.ErrInvalid
+	}
+	dst, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(name, req.TargetName)
+	}
+	if err != nil {
+		os.Remove(name)
+		return err
+	}
+	return nil
+}
+
+// Rename renames the file atomically, and the target file is replaced if it exists.
+func (lf *LocalStore) Rename(ctx context.Context, req *file.RenameRequest) error {
+	err := os.Rename(req.SourceName, req.TargetName)
+	if os.IsNotExist(err) {
+		return file.ErrNotExist
+	}
+	return err
+}
+
+// InitiateUpload creates a staging file beside the target file, so that it can be renamed atomically when the upload completes.
+// The upload can be resumed after the runtime restarts, as the committed size is the size of the staging file.
+func (lf *LocalStore) InitiateUpload(ctx context.Context, req *file.InitiateUploadRequest) (*file.InitiateUploadResp, error) {
+	var m uint64 = defaultUploadFileMode
+	if mode, ok := req.Metadata[FileMode]; ok {
+		var err error
+		m, err = strconv.ParseUint(mode, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("wrong fileMode value:%+v in metadata", err)
+		}
+	}
+	uploadId, err := util.GenerateUploadId()
+	if err != nil {
+		return nil, err
+	}
+	name, err := util.GetStagingFileName(req.FileName, uploadId)
+	if err != nil {
+		return nil, err
+	}
+	fileObj, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, os.FileMode(m))
+	if err != nil {
+		return nil, err
+	}
+	defer fileObj.Close()
+	return &file.InitiateUploadResp{UploadId: uploadId}, nil
+}
+
+// UploadPart appends the part to the staging file, and truncates the data written if the part is broken.
+func (lf *LocalStore) UploadPart(ctx context.Context, req *file.UploadPartRequest) (*file.UploadStatusResp, error) {
+	name, err := util.GetStagingFileName(req.FileName, req.UploadId)
+	if err != nil {
+		return nil, file.ErrInvalid
+	}
+	fileObj, err := os.OpenFile(name, os.O_WRONLY, 0)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, file.ErrNotExist
+		}
+		return nil, err
+	}
+	defer fileObj.Close()
+	info, err := fileObj.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() != req.Offset {
+		return nil, file.ErrOffset
+	}
+	if _, err = fileObj.Seek(req.Offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	n, err := io.Copy(fileObj, req.DataStream)
+	if err == nil && req.Size > 0 && n != req.Size {
+		err = fmt.Errorf("the size of part is %d, but %d bytes received", req.Size, n)
+	}
+	if err != nil {
+		if truncateErr := fileObj.Truncate(req.Offset); truncateErr != nil {
+			return nil, truncateErr
+		}
+		return nil, err
+	}
+	return &file.UploadStatusResp{CommittedSize: req.Offset + n}, nil
+}
+
+func (lf *LocalStore) GetUploadStatus(ctx context.Context, req *file.UploadRequest) (*file.UploadStatusResp, error) {
+	name, err := util.GetStagingFileName(req.FileName, req.UploadId)
+	if err != nil {
+		return nil, file.ErrInvalid
+	}
+	info, err := os.Stat(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, file.ErrNotExist
+		}
+		return nil, err
+	}
+	return &file.UploadStatusResp{CommittedSize: info.Size()}, nil
+}
+
+// CompleteUpload renames the staging file to the target file.
+func (lf *LocalStore) CompleteUpload(ctx context.Context, req *file.UploadRequest) error {
+	name, err := util.GetStagingFileName(req.FileName, req.UploadId)
+	if err != nil {
+		return file.ErrInvalid
+	}
+	err = os.Rename(name, req.FileName)
+	if os.IsNotExist(err) {
+		return file.ErrNotExist
+	}
+	return err
+}
+
+// AbortUpload removes the staging file.
+func (lf *LocalStore) AbortUpload(ctx context.Context, req *file.UploadRequest) error {
+	name, err := util.GetStagingFileName(req.FileName, req.UploadId)
+	if err != nil {
+		return file.ErrInvalid
+	}
+	err = os.Remove(name)
+	if os.IsNotExist(err) {
+		return file.ErrNotExist
+	}
+	return err
+}
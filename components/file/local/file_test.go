@@ -18,10 +18,15 @@ package local
 
 import (
 	"context"
+	"errors"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -117,3 +122,129 @@ func TestFile(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, exist, false)
 }
+
+type brokenReader struct{}
+
+func (r *brokenReader) Read(p []byte) (int, error) {
+	return 0, errors.New("broken")
+}
+
+func TestResumableUpload(t *testing.T) {
+	ls := &LocalStore{}
+	ctx := context.TODO()
+	name := filepath.Join(t.TempDir(), "upload.txt")
+	resp, err := ls.InitiateUpload(ctx, &file.InitiateUploadRequest{FileName: name})
+	assert.Nil(t, err)
+	req := &file.UploadRequest{FileName: name, UploadId: resp.UploadId}
+
+	status, err := ls.UploadPart(ctx, &file.UploadPartRequest{FileName: name, UploadId: resp.UploadId, Size: 6, DataStream: strings.NewReader("hello ")})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), status.CommittedSize)
+
+	// the broken part is not committed
+	_, err = ls.UploadPart(ctx, &file.UploadPartRequest{FileName: name, UploadId: resp.UploadId, Offset: 6, DataStream: io.MultiReader(strings.NewReader("wor"), &brokenReader{})})
+	assert.NotNil(t, err)
+	status, err = ls.GetUploadStatus(ctx, req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), status.CommittedSize)
+
+	_, err = ls.UploadPart(ctx, &file.UploadPartRequest{FileName: name, UploadId: resp.UploadId, Offset: 3, DataStream: strings.NewReader("world")})
+	assert.Equal(t, file.ErrOffset, err)
+	status, err = ls.UploadPart(ctx, &file.UploadPartRequest{FileName: name, UploadId: resp.UploadId, Offset: 6, DataStream: strings.NewReader("world")})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), status.CommittedSize)
+
+	assert.Nil(t, ls.CompleteUpload(ctx, req))
+	data, err := os.ReadFile(name)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", string(data))
+	_, err = ls.GetUploadStatus(ctx, req)
+	assert.Equal(t, file.ErrNotExist, err)
+
+	// abort
+	resp, err = ls.InitiateUpload(ctx, &file.InitiateUploadRequest{FileName: name})
+	assert.Nil(t, err)
+	req.UploadId = resp.UploadId
+	assert.Nil(t, ls.AbortUpload(ctx, req))
+	assert.Equal(t, file.ErrNotExist, ls.CompleteUpload(ctx, req))
+	req.UploadId = "../upload"
+	assert.Equal(t, file.ErrInvalid, ls.AbortUpload(ctx, req))
+}
+
+func TestGetRange(t *testing.T) {
+	ls := &LocalStore{}
+	name := filepath.Join(t.TempDir(), "a.txt")
+	assert.Nil(t, ioutil.WriteFile(name, []byte("hello world"), 0644))
+	read := func(req *file.GetRangeRequest) string {
+		resp, err := ls.GetRange(context.TODO(), req)
+		assert.Nil(t, err)
+		defer resp.DataStream.Close()
+		assert.Equal(t, int64(11), resp.Size)
+		data, err := ioutil.ReadAll(resp.DataStream)
+		assert.Nil(t, err)
+		return string(data)
+	}
+
+	assert.Equal(t, "world", read(&file.GetRangeRequest{FileName: name, Offset: 6}))
+	assert.Equal(t, "lo w", read(&file.GetRangeRequest{FileName: name, Offset: 3, Length: 4}))
+	assert.Equal(t, "world", read(&file.GetRangeRequest{FileName: name, Offset: 6, Length: 100}))
+	assert.Equal(t, "", read(&file.GetRangeRequest{FileName: name, Offset: 11}))
+	_, err := ls.GetRange(context.TODO(), &file.GetRangeRequest{FileName: name, Offset: 12})
+	assert.Equal(t, file.ErrRange, err)
+
+	resp, err := ls.GetRange(context.TODO(), &file.GetRangeRequest{FileName: name})
+	assert.Nil(t, err)
+	resp.DataStream.Close()
+	etag := resp.ETag
+	assert.NotEmpty(t, etag)
+
+	resp, err = ls.GetRange(context.TODO(), &file.GetRangeRequest{FileName: name, IfNoneMatch: etag})
+	assert.Nil(t, err)
+	assert.True(t, resp.NotModified)
+	assert.Nil(t, resp.DataStream)
+	assert.Equal(t, etag, resp.ETag)
+
+	resp, err = ls.GetRange(context.TODO(), &file.GetRangeRequest{FileName: name, IfModifiedSince: time.Now().Add(time.Hour)})
+	assert.Nil(t, err)
+	assert.True(t, resp.NotModified)
+
+	assert.Equal(t, "hello world", read(&file.GetRangeRequest{FileName: name, IfModifiedSince: time.Now().Add(-time.Hour)}))
+	assert.Nil(t, ioutil.WriteFile(name, []byte("hello layotto"), 0644))
+	resp, err = ls.GetRange(context.TODO(), &file.GetRangeRequest{FileName: name, IfNoneMatch: etag})
+	assert.Nil(t, err)
+	defer resp.DataStream.Close()
+	assert.False(t, resp.NotModified)
+	assert.NotEqual(t, etag, resp.ETag)
+}
+
+func TestCopyAndRename(t *testing.T) {
+	ls := &LocalStore{}
+	ctx := context.TODO()
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.txt")
+	assert.Nil(t, os.WriteFile(src, []byte("hello world"), 0600))
+
+	copied := filepath.Join(dir, "copied.txt")
+	assert.Nil(t, ls.Copy(ctx, &file.CopyRequest{SourceName: src, TargetName: copied}))
+	data, err := os.ReadFile(copied)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", string(data))
+	info, err := os.Stat(copied)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode())
+
+	renamed := filepath.Join(dir, "renamed.txt")
+	assert.Nil(t, ls.Rename(ctx, &file.RenameRequest{SourceName: src, TargetName: renamed}))
+	data, err = os.ReadFile(renamed)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", string(data))
+	_, err = os.Stat(src)
+	assert.True(t, os.IsNotExist(err))
+
+	assert.Equal(t, file.ErrNotExist, ls.Copy(ctx, &file.CopyRequest{SourceName: src, TargetName: copied}))
+	assert.Equal(t, file.ErrNotExist, ls.Rename(ctx, &file.RenameRequest{SourceName: src, TargetName: renamed}))
+	// no staging file is left
+	entries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(entries))
+}
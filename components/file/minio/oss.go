@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"strconv"
 
 	"mosn.io/layotto/components/file/util"
@@ -135,6 +136,52 @@ func (m *MinioOss) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser,
 	return obj, nil
 }
 
+// GetRange gets a range of the object, or nothing if it is not modified.
+func (m *MinioOss) GetRange(ctx context.Context, st *file.GetRangeRequest) (*file.GetRangeResp, error) {
+	bucket, err := util.GetBucketName(st.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("minio get file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	key, err := util.GetFileName(st.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("minio get file[%s] fail,err: %s", st.FileName, err.Error())
+	}
+	core, err := m.selectClient(st.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	opts := minio.GetObjectOptions{}
+	if r := util.FormatRange(st.Offset, st.Length); r != "" {
+		opts.Set("Range", r)
+	}
+	if st.IfNoneMatch != "" {
+		if err = opts.SetMatchETagExcept(st.IfNoneMatch); err != nil {
+			return nil, err
+		}
+	}
+	if !st.IfModifiedSince.IsZero() {
+		if err = opts.SetModified(st.IfModifiedSince); err != nil {
+			return nil, err
+		}
+	}
+	// core.GetObject returns the headers, where the size of the whole file is in Content-Range
+	obj, info, header, err := core.GetObject(ctx, bucket, key, opts)
+	if err != nil {
+		switch minio.ToErrorResponse(err).StatusCode {
+		case http.StatusNotModified:
+			return &file.GetRangeResp{ETag: st.IfNoneMatch, NotModified: true}, nil
+		case http.StatusRequestedRangeNotSatisfiable:
+			return nil, file.ErrRange
+		}
+		return nil, err
+	}
+	return &file.GetRangeResp{
+		DataStream: obj,
+		ETag:       info.ETag,
+		Size:       util.GetTotalSize(header.Get("Content-Range"), info.Size),
+	}, nil
+}
+
 func (m *MinioOss) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
 	bucket, err := util.GetBucketName(st.DirectoryName)
 	marker := ""
@@ -214,6 +261,35 @@ func (m *MinioOss) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.Fi
 	return resp, nil
 }
 
+// Copy copies the object by server-side copy, and the object larger than 5GB is copied in parts.
+func (m *MinioOss) Copy(ctx context.Context, req *file.CopyRequest) error {
+	srcBucket, srcKey, core, err := m.parseFileName(req.SourceName, req.Metadata)
+	if err != nil {
+		return fmt.Errorf("minio copy file[%s] fail,err: %s", req.SourceName, err.Error())
+	}
+	dstBucket, dstKey, _, err := m.parseFileName(req.TargetName, req.Metadata)
+	if err != nil {
+		return fmt.Errorf("minio copy file[%s] fail,err: %s", req.TargetName, err.Error())
+	}
+	_, err = core.Client.ComposeObject(ctx,
+		minio.CopyDestOptions{Bucket: dstBucket, Object: dstKey},
+		minio.CopySrcOptions{Bucket: srcBucket, Object: srcKey},
+	)
+	if err != nil && minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return file.ErrNotExist
+	}
+	return err
+}
+
+// Rename copies the object to the target and then removes the source.
+func (m *MinioOss) Rename(ctx context.Context, req *file.RenameRequest) error {
+	err := m.Copy(ctx, &file.CopyRequest{SourceName: req.SourceName, TargetName: req.TargetName, Metadata: req.Metadata})
+	if err != nil {
+		return err
+	}
+	return m.Del(ctx, &file.DelRequest{FileName: req.SourceName, Metadata: req.Metadata})
+}
+
 func (m *MinioOss) createOssClient(meta *MinioMetaData) (*minio.Core, error) {
 	client, err := minio.New(
 		meta.EndPoint,
@@ -256,3 +332,128 @@ func (mm *MinioMetaData) isMinioMetaValid() bool {
 	}
 	return true
 }
+
+// InitiateUpload creates a multipart upload.
+func (m *MinioOss) InitiateUpload(ctx context.Context, req *file.InitiateUploadRequest) (*file.InitiateUploadResp, error) {
+	bucket, key, core, err := m.parseFileName(req.FileName, req.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("minio initiate upload file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	uploadId, err := core.NewMultipartUpload(ctx, bucket, key, minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	if err != nil {
+		return nil, err
+	}
+	return &file.InitiateUploadResp{UploadId: uploadId}, nil
+}
+
+// UploadPart uploads the part as the next part of the multipart upload.
+func (m *MinioOss) UploadPart(ctx context.Context, req *file.UploadPartRequest) (*file.UploadStatusResp, error) {
+	bucket, key, core, err := m.parseFileName(req.FileName, req.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("minio upload part of file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	if req.Size <= 0 {
+		return nil, file.ErrPartSize
+	}
+	parts, err := m.listParts(ctx, core, bucket, key, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	committed := committedSize(parts)
+	if req.Offset != committed {
+		return nil, file.ErrOffset
+	}
+	part, err := core.PutObjectPart(ctx, bucket, key, req.UploadId, len(parts)+1, req.DataStream, req.Size, "", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	return &file.UploadStatusResp{CommittedSize: committed + part.Size}, nil
+}
+
+// GetUploadStatus sums the sizes of the parts uploaded.
+func (m *MinioOss) GetUploadStatus(ctx context.Context, req *file.UploadRequest) (*file.UploadStatusResp, error) {
+	bucket, key, core, err := m.parseFileName(req.FileName, req.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("minio get upload status of file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	parts, err := m.listParts(ctx, core, bucket, key, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	return &file.UploadStatusResp{CommittedSize: committedSize(parts)}, nil
+}
+
+// CompleteUpload completes the multipart upload with all the parts uploaded.
+func (m *MinioOss) CompleteUpload(ctx context.Context, req *file.UploadRequest) error {
+	bucket, key, core, err := m.parseFileName(req.FileName, req.Metadata)
+	if err != nil {
+		return fmt.Errorf("minio complete upload file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	parts, err := m.listParts(ctx, core, bucket, key, req.UploadId)
+	if err != nil {
+		return err
+	}
+	completed := make([]minio.CompletePart, 0, len(parts))
+	for _, part := range parts {
+		completed = append(completed, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+	_, err = core.CompleteMultipartUpload(ctx, bucket, key, req.UploadId, completed, minio.PutObjectOptions{})
+	return err
+}
+
+// AbortUpload aborts the multipart upload, and the parts uploaded are removed.
+func (m *MinioOss) AbortUpload(ctx context.Context, req *file.UploadRequest) error {
+	bucket, key, core, err := m.parseFileName(req.FileName, req.Metadata)
+	if err != nil {
+		return fmt.Errorf("minio abort upload file[%s] fail,err: %s", req.FileName, err.Error())
+	}
+	err = core.AbortMultipartUpload(ctx, bucket, key, req.UploadId)
+	if err != nil && minio.ToErrorResponse(err).Code == "NoSuchUpload" {
+		return file.ErrNotExist
+	}
+	return err
+}
+
+func (m *MinioOss) parseFileName(fileName string, meta map[string]string) (string, string, *minio.Core, error) {
+	bucket, err := util.GetBucketName(fileName)
+	if err != nil {
+		return "", "", nil, err
+	}
+	key, err := util.GetFileName(fileName)
+	if err != nil {
+		return "", "", nil, err
+	}
+	core, err := m.selectClient(meta)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return bucket, key, core, nil
+}
+
+// listParts lists all the parts uploaded, in the order of part number.
+func (m *MinioOss) listParts(ctx context.Context, core *minio.Core, bucket string, key string, uploadId string) ([]minio.ObjectPart, error) {
+	parts := make([]minio.ObjectPart, 0)
+	marker := 0
+	for {
+		out, err := core.ListObjectParts(ctx, bucket, key, uploadId, marker, 0)
+		if err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchUpload" {
+				return nil, file.ErrNotExist
+			}
+			return nil, err
+		}
+		parts = append(parts, out.ObjectParts...)
+		if !out.IsTruncated {
+			return parts, nil
+		}
+		marker = out.NextPartNumberMarker
+	}
+}
+
+func committedSize(parts []minio.ObjectPart) int64 {
+	var size int64
+	for _, part := range parts {
+		size += part.Size
+	}
+	return size
+}
@@ -137,4 +137,26 @@ func TestMinioOss_Get(t *testing.T) {
 	getReq.Metadata["endpoint"] = "endpoint"
 	_, err = oss.Get(context.TODO(), getReq)
 	assert.Nil(t, err)
+
+	// the range is got at once with the ETag, so the request fails without a minio server
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	_, err = oss.(file.RangeReader).GetRange(ctx, &file.GetRangeRequest{FileName: getReq.FileName, Metadata: getReq.Metadata, Offset: 1})
+	assert.NotNil(t, err)
+}
+
+func TestMinioOss_ResumableUpload(t *testing.T) {
+	oss := NewMinioOss()
+	err := oss.Init(context.TODO(), &file.FileConfig{Metadata: json.RawMessage(cfg)})
+	assert.Nil(t, err)
+	uploader := oss.(file.ResumableUploader)
+
+	_, err = uploader.InitiateUpload(context.TODO(), &file.InitiateUploadRequest{FileName: "a.txt", Metadata: map[string]string{}})
+	assert.Equal(t, "minio initiate upload file[a.txt] fail,err: invalid fileName format", err.Error())
+	_, err = uploader.UploadPart(context.TODO(), &file.UploadPartRequest{FileName: "/a.txt", Metadata: map[string]string{}})
+	assert.Equal(t, "minio upload part of file[/a.txt] fail,err: invalid fileName format", err.Error())
+	_, err = uploader.UploadPart(context.TODO(), &file.UploadPartRequest{FileName: "a/b.txt", Metadata: map[string]string{}})
+	assert.Equal(t, file.ErrPartSize, err)
+	err = uploader.AbortUpload(context.TODO(), &file.UploadRequest{FileName: "a/b.txt", Metadata: map[string]string{"endpoint": "endpoint1"}})
+	assert.Equal(t, "minio abort upload file[a/b.txt] fail,err: specific client not exist", err.Error())
 }
@@ -23,10 +23,14 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/qiniu/go-sdk/v7/auth/qbox"
 	"github.com/qiniu/go-sdk/v7/storage"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/util"
 )
 
 type QiniuOSSClient struct {
@@ -39,6 +43,20 @@ type QiniuOSSClient struct {
 	mac *qbox.Mac
 	fu  FormUploader
 	bm  BucketManager
+	ru  ResumeUploader
+
+	// uploads are the multipart uploads in progress keyed by upload id,
+	// because the parts uploaded can't be listed from qiniu
+	uploadsLock sync.Mutex
+	uploads     map[string]*multipartUpload
+}
+
+type multipartUpload struct {
+	// mu serializes uploading the parts
+	mu    sync.Mutex
+	key   string
+	size  int64
+	parts []storage.UploadPartInfo
 }
 
 type FormUploader interface {
@@ -48,10 +66,19 @@ type FormUploader interface {
 type BucketManager interface {
 	Stat(bucket, key string) (storage.FileInfo, error)
 	Delete(bucket, key string) (err error)
+	Copy(srcBucket, srcKey, destBucket, destKey string, force bool) (err error)
+	Move(srcBucket, srcKey, destBucket, destKey string, force bool) (err error)
 	ListFiles(bucket, prefix, delimiter, marker string,
 		limit int) (entries []storage.ListItem, commonPrefixes []string, nextMarker string, hasNext bool, err error)
 }
 
+type ResumeUploader interface {
+	InitParts(ctx context.Context, upToken, upHost, bucket, key string, hasKey bool, ret *storage.InitPartsRet) error
+	UploadParts(ctx context.Context, upToken, upHost, bucket, key string, hasKey bool, uploadId string, partNumber int64, partMD5 string, ret *storage.UploadPartsRet, body io.Reader, size int) error
+	CompleteParts(ctx context.Context, upToken, upHost string, ret interface{}, bucket, key string, hasKey bool, uploadId string, extra *storage.RputV2Extra) error
+	UpHost(ak, bucket string) (upHost string, err error)
+}
+
 func newQiniuOSSClient(ak, sk, bucket, domain string, private bool, useHttps, userCdnDomains bool) *QiniuOSSClient {
 	cfg := storage.Config{
 		UseHTTPS:      useHttps,
@@ -68,6 +95,8 @@ func newQiniuOSSClient(ak, sk, bucket, domain string, private bool, useHttps, us
 		Private:   private,
 		mac:       mac,
 		bm:        storage.NewBucketManager(mac, &cfg),
+		ru:        storage.NewResumeUploaderV2(&cfg),
+		uploads:   make(map[string]*multipartUpload),
 	}
 	return s
 }
@@ -112,6 +141,61 @@ func (s *QiniuOSSClient) get(_ context.Context, fileName string) (io.ReadCloser,
 	return resp.Body, nil
 }
 
+func (s *QiniuOSSClient) getRange(ctx context.Context, st *file.GetRangeRequest) (*file.GetRangeResp, error) {
+	if err := s.checkFileName(st.FileName); err != nil {
+		return nil, err
+	}
+
+	var accessUrl string
+
+	if !s.Private {
+		accessUrl = storage.MakePublicURL(s.Domain, st.FileName)
+
+	} else {
+		deadline := time.Now().Add(time.Second * 60).Unix() //1小时有效期
+		accessUrl = storage.MakePrivateURL(s.mac, s.Domain, st.FileName, deadline)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, accessUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	if r := util.FormatRange(st.Offset, st.Length); r != "" {
+		req.Header.Set("Range", r)
+	}
+	if st.IfNoneMatch != "" {
+		req.Header.Set("If-None-Match", st.IfNoneMatch)
+	}
+	if !st.IfModifiedSince.IsZero() {
+		req.Header.Set("If-Modified-Since", st.IfModifiedSince.UTC().Format(http.TimeFormat))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return &file.GetRangeResp{
+			DataStream: resp.Body,
+			ETag:       resp.Header.Get("ETag"),
+			Size:       util.GetTotalSize(resp.Header.Get("Content-Range"), resp.ContentLength),
+		}, nil
+	case http.StatusNotModified:
+		resp.Body.Close()
+		return &file.GetRangeResp{ETag: resp.Header.Get("ETag"), NotModified: true}, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		resp.Body.Close()
+		return nil, file.ErrRange
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, file.ErrNotExist
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("get file[%s] fail, status: %s", st.FileName, resp.Status)
+	}
+}
+
 func (s *QiniuOSSClient) stat(_ context.Context, fileName string) (*storage.FileInfo, error) {
 	if err := s.checkFileName(fileName); err != nil {
 		return nil, err
@@ -129,6 +213,28 @@ func (s *QiniuOSSClient) del(_ context.Context, fileName string) error {
 	return s.bm.Delete(s.Bucket, fileName)
 }
 
+func (s *QiniuOSSClient) copy(_ context.Context, srcName string, dstName string) error {
+	if err := s.checkFileName(srcName); err != nil {
+		return err
+	}
+	if err := s.checkFileName(dstName); err != nil {
+		return err
+	}
+
+	return s.bm.Copy(s.Bucket, srcName, s.Bucket, dstName, true)
+}
+
+func (s *QiniuOSSClient) move(_ context.Context, srcName string, dstName string) error {
+	if err := s.checkFileName(srcName); err != nil {
+		return err
+	}
+	if err := s.checkFileName(dstName); err != nil {
+		return err
+	}
+
+	return s.bm.Move(s.Bucket, srcName, s.Bucket, dstName, true)
+}
+
 func (s *QiniuOSSClient) list(_ context.Context, prefix string, limit int, marker string) (entries []storage.ListItem, commonPrefixes []string, nextMarker string, hasNext bool, err error) {
 	if limit > 1000 {
 		return nil, nil, "", false, errors.New("limit must be <=1000")
@@ -149,3 +255,115 @@ func (s *QiniuOSSClient) checkFileName(fileName string) error {
 
 	return nil
 }
+
+func (s *QiniuOSSClient) upToken() string {
+	putPolicy := storage.PutPolicy{
+		Scope: s.Bucket,
+	}
+	return putPolicy.UploadToken(qbox.NewMac(s.AccessKey, s.SecretKey))
+}
+
+func (s *QiniuOSSClient) initiateUpload(ctx context.Context, fileName string) (string, error) {
+	if err := s.checkFileName(fileName); err != nil {
+		return "", err
+	}
+	upHost, err := s.ru.UpHost(s.AccessKey, s.Bucket)
+	if err != nil {
+		return "", err
+	}
+	ret := storage.InitPartsRet{}
+	if err = s.ru.InitParts(ctx, s.upToken(), upHost, s.Bucket, fileName, true, &ret); err != nil {
+		return "", err
+	}
+	s.uploadsLock.Lock()
+	s.uploads[ret.UploadID] = &multipartUpload{key: fileName}
+	s.uploadsLock.Unlock()
+	return ret.UploadID, nil
+}
+
+// getUpload returns the upload of the file locked, which is only known by the runtime initiating it
+func (s *QiniuOSSClient) getUpload(fileName string, uploadId string) (*multipartUpload, error) {
+	if err := s.checkFileName(fileName); err != nil {
+		return nil, err
+	}
+	s.uploadsLock.Lock()
+	upload, ok := s.uploads[uploadId]
+	s.uploadsLock.Unlock()
+	if !ok || upload.key != fileName {
+		return nil, file.ErrNotExist
+	}
+	upload.mu.Lock()
+	return upload, nil
+}
+
+func (s *QiniuOSSClient) removeUpload(uploadId string) {
+	s.uploadsLock.Lock()
+	delete(s.uploads, uploadId)
+	s.uploadsLock.Unlock()
+}
+
+func (s *QiniuOSSClient) uploadPart(ctx context.Context, fileName string, uploadId string, offset int64, data io.Reader, dataSize int64) (int64, error) {
+	upload, err := s.getUpload(fileName, uploadId)
+	if err != nil {
+		return 0, err
+	}
+	defer upload.mu.Unlock()
+	if offset != upload.size {
+		return 0, file.ErrOffset
+	}
+	if dataSize <= 0 {
+		return 0, file.ErrPartSize
+	}
+	upHost, err := s.ru.UpHost(s.AccessKey, s.Bucket)
+	if err != nil {
+		return 0, err
+	}
+	partNumber := int64(len(upload.parts) + 1)
+	ret := storage.UploadPartsRet{}
+	err = s.ru.UploadParts(ctx, s.upToken(), upHost, s.Bucket, fileName, true, uploadId, partNumber, "", &ret, data, int(dataSize))
+	if err != nil {
+		return 0, err
+	}
+	upload.parts = append(upload.parts, storage.UploadPartInfo{Etag: ret.Etag, PartNumber: partNumber})
+	upload.size += dataSize
+	return upload.size, nil
+}
+
+func (s *QiniuOSSClient) uploadStatus(fileName string, uploadId string) (int64, error) {
+	upload, err := s.getUpload(fileName, uploadId)
+	if err != nil {
+		return 0, err
+	}
+	defer upload.mu.Unlock()
+	return upload.size, nil
+}
+
+func (s *QiniuOSSClient) completeUpload(ctx context.Context, fileName string, uploadId string) error {
+	upload, err := s.getUpload(fileName, uploadId)
+	if err != nil {
+		return err
+	}
+	defer upload.mu.Unlock()
+	upHost, err := s.ru.UpHost(s.AccessKey, s.Bucket)
+	if err != nil {
+		return err
+	}
+	ret := storage.PutRet{}
+	extra := &storage.RputV2Extra{Progresses: upload.parts}
+	if err = s.ru.CompleteParts(ctx, s.upToken(), upHost, &ret, s.Bucket, fileName, true, uploadId, extra); err != nil {
+		return err
+	}
+	s.removeUpload(uploadId)
+	return nil
+}
+
+// abortUpload forgets the upload, and the parts uploaded are expired by qiniu
+func (s *QiniuOSSClient) abortUpload(fileName string, uploadId string) error {
+	upload, err := s.getUpload(fileName, uploadId)
+	if err != nil {
+		return err
+	}
+	defer upload.mu.Unlock()
+	s.removeUpload(uploadId)
+	return nil
+}
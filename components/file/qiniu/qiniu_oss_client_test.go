@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -28,6 +29,7 @@ import (
 	"github.com/qiniu/go-sdk/v7/storage"
 	"github.com/stretchr/testify/assert"
 
+	"mosn.io/layotto/components/file"
 	"mosn.io/layotto/components/pkg/mock"
 )
 
@@ -164,6 +166,29 @@ func TestDeleteFile(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCopyAndMoveFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fu := mock.NewMockFormUploader(ctrl)
+	bm := mock.NewMockBucketManager(ctrl)
+
+	bm.EXPECT().Copy(gomock.Eq("xc2022"), gomock.Eq("a.txt"), gomock.Eq("xc2022"), gomock.Eq("b.txt"), true).Return(nil)
+	bm.EXPECT().Move(gomock.Eq("xc2022"), gomock.Eq("a.txt"), gomock.Eq("xc2022"), gomock.Eq("c.txt"), true).Return(errors.New("aa"))
+	s := newMockQiniuOSSClient("ak", "sk", "xc2022", "https://example.com", false, fu, bm)
+
+	err := s.copy(context.Background(), "a.txt", "b.txt")
+	assert.NoError(t, err)
+
+	err = s.move(context.Background(), "a.txt", "c.txt")
+	assert.Error(t, err)
+
+	err = s.copy(context.Background(), "/a.txt", "b.txt")
+	assert.Error(t, err)
+
+	err = s.move(context.Background(), "a.txt", "/c.txt")
+	assert.Error(t, err)
+}
+
 func TestListFile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -197,6 +222,57 @@ func TestListFile(t *testing.T) {
 
 }
 
+func TestClientResumableUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ru := mock.NewMockResumeUploader(ctrl)
+	s := newMockQiniuOSSClient("ak", "sk", "xc2022", "", true, mock.NewMockFormUploader(ctrl), mock.NewMockBucketManager(ctrl))
+	s.ru = ru
+
+	ru.EXPECT().UpHost("ak", "xc2022").Return("up.qiniu.com", nil).AnyTimes()
+	ru.EXPECT().InitParts(gomock.Any(), gomock.Any(), "up.qiniu.com", "xc2022", "a.txt", true, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, upToken, upHost, bucket, key string, hasKey bool, ret *storage.InitPartsRet) error {
+			ret.UploadID = "id"
+			return nil
+		})
+	uploadId, err := s.initiateUpload(context.Background(), "a.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "id", uploadId)
+
+	_, err = s.uploadPart(context.Background(), "a.txt", uploadId, 1, bytes.NewReader([]byte("abc")), 3)
+	assert.Equal(t, file.ErrOffset, err)
+	_, err = s.uploadPart(context.Background(), "b.txt", uploadId, 0, bytes.NewReader([]byte("abc")), 3)
+	assert.Equal(t, file.ErrNotExist, err)
+
+	ru.EXPECT().UploadParts(gomock.Any(), gomock.Any(), "up.qiniu.com", "xc2022", "a.txt", true, "id", int64(1), "", gomock.Any(), gomock.Any(), 3).
+		Return(errors.New("broken"))
+	_, err = s.uploadPart(context.Background(), "a.txt", uploadId, 0, bytes.NewReader([]byte("abc")), 3)
+	assert.Error(t, err)
+	size, err := s.uploadStatus("a.txt", uploadId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), size)
+
+	ru.EXPECT().UploadParts(gomock.Any(), gomock.Any(), "up.qiniu.com", "xc2022", "a.txt", true, "id", int64(1), "", gomock.Any(), gomock.Any(), 3).
+		DoAndReturn(func(ctx context.Context, upToken, upHost, bucket, key string, hasKey bool, uploadId string, partNumber int64, partMD5 string, ret *storage.UploadPartsRet, body io.Reader, size int) error {
+			ret.Etag = "etag"
+			return nil
+		})
+	size, err = s.uploadPart(context.Background(), "a.txt", uploadId, 0, bytes.NewReader([]byte("abc")), 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), size)
+
+	ru.EXPECT().CompleteParts(gomock.Any(), gomock.Any(), "up.qiniu.com", gomock.Any(), "xc2022", "a.txt", true, "id", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, upToken, upHost string, ret interface{}, bucket, key string, hasKey bool, uploadId string, extra *storage.RputV2Extra) error {
+			assert.Equal(t, []storage.UploadPartInfo{{Etag: "etag", PartNumber: 1}}, extra.Progresses)
+			return nil
+		})
+	err = s.completeUpload(context.Background(), "a.txt", uploadId)
+	assert.NoError(t, err)
+	_, err = s.uploadStatus("a.txt", uploadId)
+	assert.Equal(t, file.ErrNotExist, err)
+	assert.Equal(t, file.ErrNotExist, s.abortUpload("a.txt", uploadId))
+}
+
 func newMockQiniuOSSClient(ak, sk, bucket, domain string, private bool, fu FormUploader, bm BucketManager) *QiniuOSSClient {
 	s := &QiniuOSSClient{
 		AccessKey: ak,
@@ -207,6 +283,7 @@ func newMockQiniuOSSClient(ak, sk, bucket, domain string, private bool, fu FormU
 		Private:   private,
 		bm:        bm,
 		mac:       qbox.NewMac("a", "b"),
+		uploads:   make(map[string]*multipartUpload),
 	}
 
 	return s
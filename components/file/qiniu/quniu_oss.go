@@ -149,6 +149,16 @@ func (q *QiniuOSS) Get(ctx context.Context, st *file.GetFileStu) (io.ReadCloser,
 	return client.get(ctx, st.FileName)
 }
 
+// GetRange gets a range of the file through the domain, or nothing if it is not modified.
+func (q *QiniuOSS) GetRange(ctx context.Context, req *file.GetRangeRequest) (*file.GetRangeResp, error) {
+	client, err := q.selectClient(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.getRange(ctx, req)
+}
+
 func (q *QiniuOSS) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
 	client, err := q.selectClient(st.Metadata)
 	if err != nil {
@@ -212,3 +222,85 @@ func (q *QiniuOSS) Stat(ctx context.Context, st *file.FileMetaRequest) (*file.Fi
 
 	return resp, nil
 }
+
+// Copy copies the file by the server-side copy of qiniu, and the target is overwritten if it exists.
+func (q *QiniuOSS) Copy(ctx context.Context, req *file.CopyRequest) error {
+	client, err := q.selectClient(req.Metadata)
+	if err != nil {
+		return err
+	}
+
+	return client.copy(ctx, req.SourceName, req.TargetName)
+}
+
+// Rename moves the file by the server-side move of qiniu, and the target is overwritten if it exists.
+func (q *QiniuOSS) Rename(ctx context.Context, req *file.RenameRequest) error {
+	client, err := q.selectClient(req.Metadata)
+	if err != nil {
+		return err
+	}
+
+	return client.move(ctx, req.SourceName, req.TargetName)
+}
+
+// InitiateUpload initiates a multipart upload.
+// The parts uploaded are kept in the memory of the runtime, so the upload can't be resumed after the runtime restarts.
+func (q *QiniuOSS) InitiateUpload(ctx context.Context, req *file.InitiateUploadRequest) (*file.InitiateUploadResp, error) {
+	client, err := q.selectClient(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadId, err := client.initiateUpload(ctx, req.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &file.InitiateUploadResp{UploadId: uploadId}, nil
+}
+
+func (q *QiniuOSS) UploadPart(ctx context.Context, req *file.UploadPartRequest) (*file.UploadStatusResp, error) {
+	client, err := q.selectClient(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := client.uploadPart(ctx, req.FileName, req.UploadId, req.Offset, req.DataStream, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &file.UploadStatusResp{CommittedSize: size}, nil
+}
+
+func (q *QiniuOSS) GetUploadStatus(ctx context.Context, req *file.UploadRequest) (*file.UploadStatusResp, error) {
+	client, err := q.selectClient(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := client.uploadStatus(req.FileName, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &file.UploadStatusResp{CommittedSize: size}, nil
+}
+
+func (q *QiniuOSS) CompleteUpload(ctx context.Context, req *file.UploadRequest) error {
+	client, err := q.selectClient(req.Metadata)
+	if err != nil {
+		return err
+	}
+
+	return client.completeUpload(ctx, req.FileName, req.UploadId)
+}
+
+func (q *QiniuOSS) AbortUpload(ctx context.Context, req *file.UploadRequest) error {
+	client, err := q.selectClient(req.Metadata)
+	if err != nil {
+		return err
+	}
+
+	return client.abortUpload(req.FileName, req.UploadId)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/tencentyun/cos-go-sdk-v5"
 
 	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/util"
 )
 
 const (
@@ -169,6 +170,45 @@ func (t *TencentCloudOSS) Get(ctx context.Context, st *file.GetFileStu) (io.Read
 	return clientResp.Body, nil
 }
 
+// GetRange gets a range of the object, or nothing if it is not modified.
+func (t *TencentCloudOSS) GetRange(ctx context.Context, st *file.GetRangeRequest) (*file.GetRangeResp, error) {
+	if err := t.checkFileName(st.FileName); err != nil {
+		return nil, err
+	}
+
+	client, err := t.selectClient(st.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &cos.ObjectGetOptions{Range: util.FormatRange(st.Offset, st.Length)}
+	if st.IfNoneMatch != "" {
+		opt.XOptionHeader = &http.Header{}
+		opt.XOptionHeader.Set("If-None-Match", st.IfNoneMatch)
+	}
+	if !st.IfModifiedSince.IsZero() {
+		opt.IfModifiedSince = st.IfModifiedSince.UTC().Format(http.TimeFormat)
+	}
+	clientResp, err := client.Object.Get(ctx, st.FileName, opt)
+	if err != nil {
+		if cosErr, ok := cos.IsCOSError(err); ok && cosErr.Response != nil {
+			switch cosErr.Response.StatusCode {
+			case http.StatusNotModified:
+				return &file.GetRangeResp{ETag: st.IfNoneMatch, NotModified: true}, nil
+			case http.StatusRequestedRangeNotSatisfiable:
+				return nil, file.ErrRange
+			}
+		}
+		return nil, err
+	}
+
+	return &file.GetRangeResp{
+		DataStream: clientResp.Body,
+		ETag:       clientResp.Header.Get("ETag"),
+		Size:       util.GetTotalSize(clientResp.Header.Get("Content-Range"), clientResp.ContentLength),
+	}, nil
+}
+
 func (t *TencentCloudOSS) List(ctx context.Context, st *file.ListRequest) (*file.ListResp, error) {
 	client, err := t.selectClient(st.Metadata)
 	if err != nil {
@@ -265,6 +305,168 @@ func (t *TencentCloudOSS) Stat(ctx context.Context, st *file.FileMetaRequest) (*
 	return resp, nil
 }
 
+// Copy copies the object by server-side copy, and the object larger than 5GB is copied in parts.
+func (t *TencentCloudOSS) Copy(ctx context.Context, req *file.CopyRequest) error {
+	if err := t.checkFileName(req.SourceName); err != nil {
+		return err
+	}
+	if err := t.checkFileName(req.TargetName); err != nil {
+		return err
+	}
+
+	client, err := t.selectClient(req.Metadata)
+	if err != nil {
+		return err
+	}
+
+	sourceURL := client.BaseURL.BucketURL.Host + "/" + req.SourceName
+	_, _, err = client.Object.MultiCopy(ctx, req.TargetName, sourceURL, &cos.MultiCopyOptions{})
+	if cos.IsNotFoundError(err) {
+		return file.ErrNotExist
+	}
+	return err
+}
+
+// Rename copies the object to the target and then deletes the source.
+func (t *TencentCloudOSS) Rename(ctx context.Context, req *file.RenameRequest) error {
+	err := t.Copy(ctx, &file.CopyRequest{SourceName: req.SourceName, TargetName: req.TargetName, Metadata: req.Metadata})
+	if err != nil {
+		return err
+	}
+	return t.Del(ctx, &file.DelRequest{FileName: req.SourceName, Metadata: req.Metadata})
+}
+
+// InitiateUpload creates a multipart upload.
+func (t *TencentCloudOSS) InitiateUpload(ctx context.Context, req *file.InitiateUploadRequest) (*file.InitiateUploadResp, error) {
+	if err := t.checkFileName(req.FileName); err != nil {
+		return nil, err
+	}
+	client, err := t.selectClient(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	result, _, err := client.Object.InitiateMultipartUpload(ctx, req.FileName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &file.InitiateUploadResp{UploadId: result.UploadID}, nil
+}
+
+// UploadPart uploads the part as the next part of the multipart upload.
+func (t *TencentCloudOSS) UploadPart(ctx context.Context, req *file.UploadPartRequest) (*file.UploadStatusResp, error) {
+	if err := t.checkFileName(req.FileName); err != nil {
+		return nil, err
+	}
+	client, err := t.selectClient(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	// the committed size is summed from the part sizes, so the size must be known
+	if req.Size <= 0 {
+		return nil, file.ErrPartSize
+	}
+	parts, err := t.listParts(ctx, client, req.FileName, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	committed := committedSize(parts)
+	if req.Offset != committed {
+		return nil, file.ErrOffset
+	}
+	opt := &cos.ObjectUploadPartOptions{ContentLength: req.Size}
+	_, err = client.Object.UploadPart(ctx, req.FileName, req.UploadId, len(parts)+1, req.DataStream, opt)
+	if err != nil {
+		return nil, err
+	}
+	return &file.UploadStatusResp{CommittedSize: committed + req.Size}, nil
+}
+
+// GetUploadStatus sums the sizes of the parts uploaded.
+func (t *TencentCloudOSS) GetUploadStatus(ctx context.Context, req *file.UploadRequest) (*file.UploadStatusResp, error) {
+	if err := t.checkFileName(req.FileName); err != nil {
+		return nil, err
+	}
+	client, err := t.selectClient(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	parts, err := t.listParts(ctx, client, req.FileName, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	return &file.UploadStatusResp{CommittedSize: committedSize(parts)}, nil
+}
+
+// CompleteUpload completes the multipart upload with all the parts uploaded.
+func (t *TencentCloudOSS) CompleteUpload(ctx context.Context, req *file.UploadRequest) error {
+	if err := t.checkFileName(req.FileName); err != nil {
+		return err
+	}
+	client, err := t.selectClient(req.Metadata)
+	if err != nil {
+		return err
+	}
+	parts, err := t.listParts(ctx, client, req.FileName, req.UploadId)
+	if err != nil {
+		return err
+	}
+	opt := &cos.CompleteMultipartUploadOptions{}
+	for _, part := range parts {
+		opt.Parts = append(opt.Parts, cos.Object{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+	_, _, err = client.Object.CompleteMultipartUpload(ctx, req.FileName, req.UploadId, opt)
+	return err
+}
+
+// AbortUpload aborts the multipart upload, and the parts uploaded are removed.
+func (t *TencentCloudOSS) AbortUpload(ctx context.Context, req *file.UploadRequest) error {
+	if err := t.checkFileName(req.FileName); err != nil {
+		return err
+	}
+	client, err := t.selectClient(req.Metadata)
+	if err != nil {
+		return err
+	}
+	_, err = client.Object.AbortMultipartUpload(ctx, req.FileName, req.UploadId)
+	if isNoSuchUpload(err) {
+		return file.ErrNotExist
+	}
+	return err
+}
+
+// listParts lists all the parts uploaded, in the order of part number.
+func (t *TencentCloudOSS) listParts(ctx context.Context, client *cos.Client, fileName string, uploadId string) ([]cos.Object, error) {
+	parts := make([]cos.Object, 0)
+	opt := &cos.ObjectListPartsOptions{}
+	for {
+		result, _, err := client.Object.ListParts(ctx, fileName, uploadId, opt)
+		if err != nil {
+			if isNoSuchUpload(err) {
+				return nil, file.ErrNotExist
+			}
+			return nil, err
+		}
+		parts = append(parts, result.Parts...)
+		if !result.IsTruncated {
+			return parts, nil
+		}
+		opt.PartNumberMarker = result.NextPartNumberMarker
+	}
+}
+
+func committedSize(parts []cos.Object) int64 {
+	var size int64
+	for _, part := range parts {
+		size += part.Size
+	}
+	return size
+}
+
+func isNoSuchUpload(err error) bool {
+	cosErr, ok := cos.IsCOSError(err)
+	return ok && cosErr.Code == "NoSuchUpload"
+}
+
 func (t *TencentCloudOSS) selectClient(meta map[string]string) (*cos.Client, error) {
 	var (
 		endpoint string
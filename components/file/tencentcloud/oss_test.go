@@ -188,6 +188,33 @@ func TestPut(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestResumableUpload(t *testing.T) {
+	fc := file.FileConfig{}
+	oss := NewTencentCloudOSS()
+	fc.Metadata = []byte(data)
+	err := oss.Init(context.TODO(), &fc)
+	assert.Nil(t, err)
+	uploader := oss.(file.ResumableUploader)
+
+	_, err = uploader.InitiateUpload(context.Background(), &file.InitiateUploadRequest{FileName: "/b/a.txt"})
+	assert.Equal(t, "invalid fileName format", err.Error())
+
+	_, err = uploader.UploadPart(context.Background(), &file.UploadPartRequest{
+		FileName:   "b/a.txt",
+		UploadId:   "id",
+		DataStream: strings.NewReader("aaaa"),
+		Metadata:   map[string]string{"endpoint": "other"},
+	})
+	assert.Equal(t, ErrClientNotExist, err)
+
+	_, err = uploader.UploadPart(context.Background(), &file.UploadPartRequest{
+		FileName:   "b/a.txt",
+		UploadId:   "id",
+		DataStream: strings.NewReader("aaaa"),
+	})
+	assert.Equal(t, file.ErrPartSize, err)
+}
+
 func TestStat(t *testing.T) {
 	fc := file.FileConfig{}
 	oss := NewTencentCloudOSS()
@@ -251,6 +278,10 @@ func TestGet(t *testing.T) {
 	resp, err = oss.Get(context.Background(), st)
 	assert.Error(t, err)
 	assert.Nil(t, resp)
+
+	rangeResp, err := oss.(file.RangeReader).GetRange(context.Background(), &file.GetRangeRequest{FileName: "a.txt", Offset: 1})
+	assert.Error(t, err)
+	assert.Nil(t, rangeResp)
 }
 
 func TestDel(t *testing.T) {
@@ -19,6 +19,7 @@ package file
 import (
 	"encoding/json"
 	"io"
+	"time"
 
 	"mosn.io/layotto/components/ref"
 )
@@ -41,11 +42,47 @@ type GetFileStu struct {
 	Metadata map[string]string
 }
 
+// GetRangeRequest reads a range of a file, or nothing if the file is not modified
+type GetRangeRequest struct {
+	FileName string
+	Metadata map[string]string
+	// Offset and Length are the range to read, and the rest of the file is read if Length is 0
+	Offset int64
+	Length int64
+	// The data is not read if the ETag of the file matches IfNoneMatch,
+	// or the file is not modified since IfModifiedSince when IfNoneMatch is empty
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
+
+type GetRangeResp struct {
+	// DataStream is nil if NotModified
+	DataStream io.ReadCloser
+	ETag       string
+	// Size is the size of the whole file rather than the range
+	Size        int64
+	NotModified bool
+}
+
 type DelRequest struct {
 	FileName string
 	Metadata map[string]string
 }
 
+// CopyRequest copies the file SourceName to TargetName in the same store
+type CopyRequest struct {
+	SourceName string
+	TargetName string
+	Metadata   map[string]string
+}
+
+// RenameRequest moves the file SourceName to TargetName in the same store
+type RenameRequest struct {
+	SourceName string
+	TargetName string
+	Metadata   map[string]string
+}
+
 type ListRequest struct {
 	DirectoryName string
 	Marker        string
@@ -76,3 +113,34 @@ type FileMetaResp struct {
 	LastModified string
 	Metadata     map[string][]string
 }
+
+type InitiateUploadRequest struct {
+	FileName string
+	Metadata map[string]string
+}
+
+type InitiateUploadResp struct {
+	UploadId string
+}
+
+type UploadPartRequest struct {
+	FileName string
+	UploadId string
+	// Offset must be the committed size of the upload
+	Offset int64
+	// Size is the size of the part, which is required by the stores using native multipart uploads
+	Size       int64
+	DataStream io.Reader
+	Metadata   map[string]string
+}
+
+type UploadRequest struct {
+	FileName string
+	UploadId string
+	Metadata map[string]string
+}
+
+type UploadStatusResp struct {
+	// CommittedSize is the size of the data uploaded successfully, which is the offset of the next part
+	CommittedSize int64
+}
@@ -17,12 +17,18 @@
 package util
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
 	ETag = "ETag"
+
+	uploadIdLength = 16
 )
 
 func GetBucketName(fileName string) (string, error) {
@@ -52,3 +58,62 @@ func GetFileName(fileName string) (string, error) {
 	}
 	return name, nil
 }
+
+// GenerateUploadId generates a random id for the stores without native multipart uploads.
+func GenerateUploadId() (string, error) {
+	b := make([]byte, uploadIdLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// GetStagingFileName returns the name of the file holding the data uploaded, which is renamed to fileName when the upload completes.
+func GetStagingFileName(fileName string, uploadId string) (string, error) {
+	if b, err := hex.DecodeString(uploadId); err != nil || len(b) != uploadIdLength {
+		return "", fmt.Errorf("invalid upload id")
+	}
+	return fileName + "." + uploadId + ".uploading", nil
+}
+
+// FormatRange returns the value of the Range header, which is empty if the whole file is read.
+func FormatRange(offset int64, length int64) string {
+	if offset == 0 && length == 0 {
+		return ""
+	}
+	if length == 0 {
+		return fmt.Sprintf("bytes=%d-", offset)
+	}
+	return fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+}
+
+// GetTotalSize returns the size of the whole file from the Content-Range header like "bytes 0-9/100",
+// or contentLength if the whole file is returned.
+func GetTotalSize(contentRange string, contentLength int64) int64 {
+	index := strings.LastIndex(contentRange, "/")
+	if index == -1 {
+		return contentLength
+	}
+	size, err := strconv.ParseInt(contentRange[index+1:], 10, 64)
+	if err != nil {
+		return contentLength
+	}
+	return size
+}
+
+// GenerateETag generates the ETag of a file from its modified time and size for the stores without native ETags.
+func GenerateETag(modTime time.Time, size int64) string {
+	return fmt.Sprintf("\"%x-%x\"", modTime.UnixNano(), size)
+}
+
+// IsNotModified checks the conditions of a get request like HTTP, where ifModifiedSince is ignored if ifNoneMatch is not empty.
+func IsNotModified(etag string, modTime time.Time, ifNoneMatch string, ifModifiedSince time.Time) bool {
+	if ifNoneMatch != "" {
+		return ifNoneMatch == "*" || strings.Trim(ifNoneMatch, "\"") == strings.Trim(etag, "\"")
+	}
+	if ifModifiedSince.IsZero() {
+		return false
+	}
+	// the modified time is in seconds in HTTP
+	return !modTime.Truncate(time.Second).After(ifModifiedSince)
+}
@@ -18,6 +18,7 @@ package util
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -45,3 +46,36 @@ func TestGetFileName(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "aaa", name)
 }
+
+func TestGetStagingFileName(t *testing.T) {
+	id, err := GenerateUploadId()
+	assert.Nil(t, err)
+	name, err := GetStagingFileName("a/b.txt", id)
+	assert.Nil(t, err)
+	assert.Equal(t, "a/b.txt."+id+".uploading", name)
+	_, err = GetStagingFileName("a/b.txt", "../../etc/passwd")
+	assert.Equal(t, "invalid upload id", err.Error())
+}
+
+func TestFormatRange(t *testing.T) {
+	assert.Equal(t, "", FormatRange(0, 0))
+	assert.Equal(t, "bytes=10-", FormatRange(10, 0))
+	assert.Equal(t, "bytes=0-9", FormatRange(0, 10))
+}
+
+func TestGetTotalSize(t *testing.T) {
+	assert.Equal(t, int64(100), GetTotalSize("bytes 0-9/100", 10))
+	assert.Equal(t, int64(10), GetTotalSize("", 10))
+	assert.Equal(t, int64(10), GetTotalSize("bytes 0-9/*", 10))
+}
+
+func TestIsNotModified(t *testing.T) {
+	modTime := time.Unix(100, 500)
+	etag := GenerateETag(modTime, 10)
+	assert.False(t, IsNotModified(etag, modTime, "", time.Time{}))
+	assert.True(t, IsNotModified(etag, modTime, etag, time.Time{}))
+	assert.True(t, IsNotModified(etag, modTime, "*", time.Time{}))
+	assert.False(t, IsNotModified(etag, modTime, "\"other\"", time.Unix(200, 0)))
+	assert.True(t, IsNotModified(etag, modTime, "", time.Unix(100, 0)))
+	assert.False(t, IsNotModified(etag, modTime, "", time.Unix(99, 0)))
+}
@@ -14,9 +14,11 @@ package consul
 
 import (
 	"context"
+	"fmt"
 	"runtime"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	msync "mosn.io/mosn/pkg/sync"
@@ -26,6 +28,16 @@ import (
 	"mosn.io/layotto/components/pkg/utils"
 )
 
+// waitQueueSuffix is appended to the lock key to get the prefix of the waiting queue.
+// It's safe because a resource id can't contain "||".
+const waitQueueSuffix = "||waiters/"
+
+// lockSession is the consul session bound to a lock.
+// A new one is stored every time the lock is acquired or renewed.
+type lockSession struct {
+	id string
+}
+
 type ConsulLock struct {
 	metadata       utils.ConsulMetadata
 	logger         log.ErrorLogger
@@ -61,13 +73,48 @@ func (c *ConsulLock) Init(metadata lock.Metadata) error {
 	return nil
 }
 func (c *ConsulLock) Features() []lock.Feature {
-	return nil
+	return []lock.Feature{lock.FeatureFencingToken}
 }
 
-// LockKeepAlive try to renewal lease
-func (c *ConsulLock) LockKeepAlive(ctx context.Context, request *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
-	//TODO: implemnt function
-	return nil, nil
+// LockKeepAlive try to renewal lease.
+// The TTL of a consul session can't be modified, so the session is renewed with the TTL used in TryLock.
+func (c *ConsulLock) LockKeepAlive(ctx context.Context, req *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
+	resp := &lock.LockKeepAliveResponse{
+		ResourceId: req.ResourceId,
+	}
+	// find the session which holds the lock
+	pair, _, err := c.kv.Get(req.ResourceId, nil)
+	if err != nil {
+		resp.Status = lock.INTERNAL_ERROR
+		return resp, err
+	}
+	if pair == nil || pair.Session == "" {
+		resp.Status = lock.LOCK_UNEXIST
+		return resp, nil
+	}
+	key := req.LockOwner + "-" + req.ResourceId
+	session, ok := c.sMap.Load(key)
+	if !ok || session.(*lockSession).id != pair.Session {
+		resp.Status = lock.LOCK_BELONG_TO_OTHERS
+		return resp, nil
+	}
+	// renew the session
+	entry, _, err := c.sessionFactory.Renew(pair.Session, nil)
+	if err != nil {
+		resp.Status = lock.INTERNAL_ERROR
+		return resp, err
+	}
+	// the session has been invalidated
+	if entry == nil {
+		resp.Status = lock.LOCK_UNEXIST
+		return resp, nil
+	}
+	renewed := &lockSession{id: pair.Session}
+	c.sMap.Store(key, renewed)
+	c.workPool.Schedule(generateGCTask(req.Expire, &c.sMap, key, renewed))
+	resp.Status = lock.SUCCESS
+	resp.FencingToken = int64(pair.ModifyIndex)
+	return resp, nil
 }
 
 func getTTL(expire int32) string {
@@ -79,39 +126,171 @@ func getTTL(expire int32) string {
 }
 
 func (c *ConsulLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	_, resp, err := c.acquire(req.ResourceId, req.LockOwner, req.Expire)
+	return resp, err
+}
+
+// Lock waits for a consul lock.
+// Waiters acquire keys under a queue prefix of the lock with their own sessions, and only the waiter
+// with the smallest create index competes for the lock, so that waiters acquire the lock in FIFO order.
+// Changes of the queue and the lock are watched by blocking queries.
+func (c *ConsulLock) Lock(ctx context.Context, req *lock.LockRequest) (*lock.LockResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, req.WaitTimeout)
+	defer cancel()
+
+	//1.join the waiting queue
+	// the queue key is deleted when the session is invalidated, in case the runtime crashes while waiting
+	waitSession, _, err := c.sessionFactory.Create(&api.SessionEntry{
+		TTL:       getTTL(int32(req.WaitTimeout/time.Second) + 1),
+		LockDelay: 0,
+		Behavior:  "delete",
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if _, err := c.sessionFactory.Destroy(waitSession, nil); err != nil {
+			c.logger.Errorf("consul lock session destroy error: %v", err)
+		}
+	}()
+	queuePrefix := req.ResourceId + waitQueueSuffix
+	queueKey := queuePrefix + waitSession
+	joined, _, err := c.kv.Acquire(&api.KVPair{Key: queueKey, Value: []byte(req.LockOwner), Session: waitSession}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !joined {
+		return nil, fmt.Errorf("consul lock failed to join the waiting queue of %s", req.ResourceId)
+	}
+
+	//2.wait until the waiters in front of us are gone
+	var waitIndex uint64
+	for {
+		pairs, meta, err := c.kv.List(queuePrefix, blockingQuery(ctx, waitIndex))
+		if err != nil {
+			return lockFailed(ctx, err)
+		}
+		head, err := isQueueHead(pairs, queueKey)
+		if err != nil {
+			return nil, err
+		}
+		if head {
+			break
+		}
+		waitIndex = meta.LastIndex
+	}
+
+	//3.we are the head of the queue, compete for the lock until it's released by the current owner
+	waitIndex = 0
+	for {
+		session, resp, err := c.acquire(req.ResourceId, req.LockOwner, req.Expire)
+		if err != nil {
+			return lockFailed(ctx, err)
+		}
+		if resp.Success {
+			return &lock.LockResponse{
+				Success:      true,
+				FencingToken: resp.FencingToken,
+			}, nil
+		}
+		// the session is useless if the lock is held by others
+		if _, err = c.sessionFactory.Destroy(session, nil); err != nil {
+			c.logger.Errorf("consul lock session destroy error: %v", err)
+		}
+		_, meta, err := c.kv.Get(req.ResourceId, blockingQuery(ctx, waitIndex))
+		if err != nil {
+			return lockFailed(ctx, err)
+		}
+		waitIndex = meta.LastIndex
+	}
+}
+
+// acquire creates a session with the ttl and acquires the lock with it.
+// The session is only bound to the lock if the lock is acquired.
+func (c *ConsulLock) acquire(resourceId string, owner string, expire int32) (string, *lock.TryLockResponse, error) {
 
 	// create a session TTL
 	session, _, err := c.sessionFactory.Create(&api.SessionEntry{
-		TTL:       getTTL(req.Expire),
+		TTL:       getTTL(expire),
 		LockDelay: 0,
 		Behavior:  "delete", //Controls the behavior to delete when a session is invalidated.
 	}, nil)
 
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
 
 	// put a new KV pair with ttl session
-	p := &api.KVPair{Key: req.ResourceId, Value: []byte(req.LockOwner), Session: session}
+	p := &api.KVPair{Key: resourceId, Value: []byte(owner), Session: session}
 	//acquire lock
 	acquire, _, err := c.kv.Acquire(p, nil)
 
 	if err != nil {
-		return nil, err
+		return session, nil, err
 	}
 
 	if acquire {
+		//the modify index of the acquired pair is used as fencing token,
+		//which is the raft index increased monotonically in the whole consul cluster
+		pair, _, err := c.kv.Get(resourceId, nil)
+		if err != nil {
+			return session, nil, err
+		}
+		if pair == nil || pair.Session != session {
+			return session, &lock.TryLockResponse{
+				Success: false,
+			}, nil
+		}
 		//bind lockOwner+resourceId and session
-		c.sMap.Store(req.LockOwner+"-"+req.ResourceId, session)
-		c.workPool.Schedule(generateGCTask(req.Expire, &c.sMap, req.LockOwner+"-"+req.ResourceId))
-		return &lock.TryLockResponse{
-			Success: true,
+		value := &lockSession{id: session}
+		c.sMap.Store(owner+"-"+resourceId, value)
+		c.workPool.Schedule(generateGCTask(expire, &c.sMap, owner+"-"+resourceId, value))
+		return session, &lock.TryLockResponse{
+			Success:      true,
+			FencingToken: int64(pair.ModifyIndex),
 		}, nil
 	}
-	return &lock.TryLockResponse{
+	return session, &lock.TryLockResponse{
 		Success: false,
 	}, nil
 }
+
+// blockingQuery returns the options of a consul blocking query which waits for changes since the index until the ctx is done
+func blockingQuery(ctx context.Context, waitIndex uint64) *api.QueryOptions {
+	q := &api.QueryOptions{WaitIndex: waitIndex}
+	if deadline, ok := ctx.Deadline(); ok {
+		q.WaitTime = time.Until(deadline)
+	}
+	return q.WithContext(ctx)
+}
+
+// isQueueHead checks whether the queue key has the smallest create index among the waiters
+func isQueueHead(pairs api.KVPairs, queueKey string) (bool, error) {
+	var mine *api.KVPair
+	for _, pair := range pairs {
+		if pair.Key == queueKey {
+			mine = pair
+		}
+	}
+	if mine == nil {
+		return false, fmt.Errorf("consul lock waiting queue key %s is lost", queueKey)
+	}
+	for _, pair := range pairs {
+		if pair.CreateIndex < mine.CreateIndex {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// lockFailed returns a failed response without error if the wait timeout elapses
+func lockFailed(ctx context.Context, err error) (*lock.LockResponse, error) {
+	if ctx.Err() != nil {
+		return &lock.LockResponse{Success: false}, nil
+	}
+	return nil, err
+}
+
 func (c *ConsulLock) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
 
 	session, ok := c.sMap.Load(req.LockOwner + "-" + req.ResourceId)
@@ -119,8 +298,9 @@ func (c *ConsulLock) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lock
 	if !ok {
 		return &lock.UnlockResponse{Status: lock.LOCK_UNEXIST}, nil
 	}
+	sessionId := session.(*lockSession).id
 	// put a new KV pair with ttl session
-	p := &api.KVPair{Key: req.ResourceId, Value: []byte(req.LockOwner), Session: session.(string)}
+	p := &api.KVPair{Key: req.ResourceId, Value: []byte(req.LockOwner), Session: sessionId}
 	//release lock
 	release, _, err := c.kv.Release(p, nil)
 
@@ -130,7 +310,7 @@ func (c *ConsulLock) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lock
 
 	if release {
 		c.sMap.Delete(req.LockOwner + "-" + req.ResourceId)
-		_, err = c.sessionFactory.Destroy(session.(string), nil)
+		_, err = c.sessionFactory.Destroy(sessionId, nil)
 		if err != nil {
 			c.logger.Errorf("consul lock session destroy error: %v", err)
 		}
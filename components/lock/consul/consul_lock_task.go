@@ -24,10 +24,11 @@ import (
 type task func()
 
 // generate a GC task which delete element in the map after specific ttl
-func generateGCTask(ttl int32, m *sync.Map, key string) task {
+func generateGCTask(ttl int32, m *sync.Map, key string, value *lockSession) task {
 	return func() {
 		time.Sleep(time.Second * time.Duration(ttl))
-		//may delete the second lock,but not affect the result
-		m.Delete(key)
+		//only delete the element stored together with this task,
+		//so a renewed or re-acquired lock won't be deleted by a stale task
+		m.CompareAndDelete(key, value)
 	}
 }
@@ -15,6 +15,7 @@ package consul
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/consul/api"
@@ -46,7 +47,7 @@ func TestConsulLock_InitWithWrongConfig(t *testing.T) {
 // Test features
 func TestConsulLock_Features(t *testing.T) {
 	comp := NewConsulLock(log.DefaultLogger)
-	assert.True(t, len(comp.Features()) == 0)
+	assert.True(t, lock.FeatureFencingToken.IsPresent(comp.Features()))
 }
 
 // A lock A unlock
@@ -72,6 +73,8 @@ func TestConsulLock_TryLock(t *testing.T) {
 	factory.EXPECT().Destroy("session1", nil).Return(nil, nil).Times(1)
 	kv.EXPECT().Acquire(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1"}, nil).
 		Return(true, nil, nil).Times(1)
+	kv.EXPECT().Get(resouseId, nil).
+		Return(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1", ModifyIndex: 10}, nil, nil).Times(1)
 	kv.EXPECT().Release(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1"}, nil).
 		Return(true, nil, nil).Times(1)
 
@@ -83,6 +86,7 @@ func TestConsulLock_TryLock(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, true, tryLock.Success)
+	assert.Equal(t, int64(10), tryLock.FencingToken)
 
 	unlock, err := comp.Unlock(context.TODO(), &lock.UnlockRequest{
 		ResourceId: resouseId,
@@ -118,6 +122,8 @@ func TestConsulLock_ALock_BLock(t *testing.T) {
 		Return("session2", nil, nil).Times(1)
 	kv.EXPECT().Acquire(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1"}, nil).
 		Return(true, nil, nil).Times(1)
+	kv.EXPECT().Get(resouseId, nil).
+		Return(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1", ModifyIndex: 10}, nil, nil).Times(1)
 	kv.EXPECT().Acquire(&api.KVPair{Key: resouseId, Value: []byte(lockOwerB), Session: "session2"}, nil).
 		Return(false, nil, nil).Times(1)
 
@@ -163,6 +169,8 @@ func TestConsulLock_ALock_BUnlock(t *testing.T) {
 	factory.EXPECT().Destroy("session1", nil).Return(nil, nil).Times(1)
 	kv.EXPECT().Acquire(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1"}, nil).
 		Return(true, nil, nil).Times(1)
+	kv.EXPECT().Get(resouseId, nil).
+		Return(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1", ModifyIndex: 10}, nil, nil).Times(1)
 	kv.EXPECT().Release(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1"}, nil).
 		Return(true, nil, nil).Times(1)
 
@@ -190,9 +198,190 @@ func TestConsulLock_ALock_BUnlock(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, lock.SUCCESS, unlock2.Status)
+}
+
+// A lock A keepalive B keepalive
+func TestConsulLock_LockKeepAlive(t *testing.T) {
+	//mock
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockConsulClient(ctrl)
+	factory := mock.NewMockSessionFactory(ctrl)
+	kv := mock.NewMockConsulKV(ctrl)
+
+	comp := NewConsulLock(log.DefaultLogger)
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["address"] = "127.0.0.1:8500"
+	err := comp.Init(cfg)
+	assert.Nil(t, err)
+	comp.client = client
+	comp.sessionFactory = factory
+	comp.kv = kv
+	pair := &api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1", ModifyIndex: 10}
+	factory.EXPECT().Create(&api.SessionEntry{TTL: getTTL(expireTime), LockDelay: 0, Behavior: "delete"}, nil).
+		Return("session1", nil, nil).Times(1)
+	factory.EXPECT().Renew("session1", nil).Return(&api.SessionEntry{ID: "session1"}, nil, nil).Times(1)
+	kv.EXPECT().Acquire(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1"}, nil).
+		Return(true, nil, nil).Times(1)
+	kv.EXPECT().Get(resouseId, nil).Return(pair, nil, nil).Times(3)
+	kv.EXPECT().Get(resouseId+"1", nil).Return(nil, nil, nil).Times(1)
+
+	tryLock, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resouseId,
+		LockOwner:  lockOwerA,
+		Expire:     expireTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, true, tryLock.Success)
+
+	// A renews the lease
+	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resouseId,
+		LockOwner:  lockOwerA,
+		Expire:     expireTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, keepAliveResp.Status)
+	assert.Equal(t, tryLock.FencingToken, keepAliveResp.FencingToken)
+
+	// B can't renew the lease
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resouseId,
+		LockOwner:  lockOwerB,
+		Expire:     expireTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_BELONG_TO_OTHERS, keepAliveResp.Status)
+
+	// lock not exist
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resouseId + "1",
+		LockOwner:  lockOwerA,
+		Expire:     expireTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_UNEXIST, keepAliveResp.Status)
+}
+
+// A waits behind another waiter and the current owner, then gets the lock
+func TestConsulLock_Lock(t *testing.T) {
+	//mock
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockConsulClient(ctrl)
+	factory := mock.NewMockSessionFactory(ctrl)
+	kv := mock.NewMockConsulKV(ctrl)
+
+	comp := NewConsulLock(log.DefaultLogger)
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["address"] = "127.0.0.1:8500"
+	err := comp.Init(cfg)
+	assert.Nil(t, err)
+	comp.client = client
+	comp.sessionFactory = factory
+	comp.kv = kv
+
+	queuePrefix := resouseId + waitQueueSuffix
+	queueKey := queuePrefix + "wait"
+	gomock.InOrder(
+		factory.EXPECT().Create(&api.SessionEntry{TTL: getTTL(2), LockDelay: 0, Behavior: "delete"}, nil).
+			Return("wait", nil, nil),
+		kv.EXPECT().Acquire(&api.KVPair{Key: queueKey, Value: []byte(lockOwerA), Session: "wait"}, nil).
+			Return(true, nil, nil),
+		kv.EXPECT().List(queuePrefix, gomock.Any()).
+			Return(api.KVPairs{{Key: queuePrefix + "other", CreateIndex: 1}, {Key: queueKey, CreateIndex: 2}}, &api.QueryMeta{LastIndex: 3}, nil),
+		kv.EXPECT().List(queuePrefix, gomock.Any()).
+			DoAndReturn(func(prefix string, q *api.QueryOptions) (api.KVPairs, *api.QueryMeta, error) {
+				assert.Equal(t, uint64(3), q.WaitIndex)
+				return api.KVPairs{{Key: queueKey, CreateIndex: 2}}, &api.QueryMeta{LastIndex: 4}, nil
+			}),
+		// the lock is held by others
+		factory.EXPECT().Create(&api.SessionEntry{TTL: getTTL(expireTime), LockDelay: 0, Behavior: "delete"}, nil).
+			Return("session1", nil, nil),
+		kv.EXPECT().Acquire(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1"}, nil).
+			Return(false, nil, nil),
+		factory.EXPECT().Destroy("session1", nil).Return(nil, nil),
+		kv.EXPECT().Get(resouseId, gomock.Not(gomock.Nil())).
+			Return(&api.KVPair{Key: resouseId, Value: []byte(lockOwerB), Session: "sessionB"}, &api.QueryMeta{LastIndex: 5}, nil),
+		factory.EXPECT().Create(&api.SessionEntry{TTL: getTTL(expireTime), LockDelay: 0, Behavior: "delete"}, nil).
+			Return("session2", nil, nil),
+		kv.EXPECT().Acquire(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session2"}, nil).
+			Return(false, nil, nil),
+		factory.EXPECT().Destroy("session2", nil).Return(nil, nil),
+		kv.EXPECT().Get(resouseId, gomock.Not(gomock.Nil())).
+			DoAndReturn(func(key string, q *api.QueryOptions) (*api.KVPair, *api.QueryMeta, error) {
+				assert.Equal(t, uint64(5), q.WaitIndex)
+				return nil, &api.QueryMeta{LastIndex: 6}, nil
+			}),
+		// the lock is released
+		factory.EXPECT().Create(&api.SessionEntry{TTL: getTTL(expireTime), LockDelay: 0, Behavior: "delete"}, nil).
+			Return("session3", nil, nil),
+		kv.EXPECT().Acquire(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session3"}, nil).
+			Return(true, nil, nil),
+		kv.EXPECT().Get(resouseId, nil).
+			Return(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session3", ModifyIndex: 7}, nil, nil),
+		factory.EXPECT().Destroy("wait", nil).Return(nil, nil),
+	)
+
+	resp, err := comp.Lock(context.TODO(), &lock.LockRequest{
+		ResourceId:  resouseId,
+		LockOwner:   lockOwerA,
+		Expire:      expireTime,
+		WaitTimeout: time.Second,
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(7), resp.FencingToken)
+}
+
+// A waits for the lock held by B until timeout
+func TestConsulLock_LockTimeout(t *testing.T) {
+	//mock
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockConsulClient(ctrl)
+	factory := mock.NewMockSessionFactory(ctrl)
+	kv := mock.NewMockConsulKV(ctrl)
 
-	// not implement LockKeepAlive
-	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{})
-	assert.Nil(t, keepAliveResp)
+	comp := NewConsulLock(log.DefaultLogger)
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["address"] = "127.0.0.1:8500"
+	err := comp.Init(cfg)
 	assert.Nil(t, err)
+	comp.client = client
+	comp.sessionFactory = factory
+	comp.kv = kv
+
+	queuePrefix := resouseId + waitQueueSuffix
+	queueKey := queuePrefix + "wait"
+	factory.EXPECT().Create(&api.SessionEntry{TTL: getTTL(1), LockDelay: 0, Behavior: "delete"}, nil).
+		Return("wait", nil, nil).Times(1)
+	kv.EXPECT().Acquire(&api.KVPair{Key: queueKey, Value: []byte(lockOwerA), Session: "wait"}, nil).
+		Return(true, nil, nil).Times(1)
+	kv.EXPECT().List(queuePrefix, gomock.Any()).
+		Return(api.KVPairs{{Key: queueKey, CreateIndex: 2}}, &api.QueryMeta{LastIndex: 3}, nil).Times(1)
+	factory.EXPECT().Create(&api.SessionEntry{TTL: getTTL(expireTime), LockDelay: 0, Behavior: "delete"}, nil).
+		Return("session1", nil, nil).AnyTimes()
+	kv.EXPECT().Acquire(&api.KVPair{Key: resouseId, Value: []byte(lockOwerA), Session: "session1"}, nil).
+		Return(false, nil, nil).AnyTimes()
+	factory.EXPECT().Destroy("session1", nil).Return(nil, nil).AnyTimes()
+	// the blocking query returns when the wait timeout elapses
+	kv.EXPECT().Get(resouseId, gomock.Not(gomock.Nil())).
+		DoAndReturn(func(key string, q *api.QueryOptions) (*api.KVPair, *api.QueryMeta, error) {
+			<-q.Context().Done()
+			return nil, nil, q.Context().Err()
+		}).AnyTimes()
+	factory.EXPECT().Destroy("wait", nil).Return(nil, nil).Times(1)
+
+	resp, err := comp.Lock(context.TODO(), &lock.LockRequest{
+		ResourceId:  resouseId,
+		LockOwner:   lockOwerA,
+		Expire:      expireTime,
+		WaitTimeout: 100 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
 }
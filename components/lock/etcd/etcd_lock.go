@@ -15,7 +15,11 @@ package etcd
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"mosn.io/layotto/components/pkg/utils"
@@ -25,6 +29,14 @@ import (
 	"mosn.io/layotto/components/lock"
 )
 
+// waitQueueSuffix is appended to the lock key to get the prefix of the waiting queue.
+// It's safe because a resource id can't contain "||".
+const waitQueueSuffix = "||waiters/"
+
+// holdersSuffix is appended to the lock key to get the prefix of the holder keys.
+// The value of a holder key is the times the owner has acquired the lock.
+const holdersSuffix = "||holders/"
+
 // Etcd lock store
 type EtcdLock struct {
 	client   *clientv3.Client
@@ -40,7 +52,7 @@ type EtcdLock struct {
 // NewEtcdLock returns a new etcd lock
 func NewEtcdLock(logger log.ErrorLogger) *EtcdLock {
 	s := &EtcdLock{
-		features: make([]lock.Feature, 0),
+		features: []lock.Feature{lock.FeatureFencingToken, lock.FeatureSharedLock, lock.FeatureReentrantLock},
 		logger:   logger,
 	}
 
@@ -65,10 +77,44 @@ func (e *EtcdLock) Init(metadata lock.Metadata) error {
 	return err
 }
 
-// LockKeepAlive try to renewal lease
-func (e *EtcdLock) LockKeepAlive(ctx context.Context, request *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
-	//TODO: implemnt function
-	return nil, nil
+// LockKeepAlive try to renewal lease.
+// The ttl of an etcd lease can't be modified, so the lease is renewed with the expire time used in TryLock.
+func (e *EtcdLock) LockKeepAlive(ctx context.Context, req *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
+	resp := &lock.LockKeepAliveResponse{
+		ResourceId: req.ResourceId,
+	}
+	// 1.Get current lock
+	state, err := e.getState(req.ResourceId, req.LockOwner)
+	if err != nil {
+		resp.Status = lock.INTERNAL_ERROR
+		return resp, err
+	}
+	var kv *mvccpb.KeyValue
+	switch {
+	case state.exclusive(req.LockOwner):
+		kv = state.lock
+	case state.holder != nil:
+		kv = state.holder
+	case state.lock == nil && state.holders == 0:
+		resp.Status = lock.LOCK_UNEXIST
+		return resp, nil
+	default:
+		resp.Status = lock.LOCK_BELONG_TO_OTHERS
+		return resp, nil
+	}
+	//2.Renew the lease which the lock is attached to
+	_, err = e.client.KeepAliveOnce(e.ctx, clientv3.LeaseID(kv.Lease))
+	if err != nil {
+		if err == rpctypes.ErrLeaseNotFound {
+			resp.Status = lock.LOCK_UNEXIST
+			return resp, nil
+		}
+		resp.Status = lock.INTERNAL_ERROR
+		return resp, fmt.Errorf("[etcdLock]: Renew lease returned error: %s.ResourceId: %s", err, req.ResourceId)
+	}
+	resp.Status = lock.SUCCESS
+	resp.FencingToken = kv.CreateRevision
+	return resp, nil
 }
 
 // Features is to get EtcdLock's features
@@ -78,61 +124,321 @@ func (e *EtcdLock) Features() []lock.Feature {
 
 // Node tries to acquire a etcd lock
 func (e *EtcdLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	if req.Reentrant {
+		resp, err := e.reenter(req)
+		if resp != nil || err != nil {
+			return resp, err
+		}
+	}
+	txnResponse, err := e.acquire(req.ResourceId, req.LockOwner, req.Expire, req.Mode)
+	if err != nil {
+		return &lock.TryLockResponse{}, err
+	}
+
+	if !txnResponse.Succeeded {
+		return &lock.TryLockResponse{
+			Success: false,
+		}, nil
+	}
+	// the revision of the txn is the create revision of the lock key or the holder key,
+	// which is increased monotonically in the whole etcd cluster
+	return &lock.TryLockResponse{
+		Success:      true,
+		FencingToken: txnResponse.Header.Revision,
+	}, nil
+}
+
+// Lock waits for a etcd lock.
+// Waiters put keys attached to their own leases under a queue prefix of the lock key.
+// Only the waiter with the smallest create revision competes for the lock, so that waiters acquire the lock in FIFO order.
+func (e *EtcdLock) Lock(ctx context.Context, req *lock.LockRequest) (*lock.LockResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, req.WaitTimeout)
+	defer cancel()
+
+	//1.Join the waiting queue
+	// the queue key is removed when the lease expires, in case the runtime crashes while waiting
+	ttl := int64(req.WaitTimeout/time.Second) + 1
+	leaseGrantResp, err := e.client.Grant(e.ctx, ttl)
+	if err != nil {
+		return &lock.LockResponse{}, fmt.Errorf("[etcdLock]: Create new lease returned error: %s.ResourceId: %s", err, req.ResourceId)
+	}
+	defer e.client.Revoke(e.ctx, leaseGrantResp.ID)
+
+	queuePrefix := e.getQueuePrefix(req.ResourceId)
+	queueKey := fmt.Sprintf("%s%x", queuePrefix, leaseGrantResp.ID)
+	putResp, err := e.client.Put(e.ctx, queueKey, req.LockOwner, clientv3.WithLease(leaseGrantResp.ID))
+	if err != nil {
+		return &lock.LockResponse{}, fmt.Errorf("[etcdLock]: Join waiting queue returned error: %s.ResourceId: %s", err, req.ResourceId)
+	}
+
+	//2.Wait until the waiters in front of us are gone
+	for {
+		getResp, err := e.client.Get(ctx, queuePrefix, clientv3.WithPrefix(),
+			clientv3.WithMaxCreateRev(putResp.Header.Revision-1),
+			clientv3.WithSort(clientv3.SortByCreateRevision, clientv3.SortDescend),
+			clientv3.WithLimit(1))
+		if err != nil {
+			return e.lockFailed(ctx, req.ResourceId, err)
+		}
+		if len(getResp.Kvs) == 0 {
+			break
+		}
+		if err = e.waitDelete(ctx, string(getResp.Kvs[0].Key), getResp.Header.Revision+1); err != nil {
+			return e.lockFailed(ctx, req.ResourceId, err)
+		}
+	}
+
+	//3.We are the head of the queue, compete for the lock until it's released by the current owner
+	for {
+		txnResponse, err := e.acquire(req.ResourceId, req.LockOwner, req.Expire, lock.EXCLUSIVE)
+		if err != nil {
+			return e.lockFailed(ctx, req.ResourceId, err)
+		}
+		if txnResponse.Succeeded {
+			return &lock.LockResponse{
+				Success:      true,
+				FencingToken: txnResponse.Header.Revision,
+			}, nil
+		}
+		// the lock may be held by shared holders, so watch the holder keys as well
+		if err = e.waitDelete(ctx, e.getKey(req.ResourceId), txnResponse.Header.Revision+1, clientv3.WithPrefix()); err != nil {
+			return e.lockFailed(ctx, req.ResourceId, err)
+		}
+	}
+}
+
+// acquire puts the holder key attached to a new lease if nobody holds the lock exclusively.
+// An exclusive lock also puts the lock key, and it requires that there are no holders at all.
+func (e *EtcdLock) acquire(resourceId string, owner string, expire int32, mode lock.LockMode) (*clientv3.TxnResponse, error) {
 	var leaseId clientv3.LeaseID
 	//1.Create new lease
 	lease := clientv3.NewLease(e.client)
-	leaseGrantResp, err := lease.Grant(e.ctx, int64(req.Expire))
+	leaseGrantResp, err := lease.Grant(e.ctx, int64(expire))
 	if err != nil {
-		return &lock.TryLockResponse{}, fmt.Errorf("[etcdLock]: Create new lease returned error: %s.ResourceId: %s", err, req.ResourceId)
+		return nil, fmt.Errorf("[etcdLock]: Create new lease returned error: %s.ResourceId: %s", err, resourceId)
 	}
 	leaseId = leaseGrantResp.ID
 
-	key := e.getKey(req.ResourceId)
+	key := e.getKey(resourceId)
+	holderKey := e.getHolderKey(resourceId, owner)
+	cmps := []clientv3.Cmp{clientv3.Compare(clientv3.CreateRevision(key), "=", 0)}
+	ops := []clientv3.Op{clientv3.OpPut(holderKey, "1", clientv3.WithLease(leaseId))}
+	if mode == lock.EXCLUSIVE {
+		cmps = append(cmps, clientv3.Compare(clientv3.CreateRevision(e.getHoldersPrefix(resourceId)), "=", 0).WithPrefix())
+		ops = append(ops, clientv3.OpPut(key, owner, clientv3.WithLease(leaseId)))
+	} else {
+		cmps = append(cmps, clientv3.Compare(clientv3.CreateRevision(holderKey), "=", 0))
+	}
 
 	//2.Create new KV
 	kv := clientv3.NewKV(e.client)
 	//3.Create txn
 	txn := kv.Txn(e.ctx)
-	txn.If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).Then(
-		clientv3.OpPut(key, req.LockOwner, clientv3.WithLease(leaseId))).Else(
+	txn.If(cmps...).Then(ops...).Else(
 		clientv3.OpGet(key))
 	//4.Commit and try get lock
 	txnResponse, err := txn.Commit()
 	if err != nil {
-		return &lock.TryLockResponse{}, fmt.Errorf("[etcdLock]: Creat lock returned error: %s.ResourceId: %s", err, req.ResourceId)
+		return nil, fmt.Errorf("[etcdLock]: Creat lock returned error: %s.ResourceId: %s", err, resourceId)
 	}
+	if !txnResponse.Succeeded {
+		// the lease is useless if the lock is held by others
+		lease.Revoke(e.ctx, leaseId)
+	}
+	return txnResponse, nil
+}
 
-	return &lock.TryLockResponse{
-		Success: txnResponse.Succeeded,
-	}, nil
+// reenter increases the reentrant count if the owner holds the lock.
+// It returns nil if the owner doesn't hold the lock.
+// The ttl of an etcd lease can't be modified, so the lease is renewed with the expire time used in the first acquire.
+func (e *EtcdLock) reenter(req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	holderKey := e.getHolderKey(req.ResourceId, req.LockOwner)
+	for {
+		//1.Get current lock
+		state, err := e.getState(req.ResourceId, req.LockOwner)
+		if err != nil {
+			return &lock.TryLockResponse{}, err
+		}
+		count := state.reentrantCount(req.LockOwner)
+		if count == 0 {
+			return nil, nil
+		}
+		kv := state.holder
+		if state.exclusive(req.LockOwner) {
+			kv = state.lock
+		}
+		// the lock can't be reentered in another mode
+		if (req.Mode == lock.EXCLUSIVE) != state.exclusive(req.LockOwner) {
+			return &lock.TryLockResponse{Success: false}, nil
+		}
+		//2.Increase the count if nothing changed since we read it
+		txnResponse, err := e.client.Txn(e.ctx).If(
+			clientv3.Compare(clientv3.ModRevision(holderKey), "=", state.holderRevision())).Then(
+			clientv3.OpPut(holderKey, strconv.FormatInt(count+1, 10), clientv3.WithLease(clientv3.LeaseID(kv.Lease)))).Commit()
+		if err != nil {
+			return &lock.TryLockResponse{}, fmt.Errorf("[etcdLock]: Reenter lock returned error: %s.ResourceId: %s", err, req.ResourceId)
+		}
+		if !txnResponse.Succeeded {
+			continue
+		}
+		//3.Renew the lease
+		if _, err = e.client.KeepAliveOnce(e.ctx, clientv3.LeaseID(kv.Lease)); err != nil {
+			return &lock.TryLockResponse{}, fmt.Errorf("[etcdLock]: Renew lease returned error: %s.ResourceId: %s", err, req.ResourceId)
+		}
+		return &lock.TryLockResponse{
+			Success:      true,
+			FencingToken: kv.CreateRevision,
+		}, nil
+	}
 }
 
-// Node tries to release a etcd lock
+// waitDelete blocks until the key is deleted since the revision, or the ctx is done
+func (e *EtcdLock) waitDelete(ctx context.Context, key string, rev int64, opts ...clientv3.OpOption) error {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	opts = append(opts, clientv3.WithRev(rev))
+	for watchResp := range e.client.Watch(watchCtx, key, opts...) {
+		if err := watchResp.Err(); err != nil {
+			return err
+		}
+		for _, ev := range watchResp.Events {
+			if ev.Type == mvccpb.DELETE {
+				return nil
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+// lockFailed returns a failed response without error if the wait timeout elapses
+func (e *EtcdLock) lockFailed(ctx context.Context, resourceId string, err error) (*lock.LockResponse, error) {
+	if ctx.Err() != nil {
+		return &lock.LockResponse{Success: false}, nil
+	}
+	return &lock.LockResponse{}, fmt.Errorf("[etcdLock]: Wait lock returned error: %s.ResourceId: %s", err, resourceId)
+}
+
+// Node tries to release a etcd lock.
+// A reentrant lock is released after the owner unlocks it the same times it acquired the lock.
 func (e *EtcdLock) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
 	key := e.getKey(req.ResourceId)
+	holderKey := e.getHolderKey(req.ResourceId, req.LockOwner)
+	for {
+		// 1.Get current lock
+		state, err := e.getState(req.ResourceId, req.LockOwner)
+		if err != nil {
+			return newInternalErrorUnlockResponse(), err
+		}
+		count := state.reentrantCount(req.LockOwner)
+		if count == 0 {
+			if state.lock == nil && state.holders == 0 {
+				return &lock.UnlockResponse{Status: lock.LOCK_UNEXIST}, nil
+			}
+			return &lock.UnlockResponse{Status: lock.LOCK_BELONG_TO_OTHERS}, nil
+		}
+		// 2.Decrease the count, or delete the keys if it's the last time
+		cmps := []clientv3.Cmp{clientv3.Compare(clientv3.ModRevision(holderKey), "=", state.holderRevision())}
+		var ops []clientv3.Op
+		if count > 1 {
+			ops = append(ops, clientv3.OpPut(holderKey, strconv.FormatInt(count-1, 10), clientv3.WithIgnoreLease()))
+		} else {
+			ops = append(ops, clientv3.OpDelete(holderKey))
+		}
+		if state.exclusive(req.LockOwner) {
+			cmps = append(cmps, clientv3.Compare(clientv3.Value(key), "=", req.LockOwner))
+			if count <= 1 {
+				ops = append(ops, clientv3.OpDelete(key))
+			}
+		}
+		// 3.Commit and try release lock
+		txnResponse, err := e.client.Txn(e.ctx).If(cmps...).Then(ops...).Commit()
+		if err != nil {
+			return newInternalErrorUnlockResponse(), fmt.Errorf("[etcdLock]: Unlock returned error: %s.ResourceId: %s", err, req.ResourceId)
+		}
+		if txnResponse.Succeeded {
+			return &lock.UnlockResponse{Status: lock.SUCCESS}, nil
+		}
+		// the lock changed after we read it, try again
+	}
+}
 
-	// 1.Create new KV
-	kv := clientv3.NewKV(e.client)
-	// 2.Create txn
-	txn := kv.Txn(e.ctx)
-	txn.If(clientv3.Compare(clientv3.Value(key), "=", req.LockOwner)).Then(
-		clientv3.OpDelete(key)).Else(
-		clientv3.OpGet(key))
-	// 3.Commit and try release lock
-	txnResponse, err := txn.Commit()
+// GetHolderCount counts the holders of a etcd lock
+func (e *EtcdLock) GetHolderCount(ctx context.Context, req *lock.GetHolderCountRequest) (*lock.GetHolderCountResponse, error) {
+	state, err := e.getState(req.ResourceId, req.LockOwner)
 	if err != nil {
-		return newInternalErrorUnlockResponse(), fmt.Errorf("[etcdLock]: Unlock returned error: %s.ResourceId: %s", err, req.ResourceId)
+		return &lock.GetHolderCountResponse{}, err
+	}
+	resp := &lock.GetHolderCountResponse{
+		ReentrantCount: int32(state.reentrantCount(req.LockOwner)),
 	}
+	if state.lock != nil {
+		resp.Mode = lock.EXCLUSIVE
+		resp.HolderCount = 1
+	} else if state.holders > 0 {
+		resp.Mode = lock.SHARED
+		resp.HolderCount = int32(state.holders)
+	}
+	return resp, nil
+}
+
+// lockState is a snapshot of a lock and one of its holders
+type lockState struct {
+	// lock is the lock key, it's nil unless somebody holds the lock exclusively
+	lock *mvccpb.KeyValue
+	// holder is the holder key of the owner, it's nil if the owner doesn't hold the lock
+	holder *mvccpb.KeyValue
+	// holders is the number of holder keys
+	holders int64
+}
 
-	if txnResponse.Succeeded {
-		return &lock.UnlockResponse{Status: lock.SUCCESS}, nil
+// exclusive checks if the owner holds the lock exclusively
+func (s *lockState) exclusive(owner string) bool {
+	return s.lock != nil && string(s.lock.Value) == owner
+}
+
+// reentrantCount returns the times the owner has acquired the lock
+func (s *lockState) reentrantCount(owner string) int64 {
+	if s.holder != nil {
+		count, err := strconv.ParseInt(string(s.holder.Value), 10, 64)
+		if err == nil {
+			return count
+		}
+		return 1
 	}
-	resp := txnResponse.Responses[0].GetResponseRange()
-	if len(resp.Kvs) == 0 {
-		return &lock.UnlockResponse{Status: lock.LOCK_UNEXIST}, nil
+	// the lock may be acquired without a holder key by an older version
+	if s.exclusive(owner) {
+		return 1
 	}
+	return 0
+}
 
-	return &lock.UnlockResponse{Status: lock.LOCK_BELONG_TO_OTHERS}, nil
+// holderRevision returns the mod revision of the holder key, it's 0 if the key doesn't exist
+func (s *lockState) holderRevision() int64 {
+	if s.holder == nil {
+		return 0
+	}
+	return s.holder.ModRevision
+}
+
+// getState reads the lock key, the holder key of the owner and the number of holders in one txn
+func (e *EtcdLock) getState(resourceId string, owner string) (*lockState, error) {
+	txnResponse, err := e.client.Txn(e.ctx).Then(
+		clientv3.OpGet(e.getKey(resourceId)),
+		clientv3.OpGet(e.getHolderKey(resourceId, owner)),
+		clientv3.OpGet(e.getHoldersPrefix(resourceId), clientv3.WithPrefix(), clientv3.WithCountOnly())).Commit()
+	if err != nil {
+		return nil, fmt.Errorf("[etcdLock]: Get lock returned error: %s.ResourceId: %s", err, resourceId)
+	}
+	state := &lockState{
+		holders: txnResponse.Responses[2].GetResponseRange().Count,
+	}
+	if kvs := txnResponse.Responses[0].GetResponseRange().Kvs; len(kvs) > 0 {
+		state.lock = kvs[0]
+	}
+	if kvs := txnResponse.Responses[1].GetResponseRange().Kvs; len(kvs) > 0 && owner != "" {
+		state.holder = kvs[0]
+	}
+	return state, nil
 }
 
 // Close shuts down the client's etcd connections.
@@ -142,6 +448,21 @@ func (e *EtcdLock) Close() error {
 	return e.client.Close()
 }
 
+// getQueuePrefix is to return the prefix of the waiting queue keys of the lock
+func (e *EtcdLock) getQueuePrefix(resourceId string) string {
+	return fmt.Sprintf("%s%s%s", e.metadata.KeyPrefix, resourceId, waitQueueSuffix)
+}
+
+// getHoldersPrefix is to return the prefix of the holder keys of the lock
+func (e *EtcdLock) getHoldersPrefix(resourceId string) string {
+	return fmt.Sprintf("%s%s%s", e.metadata.KeyPrefix, resourceId, holdersSuffix)
+}
+
+// getHolderKey is to return the holder key of the owner
+func (e *EtcdLock) getHolderKey(resourceId string, owner string) string {
+	return e.getHoldersPrefix(resourceId) + owner
+}
+
 // getkey is to return string of type KeyPrefix + resourceId
 func (e *EtcdLock) getKey(resourceId string) string {
 	return fmt.Sprintf("%s%s", e.metadata.KeyPrefix, resourceId)
@@ -225,11 +225,273 @@ func TestEtcdLock_UnLock(t *testing.T) {
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, lock.SUCCESS, resp.Status)
+}
+
+func TestEtcdLock_FencingTokenAndKeepAlive(t *testing.T) {
+	var err error
+	var etcdServer *embed.Etcd
+	var etcdTestDir = "keepalive.test.etcd"
+	var etcdUrl = "localhost:23800"
+
+	etcdServer, err = startEtcdServer(etcdTestDir, 23800)
+	assert.NoError(t, err)
+	defer func() {
+		etcdServer.Server.Stop()
+		os.RemoveAll(etcdTestDir)
+	}()
+
+	comp := NewEtcdLock(log.DefaultLogger)
+	assert.True(t, lock.FeatureFencingToken.IsPresent(comp.Features()))
+
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+
+	cfg.Properties["endpoints"] = etcdUrl
+	err = comp.Init(cfg)
+	assert.NoError(t, err)
+
+	ownerId1 := uuid.New().String()
+	lockresp, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, true, lockresp.Success)
+	assert.True(t, lockresp.FencingToken > 0)
 
-	// not implement LockKeepAlive
-	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{})
-	assert.Nil(t, keepAliveResp)
-	assert.Nil(t, err)
+	//renew lease
+	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, keepAliveResp.Status)
+	assert.Equal(t, lockresp.FencingToken, keepAliveResp.FencingToken)
+
+	//error ownerid
+	ownerId2 := uuid.New().String()
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId2,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_BELONG_TO_OTHERS, keepAliveResp.Status)
+
+	//error resourceid
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resourceId2,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_UNEXIST, keepAliveResp.Status)
+
+	//the next owner gets a bigger token
+	_, err = comp.Unlock(context.TODO(), &lock.UnlockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+	})
+	assert.NoError(t, err)
+	lockresp2, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId2,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, true, lockresp2.Success)
+	assert.True(t, lockresp2.FencingToken > lockresp.FencingToken)
+}
+
+func TestEtcdLock_Lock(t *testing.T) {
+	var err error
+	var etcdServer *embed.Etcd
+	var etcdTestDir = "lock.test.etcd"
+	var etcdUrl = "localhost:23810"
+
+	etcdServer, err = startEtcdServer(etcdTestDir, 23810)
+	assert.NoError(t, err)
+	defer func() {
+		etcdServer.Server.Stop()
+		os.RemoveAll(etcdTestDir)
+	}()
+
+	comp := NewEtcdLock(log.DefaultLogger)
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["endpoints"] = etcdUrl
+	err = comp.Init(cfg)
+	assert.NoError(t, err)
+
+	ownerId1 := uuid.New().String()
+	lockresp, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, lockresp.Success)
+
+	//wait timeout
+	resp, err := comp.Lock(context.TODO(), &lock.LockRequest{
+		ResourceId:  resourceId,
+		LockOwner:   uuid.New().String(),
+		Expire:      10,
+		WaitTimeout: 200 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+
+	//waiters get the lock in FIFO order
+	ownerId2 := uuid.New().String()
+	ownerId3 := uuid.New().String()
+	acquired := make(chan string, 2)
+	wait := func(owner string) {
+		resp, err := comp.Lock(context.TODO(), &lock.LockRequest{
+			ResourceId:  resourceId,
+			LockOwner:   owner,
+			Expire:      10,
+			WaitTimeout: 5 * time.Second,
+		})
+		assert.NoError(t, err)
+		assert.True(t, resp.Success)
+		assert.True(t, resp.FencingToken > lockresp.FencingToken)
+		acquired <- owner
+	}
+	go wait(ownerId2)
+	time.Sleep(100 * time.Millisecond)
+	go wait(ownerId3)
+	time.Sleep(100 * time.Millisecond)
+
+	unlockResp, err := comp.Unlock(context.TODO(), &lock.UnlockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	assert.Equal(t, ownerId2, <-acquired)
+
+	unlockResp, err = comp.Unlock(context.TODO(), &lock.UnlockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	assert.Equal(t, ownerId3, <-acquired)
+}
+
+func TestEtcdLock_SharedAndReentrant(t *testing.T) {
+	var err error
+	var etcdServer *embed.Etcd
+	var etcdTestDir = "shared.test.etcd"
+	var etcdUrl = "localhost:23820"
+
+	etcdServer, err = startEtcdServer(etcdTestDir, 23820)
+	assert.NoError(t, err)
+	defer func() {
+		etcdServer.Server.Stop()
+		os.RemoveAll(etcdTestDir)
+	}()
+
+	comp := NewEtcdLock(log.DefaultLogger)
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["endpoints"] = etcdUrl
+	err = comp.Init(cfg)
+	assert.NoError(t, err)
+	assert.True(t, lock.FeatureSharedLock.IsPresent(comp.Features()))
+	assert.True(t, lock.FeatureReentrantLock.IsPresent(comp.Features()))
+
+	//two readers share the lock, and reader1 reenters it
+	reader1 := uuid.New().String()
+	reader2 := uuid.New().String()
+	readReq := &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  reader1,
+		Expire:     10,
+		Mode:       lock.SHARED,
+		Reentrant:  true,
+	}
+	lockresp, err := comp.TryLock(context.TODO(), readReq)
+	assert.NoError(t, err)
+	assert.True(t, lockresp.Success)
+	token := lockresp.FencingToken
+	lockresp, err = comp.TryLock(context.TODO(), readReq)
+	assert.NoError(t, err)
+	assert.True(t, lockresp.Success)
+	assert.Equal(t, token, lockresp.FencingToken)
+	lockresp, err = comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  reader2,
+		Expire:     10,
+		Mode:       lock.SHARED,
+	})
+	assert.NoError(t, err)
+	assert.True(t, lockresp.Success)
+	assert.True(t, lockresp.FencingToken > token)
+	countResp, err := comp.GetHolderCount(context.TODO(), &lock.GetHolderCountRequest{
+		ResourceId: resourceId,
+		LockOwner:  reader1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SHARED, countResp.Mode)
+	assert.Equal(t, int32(2), countResp.HolderCount)
+	assert.Equal(t, int32(2), countResp.ReentrantCount)
+
+	//the writer is blocked by readers until they leave
+	writer := uuid.New().String()
+	acquired := make(chan bool)
+	go func() {
+		resp, err := comp.Lock(context.TODO(), &lock.LockRequest{
+			ResourceId:  resourceId,
+			LockOwner:   writer,
+			Expire:      10,
+			WaitTimeout: 5 * time.Second,
+		})
+		assert.NoError(t, err)
+		acquired <- resp.Success
+	}()
+	time.Sleep(100 * time.Millisecond)
+	//reader1 has to unlock twice
+	for _, owner := range []string{reader1, reader2, reader1} {
+		unlockResp, err := comp.Unlock(context.TODO(), &lock.UnlockRequest{
+			ResourceId: resourceId,
+			LockOwner:  owner,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	}
+	assert.True(t, <-acquired)
+
+	//the writer can't reenter the lock without the reentrant flag
+	lockresp, err = comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  writer,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.False(t, lockresp.Success)
+	lockresp, err = comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  writer,
+		Expire:     10,
+		Reentrant:  true,
+	})
+	assert.NoError(t, err)
+	assert.True(t, lockresp.Success)
+	countResp, err = comp.GetHolderCount(context.TODO(), &lock.GetHolderCountRequest{
+		ResourceId: resourceId,
+		LockOwner:  writer,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.EXCLUSIVE, countResp.Mode)
+	assert.Equal(t, int32(1), countResp.HolderCount)
+	assert.Equal(t, int32(2), countResp.ReentrantCount)
 }
 
 func startEtcdServer(dir string, port int) (*embed.Etcd, error) {
@@ -18,6 +18,8 @@ package in_memory
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,22 +31,35 @@ type InMemoryLock struct {
 	data     *lockMap
 }
 
-// memoryLock is a lock holder
+// memoryLock is a lock, which may be held by several owners in SHARED mode
 type memoryLock struct {
-	key        string
-	owner      string
+	key  string
+	mode lock.LockMode
+	// holders of the lock, keyed by lock owner
+	holders map[string]*holder
+	// the lock expires with all its holders after expireTime
 	expireTime time.Time
-	lock       int
+}
+
+// holder is a lock owner holding the lock
+type holder struct {
+	// count is increased every time the owner reenters the lock
+	count        int32
+	acquiredAt   time.Time
+	expireTime   time.Time
+	fencingToken int64
 }
 
 type lockMap struct {
 	sync.Mutex
 	locks map[string]*memoryLock
+	// fencingToken is increased every time a lock is acquired
+	fencingToken int64
 }
 
 func NewInMemoryLock() *InMemoryLock {
 	return &InMemoryLock{
-		features: make([]lock.Feature, 0),
+		features: []lock.Feature{lock.FeatureFencingToken, lock.FeatureSharedLock, lock.FeatureReentrantLock, lock.FeatureListLock},
 		data: &lockMap{
 			locks: make(map[string]*memoryLock),
 		},
@@ -56,56 +71,88 @@ func (s *InMemoryLock) Init(_ lock.Metadata) error {
 }
 
 // LockKeepAlive try to renewal lease
-func (s *InMemoryLock) LockKeepAlive(ctx context.Context, request *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
-	//TODO: implemnt function
-	return nil, nil
+func (s *InMemoryLock) LockKeepAlive(ctx context.Context, req *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
+	s.data.Lock()
+	defer s.data.Unlock()
+	resp := &lock.LockKeepAliveResponse{
+		ResourceId: req.ResourceId,
+	}
+	// 1. Find the memoryLock for this resourceId
+	item := s.getLock(req.ResourceId)
+	if item == nil {
+		resp.Status = lock.LOCK_UNEXIST
+		return resp, nil
+	}
+	// 2. check the owner information
+	h, ok := item.holders[req.LockOwner]
+	if !ok {
+		resp.Status = lock.LOCK_BELONG_TO_OTHERS
+		return resp, nil
+	}
+	// 3. renew the lease
+	item.renew(h, req.Expire)
+	resp.Status = lock.SUCCESS
+	resp.FencingToken = h.fencingToken
+	return resp, nil
 }
 
 func (s *InMemoryLock) Features() []lock.Feature {
 	return s.features
 }
 
-// Try to add a lock. Currently this is a non-reentrant lock
+// Try to add a lock.
 func (s *InMemoryLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
 	s.data.Lock()
 	defer s.data.Unlock()
 	// 1. Find the memoryLock for this resourceId
-	item, ok := s.data.locks[req.ResourceId]
-	if !ok {
-		item = &memoryLock{
-			key: req.ResourceId,
-			//0 unlock, 1 lock
-			lock: 0,
-		}
-		s.data.locks[req.ResourceId] = item
-	}
+	item := s.getLock(req.ResourceId)
 
-	// 2. Construct a new one if the lockData has expired
-	//check expire
-	if item.owner != "" && time.Now().After(item.expireTime) {
+	if item != nil {
+		// 2. Reenter the lock if the owner holds it in the same mode
+		if h, ok := item.holders[req.LockOwner]; ok {
+			if !req.Reentrant || item.mode != req.Mode {
+				return &lock.TryLockResponse{
+					Success: false,
+				}, nil
+			}
+			h.count++
+			item.renew(h, req.Expire)
+			return &lock.TryLockResponse{
+				Success:      true,
+				FencingToken: h.fencingToken,
+			}, nil
+		}
+		// 3. Check if it has been locked by others.
+		// Only shared locks can be held by several owners
+		if item.mode == lock.EXCLUSIVE || req.Mode == lock.EXCLUSIVE {
+			//lock failed
+			return &lock.TryLockResponse{
+				Success: false,
+			}, nil
+		}
+	} else {
+		// 4. Construct a new one if nobody holds the lock
 		item = &memoryLock{
-			key:  req.ResourceId,
-			lock: 0,
+			key:     req.ResourceId,
+			mode:    req.Mode,
+			holders: make(map[string]*holder),
 		}
 		s.data.locks[req.ResourceId] = item
 	}
 
-	// 3. Check if it has been locked by others.
-	// Currently this is a non-reentrant lock
-	if item.lock == 1 {
-		//lock failed
-		return &lock.TryLockResponse{
-			Success: false,
-		}, nil
+	// 5. Update owner information
+	s.data.fencingToken++
+	h := &holder{
+		count:        1,
+		acquiredAt:   time.Now(),
+		fencingToken: s.data.fencingToken,
 	}
-
-	// 4. Update owner information
-	item.lock = 1
-	item.owner = req.LockOwner
-	item.expireTime = time.Now().Add(time.Second * time.Duration(req.Expire))
+	item.holders[req.LockOwner] = h
+	item.renew(h, req.Expire)
 
 	return &lock.TryLockResponse{
-		Success: true,
+		Success:      true,
+		FencingToken: h.fencingToken,
 	}, nil
 }
 
@@ -113,28 +160,152 @@ func (s *InMemoryLock) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lo
 	s.data.Lock()
 	defer s.data.Unlock()
 	// 1. Find the memoryLock for this resourceId
-	item, ok := s.data.locks[req.ResourceId]
+	item := s.getLock(req.ResourceId)
 
-	if !ok {
+	if item == nil {
 		return &lock.UnlockResponse{
 			Status: lock.LOCK_UNEXIST,
 		}, nil
 	}
 	// 2. check the owner information
-	if item.lock != 1 {
+	h, ok := item.holders[req.LockOwner]
+	if !ok {
 		return &lock.UnlockResponse{
-			Status: lock.LOCK_UNEXIST,
+			Status: lock.LOCK_BELONG_TO_OTHERS,
 		}, nil
 	}
-	if item.owner != req.LockOwner {
+	// 3. unlock once, and remove the owner if it has exited the lock as many times as it entered
+	h.count--
+	if h.count == 0 {
+		delete(item.holders, req.LockOwner)
+	}
+	if len(item.holders) == 0 {
+		delete(s.data.locks, req.ResourceId)
+	}
+	return &lock.UnlockResponse{
+		Status: lock.SUCCESS,
+	}, nil
+}
+
+// GetHolderCount counts the holders of a lock
+func (s *InMemoryLock) GetHolderCount(ctx context.Context, req *lock.GetHolderCountRequest) (*lock.GetHolderCountResponse, error) {
+	s.data.Lock()
+	defer s.data.Unlock()
+	resp := &lock.GetHolderCountResponse{}
+	item := s.getLock(req.ResourceId)
+	if item == nil {
+		return resp, nil
+	}
+	resp.Mode = item.mode
+	resp.HolderCount = int32(len(item.holders))
+	if h, ok := item.holders[req.LockOwner]; ok {
+		resp.ReentrantCount = h.count
+	}
+	return resp, nil
+}
+
+// GetLockInfo returns the holders of a lock
+func (s *InMemoryLock) GetLockInfo(ctx context.Context, req *lock.GetLockInfoRequest) (*lock.GetLockInfoResponse, error) {
+	s.data.Lock()
+	defer s.data.Unlock()
+	resp := &lock.GetLockInfoResponse{}
+	item := s.getLock(req.ResourceId)
+	if item == nil {
+		return resp, nil
+	}
+	info := item.info()
+	resp.Mode = info.Mode
+	resp.Holders = info.Holders
+	return resp, nil
+}
+
+// ListLocks lists the locks in the order of resource ids
+func (s *InMemoryLock) ListLocks(ctx context.Context, req *lock.ListLocksRequest) (*lock.ListLocksResponse, error) {
+	s.data.Lock()
+	defer s.data.Unlock()
+	keys := make([]string, 0, len(s.data.locks))
+	for key := range s.data.locks {
+		if strings.HasPrefix(key, req.Prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	resp := &lock.ListLocksResponse{}
+	for _, key := range keys {
+		if req.Limit > 0 && len(resp.Locks) >= int(req.Limit) {
+			break
+		}
+		if item := s.getLock(key); item != nil {
+			resp.Locks = append(resp.Locks, item.info())
+		}
+	}
+	return resp, nil
+}
+
+// ForceUnlock releases a lock no matter who holds it
+func (s *InMemoryLock) ForceUnlock(ctx context.Context, req *lock.ForceUnlockRequest) (*lock.UnlockResponse, error) {
+	s.data.Lock()
+	defer s.data.Unlock()
+	if s.getLock(req.ResourceId) == nil {
 		return &lock.UnlockResponse{
-			Status: lock.LOCK_BELONG_TO_OTHERS,
+			Status: lock.LOCK_UNEXIST,
 		}, nil
 	}
-	// 3. unlock and reset the owner information
-	item.owner = ""
-	item.lock = 0
+	delete(s.data.locks, req.ResourceId)
 	return &lock.UnlockResponse{
 		Status: lock.SUCCESS,
 	}, nil
 }
+
+// getLock returns the lock of the resource with expired holders removed, or nil if nobody holds it
+func (s *InMemoryLock) getLock(resourceId string) *memoryLock {
+	item, ok := s.data.locks[resourceId]
+	if !ok {
+		return nil
+	}
+	now := time.Now()
+	//check expire
+	if now.After(item.expireTime) {
+		delete(s.data.locks, resourceId)
+		return nil
+	}
+	for owner, h := range item.holders {
+		if now.After(h.expireTime) {
+			delete(item.holders, owner)
+		}
+	}
+	if len(item.holders) == 0 {
+		delete(s.data.locks, resourceId)
+		return nil
+	}
+	return item
+}
+
+// info returns the holders of the lock in the order of their fencing tokens
+func (l *memoryLock) info() *lock.LockInfo {
+	info := &lock.LockInfo{
+		ResourceId: l.key,
+		Mode:       l.mode,
+	}
+	now := time.Now()
+	for owner, h := range l.holders {
+		info.Holders = append(info.Holders, &lock.LockHolder{
+			LockOwner:    owner,
+			TTL:          h.expireTime.Sub(now),
+			AcquiredAt:   h.acquiredAt,
+			FencingToken: h.fencingToken,
+		})
+	}
+	sort.Slice(info.Holders, func(i, j int) bool {
+		return info.Holders[i].FencingToken < info.Holders[j].FencingToken
+	})
+	return info
+}
+
+// renew extends the lease of the holder
+func (l *memoryLock) renew(h *holder, expire int32) {
+	h.expireTime = time.Now().Add(time.Second * time.Duration(expire))
+	if h.expireTime.After(l.expireTime) {
+		l.expireTime = h.expireTime
+	}
+}
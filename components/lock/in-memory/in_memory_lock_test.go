@@ -45,7 +45,10 @@ func TestFeatures(t *testing.T) {
 
 	f := s.Features()
 	assert.NotNil(t, f)
-	assert.Equal(t, 0, len(f))
+	assert.True(t, lock.FeatureFencingToken.IsPresent(f))
+	assert.True(t, lock.FeatureSharedLock.IsPresent(f))
+	assert.True(t, lock.FeatureReentrantLock.IsPresent(f))
+	assert.True(t, lock.FeatureListLock.IsPresent(f))
 }
 
 func TestTryLock(t *testing.T) {
@@ -164,9 +167,214 @@ func TestUnLock(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, req)
 	assert.Equal(t, lock.LOCK_UNEXIST, resp.Status)
+}
+
+func TestFencingToken(t *testing.T) {
+	s := NewInMemoryLock()
+	assert.NotNil(t, s)
+
+	req := &lock.TryLockRequest{
+		ResourceId: "key111",
+		LockOwner:  "own",
+		Expire:     10,
+	}
+	resp, err := s.TryLock(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	token := resp.FencingToken
+	assert.True(t, token > 0)
+
+	// the token of a new acquisition is larger than the previous one
+	s.data.locks["key111"].expireTime = time.Now().Add(-2 * time.Second)
+	req.LockOwner = "own1"
+	resp, err = s.TryLock(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.True(t, resp.FencingToken > token)
+	token = resp.FencingToken
+
+	// failed acquisition doesn't return a token
+	req.LockOwner = "own2"
+	resp, err = s.TryLock(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.Equal(t, int64(0), resp.FencingToken)
+
+	// a different resource shares the same sequence
+	req.ResourceId = "key112"
+	resp, err = s.TryLock(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.True(t, resp.FencingToken > token)
+}
+
+func TestLockKeepAlive(t *testing.T) {
+	s := NewInMemoryLock()
+	assert.NotNil(t, s)
+
+	keepAliveReq := &lock.LockKeepAliveRequest{
+		ResourceId: "key111",
+		LockOwner:  "own",
+		Expire:     10,
+	}
+	keepAliveResp, err := s.LockKeepAlive(context.TODO(), keepAliveReq)
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_UNEXIST, keepAliveResp.Status)
+
+	lockResp, err := s.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: "key111",
+		LockOwner:  "own",
+		Expire:     1,
+	})
+	assert.NoError(t, err)
+	assert.True(t, lockResp.Success)
+
+	s.data.locks["key111"].expireTime = time.Now().Add(500 * time.Millisecond)
+	keepAliveResp, err = s.LockKeepAlive(context.TODO(), keepAliveReq)
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, keepAliveResp.Status)
+	assert.Equal(t, lockResp.FencingToken, keepAliveResp.FencingToken)
+	assert.True(t, s.data.locks["key111"].expireTime.After(time.Now().Add(5*time.Second)))
+
+	keepAliveReq.LockOwner = "own1"
+	keepAliveResp, err = s.LockKeepAlive(context.TODO(), keepAliveReq)
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_BELONG_TO_OTHERS, keepAliveResp.Status)
+}
+
+func TestSharedLock(t *testing.T) {
+	s := NewInMemoryLock()
+	assert.NotNil(t, s)
+
+	// readers share the lock
+	resp, err := s.TryLock(context.TODO(), &lock.TryLockRequest{ResourceId: "key111", LockOwner: "reader1", Expire: 10, Mode: lock.SHARED})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	resp, err = s.TryLock(context.TODO(), &lock.TryLockRequest{ResourceId: "key111", LockOwner: "reader2", Expire: 10, Mode: lock.SHARED})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
 
-	// not implement LockKeepAlive
-	keepAliveResp, err := s.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{})
-	assert.Nil(t, keepAliveResp)
-	assert.Nil(t, err)
+	// the writer is blocked by readers
+	writeReq := &lock.TryLockRequest{ResourceId: "key111", LockOwner: "writer", Expire: 10}
+	resp, err = s.TryLock(context.TODO(), writeReq)
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+
+	countResp, err := s.GetHolderCount(context.TODO(), &lock.GetHolderCountRequest{ResourceId: "key111"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SHARED, countResp.Mode)
+	assert.Equal(t, int32(2), countResp.HolderCount)
+
+	// the writer gets the lock after all readers leave
+	unlockResp, err := s.Unlock(context.TODO(), &lock.UnlockRequest{ResourceId: "key111", LockOwner: "reader1"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	resp, err = s.TryLock(context.TODO(), writeReq)
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+	unlockResp, err = s.Unlock(context.TODO(), &lock.UnlockRequest{ResourceId: "key111", LockOwner: "reader2"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	resp, err = s.TryLock(context.TODO(), writeReq)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	// readers are blocked by the writer
+	resp, err = s.TryLock(context.TODO(), &lock.TryLockRequest{ResourceId: "key111", LockOwner: "reader1", Expire: 10, Mode: lock.SHARED})
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+}
+
+func TestReentrantLock(t *testing.T) {
+	s := NewInMemoryLock()
+	assert.NotNil(t, s)
+
+	req := &lock.TryLockRequest{
+		ResourceId: "key111",
+		LockOwner:  "own",
+		Expire:     10,
+		Reentrant:  true,
+	}
+	resp, err := s.TryLock(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	token := resp.FencingToken
+
+	// reenter the lock and get the same token
+	resp, err = s.TryLock(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, token, resp.FencingToken)
+
+	// can't reenter the lock in another mode
+	resp, err = s.TryLock(context.TODO(), &lock.TryLockRequest{ResourceId: "key111", LockOwner: "own", Expire: 10, Reentrant: true, Mode: lock.SHARED})
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+
+	countResp, err := s.GetHolderCount(context.TODO(), &lock.GetHolderCountRequest{ResourceId: "key111", LockOwner: "own"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.EXCLUSIVE, countResp.Mode)
+	assert.Equal(t, int32(1), countResp.HolderCount)
+	assert.Equal(t, int32(2), countResp.ReentrantCount)
+
+	// the lock is released after unlocking it twice
+	unlockReq := &lock.UnlockRequest{ResourceId: "key111", LockOwner: "own"}
+	unlockResp, err := s.Unlock(context.TODO(), unlockReq)
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	resp, err = s.TryLock(context.TODO(), &lock.TryLockRequest{ResourceId: "key111", LockOwner: "own1", Expire: 10})
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+	unlockResp, err = s.Unlock(context.TODO(), unlockReq)
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+
+	countResp, err = s.GetHolderCount(context.TODO(), &lock.GetHolderCountRequest{ResourceId: "key111", LockOwner: "own"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), countResp.HolderCount)
+	assert.Equal(t, int32(0), countResp.ReentrantCount)
+}
+
+func TestLockInfo(t *testing.T) {
+	s := NewInMemoryLock()
+	for _, req := range []*lock.TryLockRequest{
+		{ResourceId: "order||1", LockOwner: "own1", Expire: 10, Mode: lock.SHARED},
+		{ResourceId: "order||1", LockOwner: "own2", Expire: 20, Mode: lock.SHARED},
+		{ResourceId: "order||2", LockOwner: "own3", Expire: 10},
+		{ResourceId: "user||1", LockOwner: "own4", Expire: 10},
+	} {
+		resp, err := s.TryLock(context.TODO(), req)
+		assert.NoError(t, err)
+		assert.True(t, resp.Success)
+	}
+
+	infoResp, err := s.GetLockInfo(context.TODO(), &lock.GetLockInfoRequest{ResourceId: "order||1"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SHARED, infoResp.Mode)
+	assert.Len(t, infoResp.Holders, 2)
+	assert.Equal(t, "own1", infoResp.Holders[0].LockOwner)
+	assert.True(t, infoResp.Holders[0].TTL > 9*time.Second && infoResp.Holders[0].TTL <= 10*time.Second)
+	assert.False(t, infoResp.Holders[0].AcquiredAt.IsZero())
+	assert.Equal(t, "own2", infoResp.Holders[1].LockOwner)
+	assert.True(t, infoResp.Holders[1].TTL > 19*time.Second)
+
+	listResp, err := s.ListLocks(context.TODO(), &lock.ListLocksRequest{Prefix: "order||"})
+	assert.NoError(t, err)
+	assert.Len(t, listResp.Locks, 2)
+	assert.Equal(t, "order||1", listResp.Locks[0].ResourceId)
+	assert.Equal(t, "order||2", listResp.Locks[1].ResourceId)
+	listResp, err = s.ListLocks(context.TODO(), &lock.ListLocksRequest{Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, listResp.Locks, 1)
+
+	// force release
+	unlockResp, err := s.ForceUnlock(context.TODO(), &lock.ForceUnlockRequest{ResourceId: "order||1"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	infoResp, err = s.GetLockInfo(context.TODO(), &lock.GetLockInfoRequest{ResourceId: "order||1"})
+	assert.NoError(t, err)
+	assert.Empty(t, infoResp.Holders)
+	unlockResp, err = s.ForceUnlock(context.TODO(), &lock.ForceUnlockRequest{ResourceId: "order||1"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_UNEXIST, unlockResp.Status)
 }
@@ -26,3 +26,31 @@ type LockStore interface {
 	// Node tries to renewal lease
 	LockKeepAlive(context.Context, *LockKeepAliveRequest) (*LockKeepAliveResponse, error)
 }
+
+// BlockingLockStore is a LockStore which can wait for a lock natively, e.g. by watching the lock key.
+// The runtime polls TryLock for the stores which don't implement it.
+type BlockingLockStore interface {
+	LockStore
+	// Node waits until the lock is acquired or the wait timeout elapses.
+	// Waiters of the same lock should acquire it in FIFO order.
+	Lock(ctx context.Context, req *LockRequest) (*LockResponse, error)
+}
+
+// InspectableLockStore is a LockStore which can report the holders of its locks and release them by force.
+// A store which can't list its locks should not present FeatureListLock, and return an error in ListLocks.
+type InspectableLockStore interface {
+	LockStore
+	// Get the holders of a lock
+	GetLockInfo(ctx context.Context, req *GetLockInfoRequest) (*GetLockInfoResponse, error)
+	// List the locks whose resource ids have the prefix
+	ListLocks(ctx context.Context, req *ListLocksRequest) (*ListLocksResponse, error)
+	// Release a lock no matter who holds it
+	ForceUnlock(ctx context.Context, req *ForceUnlockRequest) (*UnlockResponse, error)
+}
+
+// HolderCountLockStore is a LockStore which can count the holders of a lock.
+type HolderCountLockStore interface {
+	LockStore
+	// Get the holder count of a lock
+	GetHolderCount(ctx context.Context, req *GetHolderCountRequest) (*GetHolderCountResponse, error)
+}
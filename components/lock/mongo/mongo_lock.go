@@ -38,8 +38,17 @@ const (
 	UNLOCK_UNEXIST          = 4
 	UNLOCK_BELONG_TO_OTHERS = 5
 	UNLOCK_FAIL             = 6
+
+	// fencingTokenSuffix can't be contained in the lock key, so the counter document never conflicts with a lock
+	fencingTokenSuffix = "||fencing_token"
 )
 
+// lockDocument is the fields of a lock document which are read by MongoLock
+type lockDocument struct {
+	LockOwner    string `bson:"LockOwner"`
+	FencingToken int64  `bson:"FencingToken"`
+}
+
 // mongo lock store
 type MongoLock struct {
 	factory utils.MongoFactory
@@ -59,7 +68,7 @@ type MongoLock struct {
 // NewMongoLock returns a new mongo lock
 func NewMongoLock(logger log.ErrorLogger) *MongoLock {
 	s := &MongoLock{
-		features: make([]lock.Feature, 0),
+		features: []lock.Feature{lock.FeatureFencingToken},
 		logger:   logger,
 	}
 	return s
@@ -110,9 +119,41 @@ func (e *MongoLock) Features() []lock.Feature {
 }
 
 // LockKeepAlive try to renewal lease
-func (e *MongoLock) LockKeepAlive(ctx context.Context, request *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
-	//TODO: implemnt function
-	return nil, nil
+func (e *MongoLock) LockKeepAlive(ctx context.Context, req *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
+	var document lockDocument
+	resp := &lock.LockKeepAliveResponse{
+		ResourceId: req.ResourceId,
+	}
+	after := options.After
+	opt := options.FindOneAndUpdateOptions{
+		ReturnDocument: &after,
+	}
+	// 1. reset the expire time if the lock belongs to this owner and has not expired
+	now := time.Now()
+	filter := bson.M{"_id": req.ResourceId, "LockOwner": req.LockOwner, "Expire": bson.M{"$gt": now}}
+	update := bson.M{"$set": bson.M{"Expire": now.Add(time.Duration(req.Expire) * time.Second)}}
+	err := e.collection.FindOneAndUpdate(e.ctx, filter, update, &opt).Decode(&document)
+	if err == nil {
+		resp.Status = lock.SUCCESS
+		resp.FencingToken = document.FencingToken
+		return resp, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		resp.Status = lock.INTERNAL_ERROR
+		return resp, fmt.Errorf("[mongoLock]: Renew lease returned error: %s ResourceId: %s", err, req.ResourceId)
+	}
+	// 2. check whether the lock is held by others
+	err = e.collection.FindOne(e.ctx, bson.M{"_id": req.ResourceId, "Expire": bson.M{"$gt": now}}).Decode(&document)
+	if err == nil {
+		resp.Status = lock.LOCK_BELONG_TO_OTHERS
+		return resp, nil
+	}
+	if err == mongo.ErrNoDocuments {
+		resp.Status = lock.LOCK_UNEXIST
+		return resp, nil
+	}
+	resp.Status = lock.INTERNAL_ERROR
+	return resp, fmt.Errorf("[mongoLock]: Renew lease returned error: %s ResourceId: %s", err, req.ResourceId)
 }
 
 func (e *MongoLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
@@ -132,6 +173,7 @@ func (e *MongoLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*loc
 	// close mongo session
 	defer e.session.EndSession(e.ctx)
 
+	var fencingToken int64
 	// start transaction
 	status, err := e.session.WithTransaction(e.ctx, func(sessionContext mongo.SessionContext) (interface{}, error) {
 		var err error
@@ -141,18 +183,30 @@ func (e *MongoLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*loc
 		expireTime := time.Now().Add(time.Duration(req.Expire) * time.Second)
 
 		// insert mongo lock
-		insertOneResult, err = e.collection.InsertOne(e.ctx, bson.M{"_id": req.ResourceId, "LockOwner": req.LockOwner, "Expire": expireTime})
+		insertOneResult, err = e.collection.InsertOne(sessionContext, bson.M{"_id": req.ResourceId, "LockOwner": req.LockOwner, "Expire": expireTime})
 
 		if err != nil {
 			_ = sessionContext.AbortTransaction(sessionContext)
 			return TRY_LOCK_FAIL, err
 		}
 
+		if insertOneResult == nil || insertOneResult.InsertedID != req.ResourceId {
+			return TRY_LOCK_FAIL, err
+		}
+
+		// increase the fencing token and save it in the lock, in the same transaction
+		if fencingToken, err = e.nextFencingToken(sessionContext, req.ResourceId); err != nil {
+			_ = sessionContext.AbortTransaction(sessionContext)
+			return TRY_LOCK_FAIL, err
+		}
+		if _, err = e.collection.UpdateOne(sessionContext, bson.M{"_id": req.ResourceId}, bson.M{"$set": bson.M{"FencingToken": fencingToken}}); err != nil {
+			_ = sessionContext.AbortTransaction(sessionContext)
+			return TRY_LOCK_FAIL, err
+		}
+
 		// commit and set status
-		if insertOneResult != nil && insertOneResult.InsertedID == req.ResourceId {
-			if err = sessionContext.CommitTransaction(sessionContext); err == nil {
-				return TRY_LOCK_SUCCESS, err
-			}
+		if err = sessionContext.CommitTransaction(sessionContext); err == nil {
+			return TRY_LOCK_SUCCESS, err
 		}
 
 		return TRY_LOCK_FAIL, err
@@ -165,7 +219,8 @@ func (e *MongoLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*loc
 
 	if status == TRY_LOCK_SUCCESS {
 		return &lock.TryLockResponse{
-			Success: true,
+			Success:      true,
+			FencingToken: fencingToken,
 		}, nil
 	}
 	return &lock.TryLockResponse{
@@ -237,6 +292,23 @@ func (e *MongoLock) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lock.
 	}, nil
 }
 
+// nextFencingToken increases the counter document of the resource and returns the new value
+func (e *MongoLock) nextFencingToken(ctx context.Context, resourceId string) (int64, error) {
+	var document lockDocument
+	after := options.After
+	upsert := true
+	opt := options.FindOneAndUpdateOptions{
+		ReturnDocument: &after,
+		Upsert:         &upsert,
+	}
+	err := e.collection.FindOneAndUpdate(ctx, bson.M{"_id": resourceId + fencingTokenSuffix},
+		bson.M{"$inc": bson.M{"FencingToken": int64(1)}}, &opt).Decode(&document)
+	if err != nil {
+		return 0, err
+	}
+	return document.FencingToken, nil
+}
+
 func newInternalErrorUnlockResponse() *lock.UnlockResponse {
 	return &lock.UnlockResponse{
 		Status: lock.INTERNAL_ERROR,
@@ -186,9 +186,83 @@ func TestMongoLock_Unlock(t *testing.T) {
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, lock.SUCCESS, resp.Status)
+}
+
+func TestMongoLock_FencingTokenAndKeepAlive(t *testing.T) {
+	var err error
+	var mongoUrl = "localhost:xxxx"
+
+	comp := NewMongoLock(log.DefaultLogger)
+	assert.True(t, lock.FeatureFencingToken.IsPresent(comp.Features()))
+
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+
+	cfg.Properties["mongoHost"] = mongoUrl
+	_ = comp.Init(cfg)
+	// mock
+	result := make(map[string]bson.M)
+	mockMongoClient := mock.MockMongoClient{}
+	mockMongoSession := mock.NewMockMongoSession()
+	mockMongoCollection := mock.MockMongoCollection{
+		InsertManyResult: &mongo.InsertManyResult{},
+		InsertOneResult:  &mongo.InsertOneResult{},
+		SingleResult:     &mongo.SingleResult{},
+		Result:           result,
+	}
+
+	comp.session = mockMongoSession
+	comp.collection = &mockMongoCollection
+	comp.client = &mockMongoClient
+
+	ownerId1 := uuid.New().String()
+	lockresp, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, true, lockresp.Success)
+	assert.Equal(t, int64(1), lockresp.FencingToken)
+
+	//renew lease
+	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, keepAliveResp.Status)
+	assert.Equal(t, lockresp.FencingToken, keepAliveResp.FencingToken)
+
+	//error ownerid
+	ownerId2 := uuid.New().String()
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId2,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_BELONG_TO_OTHERS, keepAliveResp.Status)
+
+	//error resourceid
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resourceId2,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_UNEXIST, keepAliveResp.Status)
 
-	// not implement LockKeepAlive
-	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{})
-	assert.Nil(t, keepAliveResp)
-	assert.Nil(t, err)
+	//the next owner gets a bigger token
+	delete(result, resourceId)
+	lockresp, err = comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId2,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, true, lockresp.Success)
+	assert.Equal(t, int64(2), lockresp.FencingToken)
 }
@@ -27,6 +27,16 @@ import (
 	"mosn.io/layotto/components/pkg/utils"
 )
 
+const (
+	unlockScript = "local v = redis.call(\"get\",KEYS[1]); if v==false then return -1 end; if v~=ARGV[1] then return -2 else return redis.call(\"del\",KEYS[1]) end"
+	// tryLockScript returns the new fencing token if the lock is acquired, otherwise returns 0
+	tryLockScript = "if redis.call(\"set\",KEYS[1],ARGV[1],\"NX\",\"PX\",ARGV[2]) then return redis.call(\"incr\",KEYS[2]) else return 0 end"
+	// keepAliveScript returns the fencing token if the lease is renewed, otherwise returns -1 or -2
+	keepAliveScript = "local v = redis.call(\"get\",KEYS[1]); if v==false then return -1 end; if v~=ARGV[1] then return -2 end; redis.call(\"pexpire\",KEYS[1],ARGV[2]); return tonumber(redis.call(\"get\",KEYS[2]) or \"0\")"
+	// raiseFencingTokenScript sets the token counter to ARGV[1] if the counter is less than it
+	raiseFencingTokenScript = "local v = tonumber(redis.call(\"get\",KEYS[1]) or \"0\"); if v < tonumber(ARGV[1]) then redis.call(\"set\",KEYS[1],ARGV[1]) end; return 1"
+)
+
 // RedLock
 // it will be best to use at least 5 hosts
 type ClusterRedisLock struct {
@@ -44,7 +54,7 @@ type ClusterRedisLock struct {
 // NewClusterRedisLock returns a new redis lock store
 func NewClusterRedisLock(logger log.ErrorLogger) *ClusterRedisLock {
 	s := &ClusterRedisLock{
-		features: make([]lock.Feature, 0),
+		features: []lock.Feature{lock.FeatureFencingToken},
 		logger:   logger,
 	}
 
@@ -56,6 +66,7 @@ type resultMsg struct {
 	host         string
 	lockStatus   bool
 	unlockStatus lock.LockStatus
+	fencingToken int64
 }
 
 func (c *ClusterRedisLock) Init(metadata lock.Metadata) error {
@@ -81,9 +92,61 @@ func (c *ClusterRedisLock) Features() []lock.Feature {
 }
 
 // LockKeepAlive try to renewal lease
-func (c *ClusterRedisLock) LockKeepAlive(ctx context.Context, request *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
-	//TODO: implemnt function
-	return nil, nil
+func (c *ClusterRedisLock) LockKeepAlive(ctx context.Context, req *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
+	wg := sync.WaitGroup{}
+	wg.Add(len(c.clients))
+	ch := make(chan resultMsg, len(c.clients))
+
+	//renew lease concurrently
+	for i := range c.clients {
+		clientIndex := i
+		c.workpool.Schedule(func() {
+			c.KeepAliveSingleRedis(clientIndex, req, &wg, ch)
+		})
+	}
+	wg.Wait()
+	close(ch)
+
+	successCount := 0
+	belongToOthers := false
+	var fencingToken int64
+	errorStrs := make([]string, 0, len(c.clients))
+	for msg := range ch {
+		if msg.error != nil {
+			errorStrs = append(errorStrs, msg.error.Error())
+			continue
+		}
+		switch msg.unlockStatus {
+		case lock.SUCCESS:
+			successCount++
+			if msg.fencingToken > fencingToken {
+				fencingToken = msg.fencingToken
+			}
+		case lock.LOCK_BELONG_TO_OTHERS:
+			belongToOthers = true
+		}
+	}
+	var err error
+	if len(errorStrs) > 0 {
+		err = fmt.Errorf("%s", strings.Join(errorStrs, "\n"))
+	}
+	resp := &lock.LockKeepAliveResponse{
+		ResourceId: req.ResourceId,
+	}
+	//renewing lease on majority of redis cluster will be regarded as success
+	if successCount*2 > len(c.clients) {
+		resp.Status = lock.SUCCESS
+		resp.FencingToken = fencingToken
+		return resp, err
+	}
+	if err != nil {
+		resp.Status = lock.INTERNAL_ERROR
+	} else if belongToOthers {
+		resp.Status = lock.LOCK_BELONG_TO_OTHERS
+	} else {
+		resp.Status = lock.LOCK_UNEXIST
+	}
+	return resp, err
 }
 
 func (c *ClusterRedisLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
@@ -120,6 +183,7 @@ func (c *ClusterRedisLock) TryLock(ctx context.Context, req *lock.TryLockRequest
 	close(resultChan)
 
 	successCount := 0
+	var fencingToken int64
 	errorStrs := make([]string, 0, len(c.clients))
 	for msg := range resultChan {
 		if msg.error != nil {
@@ -128,6 +192,9 @@ func (c *ClusterRedisLock) TryLock(ctx context.Context, req *lock.TryLockRequest
 		}
 		if msg.lockStatus {
 			successCount++
+			if msg.fencingToken > fencingToken {
+				fencingToken = msg.fencingToken
+			}
 		}
 	}
 	var err error
@@ -136,8 +203,12 @@ func (c *ClusterRedisLock) TryLock(ctx context.Context, req *lock.TryLockRequest
 	}
 	//getting lock on majority of redis cluster will be regarded as locking success
 	if successCount*2 > len(c.clients) {
+		//the token counters on different nodes may be inconsistent,
+		//so raise all of them to the max one to make sure the next token is bigger
+		c.raiseFencingToken(req.ResourceId, fencingToken)
 		return &lock.TryLockResponse{
-			Success: true,
+			Success:      true,
+			FencingToken: fencingToken,
 		}, err
 	}
 
@@ -203,19 +274,61 @@ func (c *ClusterRedisLock) LockSingleRedis(clientIndex int, req *lock.TryLockReq
 	msg := resultMsg{
 		host: c.metadata.Hosts[clientIndex],
 	}
-	nx := c.clients[clientIndex].SetNX(c.ctx, req.ResourceId, req.LockOwner, time.Second*time.Duration(req.Expire))
-	if nx == nil {
-		msg.error = fmt.Errorf("[ClusterRedisLock]: SetNX returned nil. host: %s \n ResourceId: %s", c.clients[clientIndex], req.ResourceId)
+	eval := c.clients[clientIndex].Eval(c.ctx, tryLockScript, []string{req.ResourceId, fencingTokenKey(req.ResourceId)},
+		req.LockOwner, int64(req.Expire)*1000)
+	if eval == nil {
+		msg.error = fmt.Errorf("[ClusterRedisLock]: Eval trylock script returned nil. host: %s \n ResourceId: %s", c.clients[clientIndex], req.ResourceId)
+		ch <- msg
+		return
+	}
+	token, err := eval.Int64()
+	if err != nil {
+		msg.error = fmt.Errorf("[ClusterRedisLock]: %s host: %s \n ResourceId: %s", err.Error(), c.clients[clientIndex], req.ResourceId)
+		ch <- msg
+		return
+	}
+	msg.lockStatus = token > 0
+	msg.fencingToken = token
+	ch <- msg
+}
+
+func (c *ClusterRedisLock) KeepAliveSingleRedis(clientIndex int, req *lock.LockKeepAliveRequest, wg *sync.WaitGroup, ch chan resultMsg) {
+	defer wg.Done()
+	msg := resultMsg{
+		host: c.metadata.Hosts[clientIndex],
+	}
+	eval := c.clients[clientIndex].Eval(c.ctx, keepAliveScript, []string{req.ResourceId, fencingTokenKey(req.ResourceId)},
+		req.LockOwner, int64(req.Expire)*1000)
+	if eval == nil {
+		msg.error = fmt.Errorf("[ClusterRedisLock]: Eval keepalive script returned nil. host: %s \n ResourceId: %s", c.clients[clientIndex], req.ResourceId)
 		ch <- msg
 		return
 	}
-	if nx.Err() != nil {
-		msg.error = fmt.Errorf("[ClusterRedisLock]: %s host: %s \n ResourceId: %s", nx.Err().Error(), c.clients[clientIndex], req.ResourceId)
+	i, err := eval.Int64()
+	if err != nil {
+		msg.error = fmt.Errorf("[ClusterRedisLock]: %s host: %s \n ResourceId: %s", err.Error(), c.clients[clientIndex], req.ResourceId)
+		ch <- msg
+		return
 	}
-	msg.lockStatus = nx.Val()
+	resp := newKeepAliveResponse(req.ResourceId, i)
+	msg.unlockStatus = resp.Status
+	msg.fencingToken = resp.FencingToken
 	ch <- msg
 }
 
+// raiseFencingToken makes sure the token counter on every node is not less than the given token
+func (c *ClusterRedisLock) raiseFencingToken(resourceId string, token int64) {
+	for i, client := range c.clients {
+		eval := client.Eval(c.ctx, raiseFencingTokenScript, []string{fencingTokenKey(resourceId)}, token)
+		if eval == nil {
+			continue
+		}
+		if err := eval.Err(); err != nil {
+			c.logger.Errorf("[ClusterRedisLock]: raise fencing token error: %s host: %s \n ResourceId: %s", err.Error(), c.metadata.Hosts[i], resourceId)
+		}
+	}
+}
+
 func (c *ClusterRedisLock) UnlockSingleRedis(clientIndex int, req *lock.UnlockRequest, wg *sync.WaitGroup, ch chan resultMsg) {
 	defer wg.Done()
 	eval := c.clients[clientIndex].Eval(c.ctx, unlockScript, []string{req.ResourceId}, req.LockOwner)
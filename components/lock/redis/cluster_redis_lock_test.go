@@ -151,8 +151,84 @@ func TestClusterRedisLock_TryLock(t *testing.T) {
 		wg.Done()
 	}()
 	wg.Wait()
-	// not implement LockKeepAlive
-	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{})
-	assert.Nil(t, keepAliveResp)
-	assert.Nil(t, err)
+}
+
+func TestClusterRedisLock_FencingTokenAndKeepAlive(t *testing.T) {
+	// start 5 miniredis instances
+	redisAddrs := make([]string, 0, 5)
+	var err error
+	for i := 0; i < 5; i++ {
+		redis, err := miniredis.Run()
+		assert.NoError(t, err)
+		defer redis.Close()
+		redisAddrs = append(redisAddrs, redis.Addr())
+	}
+	// construct component
+	comp := NewClusterRedisLock(log.DefaultLogger)
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["redisHosts"] = strings.Join(redisAddrs, ",")
+	cfg.Properties["redisPassword"] = ""
+	// init
+	err = comp.Init(cfg)
+	assert.NoError(t, err)
+	assert.True(t, lock.FeatureFencingToken.IsPresent(comp.Features()))
+	// 1. client1 trylock
+	ownerId1 := uuid.New().String()
+	resp, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: cResourceId,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.True(t, resp.FencingToken > 0)
+	// 2. client1 renews the lease and gets the same token
+	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: cResourceId,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, keepAliveResp.Status)
+	assert.Equal(t, resp.FencingToken, keepAliveResp.FencingToken)
+	// 3. client2 can't renew the lease
+	ownerId2 := uuid.New().String()
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: cResourceId,
+		LockOwner:  ownerId2,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_BELONG_TO_OTHERS, keepAliveResp.Status)
+	// 4. client1 unlock
+	unlockResp, err := comp.Unlock(context.TODO(), &lock.UnlockRequest{
+		ResourceId: cResourceId,
+		LockOwner:  ownerId1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	// 5. client2 gets a bigger token
+	resp2, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: cResourceId,
+		LockOwner:  ownerId2,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp2.Success)
+	assert.True(t, resp2.FencingToken > resp.FencingToken)
+	// 6. the lock doesn't exist after unlock
+	_, err = comp.Unlock(context.TODO(), &lock.UnlockRequest{
+		ResourceId: cResourceId,
+		LockOwner:  ownerId2,
+	})
+	assert.NoError(t, err)
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: cResourceId,
+		LockOwner:  ownerId2,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_UNEXIST, keepAliveResp.Status)
 }
@@ -15,6 +15,8 @@ package redis
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -39,7 +41,7 @@ type StandaloneRedisLock struct {
 // NewStandaloneRedisLock returns a new redis lock store
 func NewStandaloneRedisLock(logger log.ErrorLogger) *StandaloneRedisLock {
 	s := &StandaloneRedisLock{
-		features: make([]lock.Feature, 0),
+		features: []lock.Feature{lock.FeatureFencingToken, lock.FeatureSharedLock, lock.FeatureReentrantLock, lock.FeatureListLock},
 		logger:   logger,
 	}
 
@@ -70,35 +72,226 @@ func (p *StandaloneRedisLock) Features() []lock.Feature {
 }
 
 // LockKeepAlive try to renewal lease
-func (p *StandaloneRedisLock) LockKeepAlive(ctx context.Context, request *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
-	//TODO: implemnt function
-	return nil, nil
+func (p *StandaloneRedisLock) LockKeepAlive(ctx context.Context, req *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
+	// 1. delegate to client.eval lua script
+	now := time.Now()
+	eval := p.client.Eval(p.ctx, holdersKeepAliveScript, []string{req.ResourceId, fencingTokenKey(req.ResourceId)},
+		req.LockOwner, now.UnixMilli(), expireAt(now, req.Expire), int64(req.Expire)*1000)
+	// 2. check error
+	if eval == nil {
+		return newInternalErrorKeepAliveResponse(req.ResourceId), fmt.Errorf("[standaloneRedisLock]: Eval keepalive script returned nil.ResourceId: %s", req.ResourceId)
+	}
+	i, err := eval.Int64()
+	if err != nil {
+		return newInternalErrorKeepAliveResponse(req.ResourceId), err
+	}
+	// 3. parse result
+	return newKeepAliveResponse(req.ResourceId, i), nil
 }
 
 // Node tries to acquire a redis lock
 func (p *StandaloneRedisLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
-	// 1.Setting redis expiration time
-	nx := p.client.SetNX(p.ctx, req.ResourceId, req.LockOwner, time.Second*time.Duration(req.Expire))
-	if nx == nil {
-		return &lock.TryLockResponse{}, fmt.Errorf("[standaloneRedisLock]: SetNX returned nil.ResourceId: %s", req.ResourceId)
+	// 1. add the holder with expiration time and increase the fencing token atomically
+	reentrant := 0
+	if req.Reentrant {
+		reentrant = 1
+	}
+	now := time.Now()
+	eval := p.client.Eval(p.ctx, holdersTryLockScript, []string{req.ResourceId, fencingTokenKey(req.ResourceId)},
+		req.LockOwner, now.UnixMilli(), expireAt(now, req.Expire), int64(req.Expire)*1000, int(req.Mode), reentrant)
+	if eval == nil {
+		return &lock.TryLockResponse{}, fmt.Errorf("[standaloneRedisLock]: Eval trylock script returned nil.ResourceId: %s", req.ResourceId)
 	}
 	// 2. check error
-	err := nx.Err()
+	token, err := eval.Int64()
 	if err != nil {
 		return &lock.TryLockResponse{}, err
 	}
 
 	return &lock.TryLockResponse{
-		Success: nx.Val(),
+		Success:      token > 0,
+		FencingToken: token,
 	}, nil
 }
 
-const unlockScript = "local v = redis.call(\"get\",KEYS[1]); if v==false then return -1 end; if v~=ARGV[1] then return -2 else return redis.call(\"del\",KEYS[1]) end"
+const (
+	// fencingTokenSuffix can't be contained in the lock key, so the token key never conflicts with a lock key
+	fencingTokenSuffix = "||fencing_token"
+)
+
+// An EXCLUSIVE and non-reentrant standalone redis lock is a string whose value is the owner, which expires with the lease.
+// It's the same as the locks of the older versions and the cluster redis locks, so they can work on the same keys.
+//
+// Otherwise the lock is a hash, so that it can be held by several owners in SHARED mode,
+// or be held by an owner several times if it's reentrant:
+//
+//	mode      : the LockMode of the lock
+//	c:<owner> : how many times the owner holds the lock
+//	e:<owner> : the unix millisecond when the owner's lease expires
+//	t:<owner> : the fencing token of the owner
+//	a:<owner> : the unix millisecond when the owner acquired the lock
+//
+// The key itself expires after the longest lease.
+// The scripts below handle both formats. They remove expired holders of a hash first,
+// and KEYS[2] is the fencing token counter, ARGV[1] is the lock owner, ARGV[2] is the current unix millisecond.
+const (
+	isStringLockScript = `
+if redis.call('type', KEYS[1]).ok == 'string' then
+`
+	pruneHoldersScript = `
+local now = tonumber(ARGV[2])
+local holders = 0
+local fields = redis.call('hgetall', KEYS[1])
+for i = 1, #fields, 2 do
+	if string.sub(fields[i], 1, 2) == 'e:' then
+		local owner = string.sub(fields[i], 3)
+		if tonumber(fields[i + 1]) <= now then
+			redis.call('hdel', KEYS[1], 'c:' .. owner, 'e:' .. owner, 't:' .. owner, 'a:' .. owner)
+		else
+			holders = holders + 1
+		end
+	end
+end
+if holders == 0 then
+	redis.call('del', KEYS[1])
+end
+`
+	// renewHolderScript sets the lease of the owner to expire at ARGV[3], and makes sure the key lives for ARGV[4] milliseconds at least
+	renewHolderScript = `
+local function renew()
+	redis.call('hset', KEYS[1], 'e:' .. ARGV[1], ARGV[3])
+	if redis.call('pttl', KEYS[1]) < tonumber(ARGV[4]) then
+		redis.call('pexpire', KEYS[1], ARGV[4])
+	end
+end
+`
+	// holdersTryLockScript returns the fencing token if the lock is acquired, otherwise returns 0.
+	// ARGV[5] is the lock mode, and ARGV[6] is 1 if the lock is reentrant
+	holdersTryLockScript = isStringLockScript + `
+	return 0
+end
+` + pruneHoldersScript + renewHolderScript + `
+if holders == 0 and ARGV[5] == '0' and ARGV[6] ~= '1' then
+	redis.call('set', KEYS[1], ARGV[1], 'PX', ARGV[4])
+	return redis.call('incr', KEYS[2])
+end
+if holders > 0 then
+	local mode = redis.call('hget', KEYS[1], 'mode')
+	if redis.call('hget', KEYS[1], 'c:' .. ARGV[1]) then
+		if ARGV[6] ~= '1' or mode ~= ARGV[5] then
+			return 0
+		end
+		redis.call('hincrby', KEYS[1], 'c:' .. ARGV[1], 1)
+		renew()
+		return tonumber(redis.call('hget', KEYS[1], 't:' .. ARGV[1]))
+	end
+	if mode == '0' or ARGV[5] == '0' then
+		return 0
+	end
+end
+local token = redis.call('incr', KEYS[2])
+redis.call('hmset', KEYS[1], 'mode', ARGV[5], 'c:' .. ARGV[1], 1, 't:' .. ARGV[1], token, 'a:' .. ARGV[1], ARGV[2])
+renew()
+return token
+`
+	// holdersUnlockScript returns how many times the owner still holds the lock, or returns -1 or -2
+	holdersUnlockScript = isStringLockScript + `
+	if redis.call('get', KEYS[1]) ~= ARGV[1] then
+		return -2
+	end
+	redis.call('del', KEYS[1])
+	return 0
+end
+` + pruneHoldersScript + `
+if holders == 0 then
+	return -1
+end
+local count = redis.call('hget', KEYS[1], 'c:' .. ARGV[1])
+if not count then
+	return -2
+end
+if tonumber(count) > 1 then
+	return redis.call('hincrby', KEYS[1], 'c:' .. ARGV[1], -1)
+end
+redis.call('hdel', KEYS[1], 'c:' .. ARGV[1], 'e:' .. ARGV[1], 't:' .. ARGV[1], 'a:' .. ARGV[1])
+if holders == 1 then
+	redis.call('del', KEYS[1])
+end
+return 0
+`
+	// holdersKeepAliveScript returns the fencing token if the lease is renewed, otherwise returns -1 or -2
+	holdersKeepAliveScript = isStringLockScript + `
+	if redis.call('get', KEYS[1]) ~= ARGV[1] then
+		return -2
+	end
+	redis.call('pexpire', KEYS[1], ARGV[4])
+	return tonumber(redis.call('get', KEYS[2]) or '0')
+end
+` + pruneHoldersScript + renewHolderScript + `
+if holders == 0 then
+	return -1
+end
+if not redis.call('hget', KEYS[1], 'c:' .. ARGV[1]) then
+	return -2
+end
+renew()
+return tonumber(redis.call('hget', KEYS[1], 't:' .. ARGV[1]))
+`
+	// holderCountScript returns the lock mode, the holder count and how many times the owner holds the lock
+	holderCountScript = isStringLockScript + `
+	if redis.call('get', KEYS[1]) == ARGV[1] then
+		return {0, 1, 1}
+	end
+	return {0, 1, 0}
+end
+` + pruneHoldersScript + `
+if holders == 0 then
+	return {0, 0, 0}
+end
+return {tonumber(redis.call('hget', KEYS[1], 'mode')), holders, tonumber(redis.call('hget', KEYS[1], 'c:' .. ARGV[1]) or '0')}
+`
+	// lockInfoScript returns the lock mode followed by the owner, expire time, acquire time and fencing token of each holder,
+	// or returns an empty array if nobody holds the lock. The acquire time of a string lock is unknown, which is 0.
+	lockInfoScript = isStringLockScript + `
+	local ttl = redis.call('pttl', KEYS[1])
+	if ttl < 0 then
+		ttl = 0
+	end
+	return {0, redis.call('get', KEYS[1]), tonumber(ARGV[2]) + ttl, 0, tonumber(redis.call('get', KEYS[2]) or '0')}
+end
+` + pruneHoldersScript + `
+if holders == 0 then
+	return {}
+end
+local result = {tonumber(redis.call('hget', KEYS[1], 'mode'))}
+fields = redis.call('hgetall', KEYS[1])
+for i = 1, #fields, 2 do
+	if string.sub(fields[i], 1, 2) == 'e:' then
+		local owner = string.sub(fields[i], 3)
+		table.insert(result, owner)
+		table.insert(result, tonumber(fields[i + 1]))
+		table.insert(result, tonumber(redis.call('hget', KEYS[1], 'a:' .. owner) or '0'))
+		table.insert(result, tonumber(redis.call('hget', KEYS[1], 't:' .. owner) or '0'))
+	end
+end
+return result
+`
+)
+
+// expireAt returns the unix millisecond when a lease of expire seconds from now expires
+func expireAt(now time.Time, expire int32) int64 {
+	return now.Add(time.Duration(expire) * time.Second).UnixMilli()
+}
+
+// fencingTokenKey returns the key of the counter which generates fencing tokens for this resource
+func fencingTokenKey(resourceId string) string {
+	return resourceId + fencingTokenSuffix
+}
 
 // Node tries to release a redis lock
 func (p *StandaloneRedisLock) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
 	// 1. delegate to client.eval lua script
-	eval := p.client.Eval(p.ctx, unlockScript, []string{req.ResourceId}, req.LockOwner)
+	eval := p.client.Eval(p.ctx, holdersUnlockScript, []string{req.ResourceId}, req.LockOwner, time.Now().UnixMilli())
 	// 2. check error
 	if eval == nil {
 		return newInternalErrorUnlockResponse(), fmt.Errorf("[standaloneRedisLock]: Eval unlock script returned nil.ResourceId: %s", req.ResourceId)
@@ -127,6 +320,132 @@ func (p *StandaloneRedisLock) Unlock(ctx context.Context, req *lock.UnlockReques
 	}, nil
 }
 
+// GetHolderCount counts the holders of a redis lock
+func (p *StandaloneRedisLock) GetHolderCount(ctx context.Context, req *lock.GetHolderCountRequest) (*lock.GetHolderCountResponse, error) {
+	eval := p.client.Eval(p.ctx, holderCountScript, []string{req.ResourceId}, req.LockOwner, time.Now().UnixMilli())
+	if eval == nil {
+		return &lock.GetHolderCountResponse{}, fmt.Errorf("[standaloneRedisLock]: Eval holder count script returned nil.ResourceId: %s", req.ResourceId)
+	}
+	result, err := eval.Int64Slice()
+	if err != nil {
+		return &lock.GetHolderCountResponse{}, err
+	}
+	if len(result) != 3 {
+		return &lock.GetHolderCountResponse{}, fmt.Errorf("[standaloneRedisLock]: Eval holder count script returned %v.ResourceId: %s", result, req.ResourceId)
+	}
+	return &lock.GetHolderCountResponse{
+		Mode:           lock.LockMode(result[0]),
+		HolderCount:    int32(result[1]),
+		ReentrantCount: int32(result[2]),
+	}, nil
+}
+
+// GetLockInfo returns the holders of a redis lock
+func (p *StandaloneRedisLock) GetLockInfo(ctx context.Context, req *lock.GetLockInfoRequest) (*lock.GetLockInfoResponse, error) {
+	info, err := p.getLockInfo(req.ResourceId)
+	if err != nil {
+		return &lock.GetLockInfoResponse{}, err
+	}
+	if info == nil {
+		return &lock.GetLockInfoResponse{}, nil
+	}
+	return &lock.GetLockInfoResponse{
+		Mode:    info.Mode,
+		Holders: info.Holders,
+	}, nil
+}
+
+// ListLocks scans the keys with the prefix, so it may be slow if there are lots of keys
+func (p *StandaloneRedisLock) ListLocks(ctx context.Context, req *lock.ListLocksRequest) (*lock.ListLocksResponse, error) {
+	resp := &lock.ListLocksResponse{}
+	iter := p.client.Scan(p.ctx, 0, escapeGlob(req.Prefix)+"*", 0).Iterator()
+	for iter.Next(p.ctx) {
+		if req.Limit > 0 && len(resp.Locks) >= int(req.Limit) {
+			break
+		}
+		key := iter.Val()
+		if strings.HasSuffix(key, fencingTokenSuffix) {
+			continue
+		}
+		info, err := p.getLockInfo(key)
+		if err != nil {
+			return &lock.ListLocksResponse{}, err
+		}
+		if info != nil {
+			resp.Locks = append(resp.Locks, info)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return &lock.ListLocksResponse{}, fmt.Errorf("[standaloneRedisLock]: Scan locks returned error: %s.Prefix: %s", err, req.Prefix)
+	}
+	return resp, nil
+}
+
+// ForceUnlock deletes the lock no matter who holds it.
+// The fencing token counter is kept, so that tokens are still increasing after the lock is acquired again.
+func (p *StandaloneRedisLock) ForceUnlock(ctx context.Context, req *lock.ForceUnlockRequest) (*lock.UnlockResponse, error) {
+	deleted, err := p.client.Del(p.ctx, req.ResourceId).Result()
+	if err != nil {
+		return newInternalErrorUnlockResponse(), fmt.Errorf("[standaloneRedisLock]: Force unlock returned error: %s.ResourceId: %s", err, req.ResourceId)
+	}
+	if deleted == 0 {
+		return &lock.UnlockResponse{Status: lock.LOCK_UNEXIST}, nil
+	}
+	return &lock.UnlockResponse{Status: lock.SUCCESS}, nil
+}
+
+// getLockInfo returns nil if nobody holds the lock
+func (p *StandaloneRedisLock) getLockInfo(resourceId string) (*lock.LockInfo, error) {
+	now := time.Now().UnixMilli()
+	result, err := p.client.Eval(p.ctx, lockInfoScript, []string{resourceId, fencingTokenKey(resourceId)}, "", now).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("[standaloneRedisLock]: Eval lock info script returned error: %s.ResourceId: %s", err, resourceId)
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	if len(result)%4 != 1 {
+		return nil, fmt.Errorf("[standaloneRedisLock]: Eval lock info script returned %v.ResourceId: %s", result, resourceId)
+	}
+	mode, _ := result[0].(int64)
+	info := &lock.LockInfo{
+		ResourceId: resourceId,
+		Mode:       lock.LockMode(mode),
+	}
+	for i := 1; i < len(result); i += 4 {
+		owner, _ := result[i].(string)
+		expireAt, _ := result[i+1].(int64)
+		acquiredAt, _ := result[i+2].(int64)
+		token, _ := result[i+3].(int64)
+		holder := &lock.LockHolder{
+			LockOwner:    owner,
+			TTL:          time.Duration(expireAt-now) * time.Millisecond,
+			FencingToken: token,
+		}
+		if acquiredAt > 0 {
+			holder.AcquiredAt = time.UnixMilli(acquiredAt)
+		}
+		info.Holders = append(info.Holders, holder)
+	}
+	sort.Slice(info.Holders, func(i, j int) bool {
+		return info.Holders[i].FencingToken < info.Holders[j].FencingToken
+	})
+	return info, nil
+}
+
+// escapeGlob escapes the special characters of the glob-style pattern used by SCAN
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		switch c {
+		case '*', '?', '[', ']', '\\':
+			b.WriteRune('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
 // newInternalErrorUnlockResponse is to return lock release error
 func newInternalErrorUnlockResponse() *lock.UnlockResponse {
 	return &lock.UnlockResponse{
@@ -134,6 +453,33 @@ func newInternalErrorUnlockResponse() *lock.UnlockResponse {
 	}
 }
 
+// newInternalErrorKeepAliveResponse is to return lease renewal error
+func newInternalErrorKeepAliveResponse(resourceId string) *lock.LockKeepAliveResponse {
+	return &lock.LockKeepAliveResponse{
+		ResourceId: resourceId,
+		Status:     lock.INTERNAL_ERROR,
+	}
+}
+
+// newKeepAliveResponse converts the result of the keep alive scripts into a response
+func newKeepAliveResponse(resourceId string, result int64) *lock.LockKeepAliveResponse {
+	resp := &lock.LockKeepAliveResponse{
+		ResourceId: resourceId,
+	}
+	switch {
+	case result >= 0:
+		resp.Status = lock.SUCCESS
+		resp.FencingToken = result
+	case result == -1:
+		resp.Status = lock.LOCK_UNEXIST
+	case result == -2:
+		resp.Status = lock.LOCK_BELONG_TO_OTHERS
+	default:
+		resp.Status = lock.INTERNAL_ERROR
+	}
+	return resp
+}
+
 // Close shuts down the client's redis connections.
 func (p *StandaloneRedisLock) Close() error {
 	if p.cancel != nil {
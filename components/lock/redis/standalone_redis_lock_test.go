@@ -16,6 +16,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	miniredis "github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
@@ -150,3 +151,300 @@ func TestStandaloneRedisLock_TryLock(t *testing.T) {
 	}()
 	wg.Wait()
 }
+
+func TestStandaloneRedisLock_FencingTokenAndKeepAlive(t *testing.T) {
+	// 0. prepare
+	// start redis
+	s, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer s.Close()
+	// construct component
+	comp := NewStandaloneRedisLock(log.DefaultLogger)
+	defer comp.Close()
+
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["redisHost"] = s.Addr()
+	cfg.Properties["redisPassword"] = ""
+	// init
+	err = comp.Init(cfg)
+	assert.NoError(t, err)
+	assert.True(t, lock.FeatureFencingToken.IsPresent(comp.Features()))
+	// 1. client1 trylock
+	ownerId1 := uuid.New().String()
+	resp, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(1), resp.FencingToken)
+	// 2. client2 fails to trylock and gets no token
+	ownerId2 := uuid.New().String()
+	resp2, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId2,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.False(t, resp2.Success)
+	assert.Equal(t, int64(0), resp2.FencingToken)
+	// 3. client1 renews the lease
+	s.FastForward(5 * time.Second)
+	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+		Expire:     20,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, keepAliveResp.Status)
+	assert.Equal(t, resp.FencingToken, keepAliveResp.FencingToken)
+	assert.True(t, s.TTL(resourceId) > 10*time.Second)
+	// 4. client2 can't renew the lease
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId2,
+		Expire:     20,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_BELONG_TO_OTHERS, keepAliveResp.Status)
+	// 5. the lock expires and client2 gets a bigger token
+	s.FastForward(30 * time.Second)
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId1,
+		Expire:     20,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_UNEXIST, keepAliveResp.Status)
+	resp2, err = comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  ownerId2,
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp2.Success)
+	assert.Equal(t, int64(2), resp2.FencingToken)
+}
+
+func TestStandaloneRedisLock_SharedAndReentrant(t *testing.T) {
+	// 0. prepare
+	// start redis
+	s, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer s.Close()
+	// construct component
+	comp := NewStandaloneRedisLock(log.DefaultLogger)
+	defer comp.Close()
+
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["redisHost"] = s.Addr()
+	cfg.Properties["redisPassword"] = ""
+	// init
+	err = comp.Init(cfg)
+	assert.NoError(t, err)
+	assert.True(t, lock.FeatureSharedLock.IsPresent(comp.Features()))
+	assert.True(t, lock.FeatureReentrantLock.IsPresent(comp.Features()))
+	// 1. two readers share the lock, and reader1 reenters it
+	reader1 := uuid.New().String()
+	reader2 := uuid.New().String()
+	readReq := &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  reader1,
+		Expire:     10,
+		Mode:       lock.SHARED,
+		Reentrant:  true,
+	}
+	resp, err := comp.TryLock(context.TODO(), readReq)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	token := resp.FencingToken
+	resp, err = comp.TryLock(context.TODO(), readReq)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, token, resp.FencingToken)
+	resp, err = comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  reader2,
+		Expire:     10,
+		Mode:       lock.SHARED,
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.True(t, resp.FencingToken > token)
+	countResp, err := comp.GetHolderCount(context.TODO(), &lock.GetHolderCountRequest{
+		ResourceId: resourceId,
+		LockOwner:  reader1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SHARED, countResp.Mode)
+	assert.Equal(t, int32(2), countResp.HolderCount)
+	assert.Equal(t, int32(2), countResp.ReentrantCount)
+	// 2. the writer is blocked by readers
+	writer := uuid.New().String()
+	writeReq := &lock.TryLockRequest{
+		ResourceId: resourceId,
+		LockOwner:  writer,
+		Expire:     10,
+	}
+	resp, err = comp.TryLock(context.TODO(), writeReq)
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+	// 3. readers leave, reader1 has to unlock twice
+	for _, owner := range []string{reader1, reader2, reader1} {
+		unlockResp, err := comp.Unlock(context.TODO(), &lock.UnlockRequest{
+			ResourceId: resourceId,
+			LockOwner:  owner,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	}
+	assert.False(t, s.Exists(resourceId))
+	// 4. the writer gets the lock, and it can't be reentered without the reentrant flag
+	resp, err = comp.TryLock(context.TODO(), writeReq)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	resp, err = comp.TryLock(context.TODO(), writeReq)
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+	countResp, err = comp.GetHolderCount(context.TODO(), &lock.GetHolderCountRequest{
+		ResourceId: resourceId,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.EXCLUSIVE, countResp.Mode)
+	assert.Equal(t, int32(1), countResp.HolderCount)
+	assert.Equal(t, int32(0), countResp.ReentrantCount)
+}
+
+func TestStandaloneRedisLock_LockInfo(t *testing.T) {
+	// 0. prepare
+	// start redis
+	s, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer s.Close()
+	// construct component
+	comp := NewStandaloneRedisLock(log.DefaultLogger)
+	defer comp.Close()
+
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["redisHost"] = s.Addr()
+	cfg.Properties["redisPassword"] = ""
+	// init
+	err = comp.Init(cfg)
+	assert.NoError(t, err)
+	assert.True(t, lock.FeatureListLock.IsPresent(comp.Features()))
+	// 1. two readers hold a lock, and a writer holds another one
+	for _, req := range []*lock.TryLockRequest{
+		{ResourceId: "order||1", LockOwner: "owner1", Expire: 10, Mode: lock.SHARED},
+		{ResourceId: "order||1", LockOwner: "owner2", Expire: 20, Mode: lock.SHARED},
+		{ResourceId: "order||2", LockOwner: "owner3", Expire: 10},
+		{ResourceId: "user||1", LockOwner: "owner4", Expire: 10},
+	} {
+		resp, err := comp.TryLock(context.TODO(), req)
+		assert.NoError(t, err)
+		assert.True(t, resp.Success)
+	}
+	// 2. get lock info
+	infoResp, err := comp.GetLockInfo(context.TODO(), &lock.GetLockInfoRequest{ResourceId: "order||1"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SHARED, infoResp.Mode)
+	assert.Len(t, infoResp.Holders, 2)
+	assert.Equal(t, "owner1", infoResp.Holders[0].LockOwner)
+	assert.True(t, infoResp.Holders[0].TTL > 9*time.Second && infoResp.Holders[0].TTL <= 10*time.Second)
+	assert.True(t, time.Since(infoResp.Holders[0].AcquiredAt) < time.Minute)
+	assert.Equal(t, "owner2", infoResp.Holders[1].LockOwner)
+	assert.True(t, infoResp.Holders[1].FencingToken > 0)
+	// 3. list locks, the fencing token counters are not listed
+	listResp, err := comp.ListLocks(context.TODO(), &lock.ListLocksRequest{Prefix: "order||"})
+	assert.NoError(t, err)
+	assert.Len(t, listResp.Locks, 2)
+	for _, info := range listResp.Locks {
+		assert.Contains(t, []string{"order||1", "order||2"}, info.ResourceId)
+	}
+	// 4. force unlock
+	unlockResp, err := comp.ForceUnlock(context.TODO(), &lock.ForceUnlockRequest{ResourceId: "order||1"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	infoResp, err = comp.GetLockInfo(context.TODO(), &lock.GetLockInfoRequest{ResourceId: "order||1"})
+	assert.NoError(t, err)
+	assert.Empty(t, infoResp.Holders)
+	unlockResp, err = comp.ForceUnlock(context.TODO(), &lock.ForceUnlockRequest{ResourceId: "order||1"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_UNEXIST, unlockResp.Status)
+}
+
+func TestStandaloneRedisLock_StringLock(t *testing.T) {
+	// 0. prepare
+	// start redis
+	s, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer s.Close()
+	// construct component
+	comp := NewStandaloneRedisLock(log.DefaultLogger)
+	defer comp.Close()
+
+	cfg := lock.Metadata{
+		Properties: make(map[string]string),
+	}
+	cfg.Properties["redisHost"] = s.Addr()
+	cfg.Properties["redisPassword"] = ""
+	// init
+	err = comp.Init(cfg)
+	assert.NoError(t, err)
+	// 1. the exclusive and non-reentrant lock is a string, as the older versions
+	resp, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{ResourceId: resourceId, LockOwner: "owner1", Expire: 10})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, "string", s.Type(resourceId))
+	v, _ := s.Get(resourceId)
+	assert.Equal(t, "owner1", v)
+	// 2. the lock held by an older version works with the other modes
+	s.Set(resourceId, "old")
+	s.SetTTL(resourceId, 10*time.Second)
+	for _, req := range []*lock.TryLockRequest{
+		{ResourceId: resourceId, LockOwner: "owner2", Expire: 10},
+		{ResourceId: resourceId, LockOwner: "owner2", Expire: 10, Mode: lock.SHARED},
+		{ResourceId: resourceId, LockOwner: "old", Expire: 10, Reentrant: true},
+	} {
+		resp, err = comp.TryLock(context.TODO(), req)
+		assert.NoError(t, err)
+		assert.False(t, resp.Success)
+	}
+	countResp, err := comp.GetHolderCount(context.TODO(), &lock.GetHolderCountRequest{ResourceId: resourceId, LockOwner: "old"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.EXCLUSIVE, countResp.Mode)
+	assert.Equal(t, int32(1), countResp.HolderCount)
+	assert.Equal(t, int32(1), countResp.ReentrantCount)
+	infoResp, err := comp.GetLockInfo(context.TODO(), &lock.GetLockInfoRequest{ResourceId: resourceId})
+	assert.NoError(t, err)
+	assert.Len(t, infoResp.Holders, 1)
+	assert.Equal(t, "old", infoResp.Holders[0].LockOwner)
+	assert.Equal(t, int64(1), infoResp.Holders[0].FencingToken)
+	assert.True(t, infoResp.Holders[0].TTL > 9*time.Second)
+	assert.True(t, infoResp.Holders[0].AcquiredAt.IsZero())
+	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{ResourceId: resourceId, LockOwner: "owner2", Expire: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_BELONG_TO_OTHERS, keepAliveResp.Status)
+	keepAliveResp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{ResourceId: resourceId, LockOwner: "old", Expire: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, keepAliveResp.Status)
+	assert.True(t, s.TTL(resourceId) > 10*time.Second)
+	unlockResp, err := comp.Unlock(context.TODO(), &lock.UnlockRequest{ResourceId: resourceId, LockOwner: "owner2"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_BELONG_TO_OTHERS, unlockResp.Status)
+	unlockResp, err = comp.Unlock(context.TODO(), &lock.UnlockRequest{ResourceId: resourceId, LockOwner: "old"})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, unlockResp.Status)
+	// 3. the shared lock is a hash
+	resp, err = comp.TryLock(context.TODO(), &lock.TryLockRequest{ResourceId: resourceId, LockOwner: "owner2", Expire: 10, Mode: lock.SHARED})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(2), resp.FencingToken)
+	assert.Equal(t, "hash", s.Type(resourceId))
+}
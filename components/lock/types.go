@@ -12,10 +12,38 @@
 // limitations under the License.
 package lock
 
-import "mosn.io/layotto/components/ref"
+import (
+	"time"
+
+	"mosn.io/layotto/components/ref"
+)
 
 type Feature string
 
+const (
+	// FeatureFencingToken means the lock store returns a monotonically increasing fencing token
+	// every time a lock is acquired, so downstream services can reject writes from a stale owner.
+	FeatureFencingToken Feature = "FENCING_TOKEN"
+	// FeatureSharedLock means the lock store supports SHARED mode,
+	// i.e. the lock can be held by several owners at the same time.
+	FeatureSharedLock Feature = "SHARED_LOCK"
+	// FeatureReentrantLock means an owner holding the lock can acquire it again,
+	// and the lock is released after the owner unlocks it the same times.
+	FeatureReentrantLock Feature = "REENTRANT_LOCK"
+	// FeatureListLock means the lock store can list its locks by the prefix of resource ids.
+	FeatureListLock Feature = "LIST_LOCK"
+)
+
+// IsPresent checks if a given feature is present in the list.
+func (f Feature) IsPresent(features []Feature) bool {
+	for _, feature := range features {
+		if feature == f {
+			return true
+		}
+	}
+	return false
+}
+
 // Lock's metadata
 type Config struct {
 	ref.Config
@@ -33,11 +61,39 @@ type TryLockRequest struct {
 	ResourceId string
 	LockOwner  string
 	Expire     int32
+	// Mode is EXCLUSIVE by default. SHARED mode requires FeatureSharedLock
+	Mode LockMode
+	// Reentrant requires FeatureReentrantLock
+	Reentrant bool
 }
 
 // Lock acquire request was successful or not
 type TryLockResponse struct {
 	Success bool
+	// FencingToken is a monotonically increasing number assigned to every successful acquisition of a lock.
+	// It is only set when the lock store supports FeatureFencingToken.
+	FencingToken int64
+}
+
+// Blocking lock acquire request
+type LockRequest struct {
+	ResourceId string
+	LockOwner  string
+	Expire     int32
+	// WaitTimeout is the max duration to wait for the lock
+	WaitTimeout time.Duration
+	// Mode is EXCLUSIVE by default. SHARED mode requires FeatureSharedLock
+	Mode LockMode
+	// Reentrant requires FeatureReentrantLock
+	Reentrant bool
+}
+
+// Blocking lock acquire request was successful or not
+type LockResponse struct {
+	Success bool
+	// FencingToken is a monotonically increasing number assigned to every successful acquisition of a lock.
+	// It is only set when the lock store supports FeatureFencingToken.
+	FencingToken int64
 }
 
 // Lock release request
@@ -60,8 +116,86 @@ type LockKeepAliveRequest struct {
 type LockKeepAliveResponse struct {
 	ResourceId string
 	Status     LockStatus
+	// FencingToken is the token assigned when the lock was acquired.
+	// It is only set when the lock store supports FeatureFencingToken.
+	FencingToken int64
+}
+
+// Lock holder count request
+type GetHolderCountRequest struct {
+	ResourceId string
+	// LockOwner is optional, it's used to get the ReentrantCount
+	LockOwner string
 }
 
+// Lock holder count response
+type GetHolderCountResponse struct {
+	// Mode of the lock, it's meaningless if nobody holds the lock
+	Mode LockMode
+	// HolderCount is the number of owners holding the lock
+	HolderCount int32
+	// ReentrantCount is the times LockOwner has acquired the lock without unlocking it
+	ReentrantCount int32
+}
+
+// Lock info request
+type GetLockInfoRequest struct {
+	ResourceId string
+}
+
+// Lock info response
+type GetLockInfoResponse struct {
+	// Mode of the lock, it's meaningless if nobody holds the lock
+	Mode LockMode
+	// Holders is empty if nobody holds the lock
+	Holders []*LockHolder
+}
+
+// Lock list request
+type ListLocksRequest struct {
+	// Prefix of the resource ids, all the locks are listed if it's empty
+	Prefix string
+	// Limit is the max number of locks returned, there's no limit if it's 0
+	Limit int32
+}
+
+// Lock list response
+type ListLocksResponse struct {
+	Locks []*LockInfo
+}
+
+// Lock force release request
+type ForceUnlockRequest struct {
+	ResourceId string
+}
+
+// LockInfo is a lock held by somebody
+type LockInfo struct {
+	ResourceId string
+	Mode       LockMode
+	Holders    []*LockHolder
+}
+
+// LockHolder is an owner holding a lock
+type LockHolder struct {
+	LockOwner string
+	// TTL is the remaining time before the lease expires
+	TTL time.Duration
+	// AcquiredAt is the time when the owner acquired the lock, which is zero if the store doesn't record it
+	AcquiredAt   time.Time
+	FencingToken int64
+}
+
+type LockMode int32
+
+// lock mode
+const (
+	// EXCLUSIVE lock can only be held by one owner at the same time, a.k.a. write lock
+	EXCLUSIVE LockMode = 0
+	// SHARED lock can be held by several owners at the same time, a.k.a. read lock
+	SHARED LockMode = 1
+)
+
 type LockStatus int32
 
 // lock status
@@ -16,6 +16,9 @@ package zookeeper
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-zookeeper/zk"
@@ -26,14 +29,42 @@ import (
 	"mosn.io/layotto/components/pkg/utils"
 )
 
-var closeConn = func(conn utils.ZKConnection, expireInSecond int32) {
-	//can also
-	//time.Sleep(time.Second * time.Duration(expireInSecond))
-	<-time.After(time.Second * time.Duration(expireInSecond))
+const (
+	// waitQueueSuffix is appended to the lock node to get the queue node.
+	// It's safe because a resource id can't contain "||".
+	waitQueueSuffix = "||waiters"
+	waiterPrefix    = "waiter-"
+)
+
+var closeConn = func(conn utils.ZKConnection, l *lease) {
+	//the deadline may be extended by LockKeepAlive while waiting
+	for d := l.remaining(); d > 0; d = l.remaining() {
+		<-time.After(d)
+	}
 	// make sure close connecion
 	conn.Close()
 }
 
+// lease records when the session holding a lock node should be closed
+type lease struct {
+	//unix nano, accessed atomically
+	deadline int64
+}
+
+func newLease(expireInSecond int32) *lease {
+	l := &lease{}
+	l.extend(expireInSecond)
+	return l
+}
+
+func (l *lease) extend(expireInSecond int32) {
+	atomic.StoreInt64(&l.deadline, time.Now().Add(time.Duration(expireInSecond)*time.Second).UnixNano())
+}
+
+func (l *lease) remaining() time.Duration {
+	return time.Until(time.Unix(0, atomic.LoadInt64(&l.deadline)))
+}
+
 // ZookeeperLock lock store
 type ZookeeperLock struct {
 	//trylock reestablish connection  every time
@@ -41,7 +72,9 @@ type ZookeeperLock struct {
 	//unlock reuse this conneciton
 	unlockConn utils.ZKConnection
 	metadata   utils.ZookeeperMetadata
-	logger     log.ErrorLogger
+	//leases of the sessions created by this instance, keyed by node path
+	leases sync.Map
+	logger log.ErrorLogger
 }
 
 // NewZookeeperLock Create ZookeeperLock
@@ -74,13 +107,37 @@ func (p *ZookeeperLock) Init(metadata lock.Metadata) error {
 
 // Features is to get ZookeeperLock's features
 func (p *ZookeeperLock) Features() []lock.Feature {
-	return nil
+	return []lock.Feature{lock.FeatureFencingToken}
 }
 
-// LockKeepAlive try to renewal lease
-func (p *ZookeeperLock) LockKeepAlive(ctx context.Context, request *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
-	//TODO: implemnt function
-	return nil, nil
+// LockKeepAlive try to renewal lease.
+// The lock node is ephemeral and lives as long as the session created in TryLock,
+// so renewal postpones closing that session.
+func (p *ZookeeperLock) LockKeepAlive(ctx context.Context, req *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
+	path := "/" + req.ResourceId
+	owner, stat, err := p.unlockConn.Get(path)
+	if err != nil {
+		//node does not exist, indicates this lock has expired
+		if err == zk.ErrNoNode {
+			return &lock.LockKeepAliveResponse{ResourceId: req.ResourceId, Status: lock.LOCK_UNEXIST}, nil
+		}
+		//other err
+		return nil, err
+	}
+	if string(owner) != req.LockOwner {
+		return &lock.LockKeepAliveResponse{ResourceId: req.ResourceId, Status: lock.LOCK_BELONG_TO_OTHERS}, nil
+	}
+	l, ok := p.leases.Load(path)
+	if !ok {
+		//the session holding this node was created by another instance
+		return nil, fmt.Errorf("[zookeeperLock]: the session of lock %s is not held by this instance", req.ResourceId)
+	}
+	l.(*lease).extend(req.Expire)
+	return &lock.LockKeepAliveResponse{
+		ResourceId:   req.ResourceId,
+		Status:       lock.SUCCESS,
+		FencingToken: stat.Czxid,
+	}, nil
 }
 
 // TryLock Node tries to acquire a zookeeper lock
@@ -90,8 +147,9 @@ func (p *ZookeeperLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (
 	if err != nil {
 		return &lock.TryLockResponse{}, err
 	}
+	path := "/" + req.ResourceId
 	//1.create zk ephemeral node
-	_, err = conn.Create("/"+req.ResourceId, []byte(req.LockOwner), zk.FlagEphemeral, zk.WorldACL(zk.PermAll))
+	_, err = conn.Create(path, []byte(req.LockOwner), zk.FlagEphemeral, zk.WorldACL(zk.PermAll))
 
 	//2.1 create node fail ,indicates lock fail
 	if err != nil {
@@ -106,17 +164,151 @@ func (p *ZookeeperLock) TryLock(ctx context.Context, req *lock.TryLockRequest) (
 		return nil, err
 	}
 
-	//2.2 create node success, asyn  to make sure zkclient alive for need time
-	util.GoWithRecover(func() {
-		closeConn(conn, req.Expire)
-	}, nil)
+	//2.2 create node success, hold the lock until the lease expires
+	token, err := p.hold(conn, path, req.Expire)
+	if err != nil {
+		return nil, err
+	}
 
 	return &lock.TryLockResponse{
-		Success: true,
+		Success:      true,
+		FencingToken: token,
 	}, nil
 
 }
 
+// Lock Node waits for a zookeeper lock.
+// Waiters create sequential ephemeral nodes under the queue node of the lock,
+// and only the smallest one competes for the lock, so that waiters acquire the lock in FIFO order.
+func (p *ZookeeperLock) Lock(ctx context.Context, req *lock.LockRequest) (*lock.LockResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, req.WaitTimeout)
+	defer cancel()
+
+	conn, err := p.factory.NewConnection(time.Duration(req.Expire)*time.Second, p.metadata)
+	if err != nil {
+		return &lock.LockResponse{}, err
+	}
+	path := "/" + req.ResourceId
+	queue := path + waitQueueSuffix
+
+	//1.join the waiting queue
+	node, err := joinQueue(conn, queue, req.LockOwner)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	acquired := false
+	defer func() {
+		conn.Delete(node, -1)
+		//remove the queue if nobody is waiting
+		conn.Delete(queue, -1)
+		if !acquired {
+			conn.Close()
+		}
+	}()
+
+	//2.wait until the waiters in front of us are gone
+	name := node[len(queue)+1:]
+	for {
+		children, _, err := conn.Children(queue)
+		if err != nil {
+			return nil, err
+		}
+		prev := ""
+		for _, child := range children {
+			// the sequence numbers are zero padded, so they can be compared as strings
+			if child < name && child > prev {
+				prev = child
+			}
+		}
+		if prev == "" {
+			break
+		}
+		if ok, err := waitDelete(ctx, conn, queue+"/"+prev); err != nil || !ok {
+			return &lock.LockResponse{Success: false}, err
+		}
+	}
+
+	//3.we are the head of the queue, compete for the lock until it's released by the current owner
+	for {
+		_, err = conn.Create(path, []byte(req.LockOwner), zk.FlagEphemeral, zk.WorldACL(zk.PermAll))
+		if err == nil {
+			break
+		}
+		if err != zk.ErrNodeExists {
+			return nil, err
+		}
+		if ok, err := waitDelete(ctx, conn, path); err != nil || !ok {
+			return &lock.LockResponse{Success: false}, err
+		}
+	}
+
+	//4.hold the lock until the lease expires
+	acquired = true
+	token, err := p.hold(conn, path, req.Expire)
+	if err != nil {
+		return nil, err
+	}
+	return &lock.LockResponse{
+		Success:      true,
+		FencingToken: token,
+	}, nil
+}
+
+// hold gets the fencing token of the created lock node, and keeps the session alive until the lease expires
+func (p *ZookeeperLock) hold(conn utils.ZKConnection, path string, expire int32) (int64, error) {
+	//the czxid of the node is used as fencing token,
+	//which is increased monotonically in the whole zookeeper cluster
+	_, stat, err := conn.Get(path)
+	if err != nil {
+		//closing the session deletes the ephemeral node
+		conn.Close()
+		return 0, err
+	}
+
+	//asyn  to make sure zkclient alive for need time
+	l := newLease(expire)
+	p.leases.Store(path, l)
+	util.GoWithRecover(func() {
+		closeConn(conn, l)
+		p.leases.CompareAndDelete(path, l)
+	}, nil)
+	return stat.Czxid, nil
+}
+
+// joinQueue creates a sequential ephemeral node under the queue node
+func joinQueue(conn utils.ZKConnection, queue string, owner string) (string, error) {
+	for {
+		_, err := conn.Create(queue, nil, 0, zk.WorldACL(zk.PermAll))
+		if err != nil && err != zk.ErrNodeExists {
+			return "", err
+		}
+		node, err := conn.Create(queue+"/"+waiterPrefix, []byte(owner), zk.FlagEphemeral|zk.FlagSequence, zk.WorldACL(zk.PermAll))
+		//the queue node may be removed by the last waiter just now
+		if err == zk.ErrNoNode {
+			continue
+		}
+		return node, err
+	}
+}
+
+// waitDelete blocks until the node is deleted. It returns false if the ctx is done before that.
+func waitDelete(ctx context.Context, conn utils.ZKConnection, path string) (bool, error) {
+	exists, _, ch, err := conn.ExistsW(path)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return true, nil
+	}
+	select {
+	case <-ch:
+		return true, nil
+	case <-ctx.Done():
+		return false, nil
+	}
+}
+
 // Unlock Node tries to release a zookeeper lock
 func (p *ZookeeperLock) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
 
@@ -40,7 +40,11 @@ var cfg = lock.Metadata{
 	Properties: make(map[string]string),
 }
 
-var mockCloseConn = func(conn utils.ZKConnection, expireInSecond int32) {
+var mockCloseConn = func(conn utils.ZKConnection, l *lease) {
+	//keep the lease until it expires, but never close the mocked connection
+	for d := l.remaining(); d > 0; d = l.remaining() {
+		<-time.After(d)
+	}
 }
 
 func TestMain(m *testing.M) {
@@ -65,6 +69,7 @@ func TestZookeeperLock_ALock_AUnlock(t *testing.T) {
 	path := "/" + resouseId
 	factory.EXPECT().NewConnection(time.Duration(expireTime)*time.Second, comp.metadata).Return(lockConn, nil).Times(1)
 	lockConn.EXPECT().Create(path, []byte(lockOwerA), int32(zk.FlagEphemeral), zk.WorldACL(zk.PermAll)).Return("", nil).Times(1)
+	lockConn.EXPECT().Get(path).Return([]byte(lockOwerA), &zk.Stat{Version: 0, Czxid: 100}, nil).Times(1)
 	unlockConn.EXPECT().Get(path).Return([]byte(lockOwerA), &zk.Stat{Version: 123}, nil).Times(1)
 	unlockConn.EXPECT().Delete(path, int32(123)).Return(nil).Times(1)
 
@@ -78,6 +83,7 @@ func TestZookeeperLock_ALock_AUnlock(t *testing.T) {
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, tryLock.Success, true)
+	assert.Equal(t, int64(100), tryLock.FencingToken)
 	unlock, _ := comp.Unlock(context.TODO(), &lock.UnlockRequest{
 		ResourceId: resouseId,
 		LockOwner:  lockOwerA,
@@ -100,6 +106,7 @@ func TestZookeeperLock_ALock_BUnlock(t *testing.T) {
 	path := "/" + resouseId
 	factory.EXPECT().NewConnection(time.Duration(expireTime)*time.Second, comp.metadata).Return(lockConn, nil).Times(1)
 	lockConn.EXPECT().Create(path, []byte(lockOwerA), int32(zk.FlagEphemeral), zk.WorldACL(zk.PermAll)).Return("", nil).Times(1)
+	lockConn.EXPECT().Get(path).Return([]byte(lockOwerA), &zk.Stat{Version: 0, Czxid: 100}, nil).Times(1)
 	unlockConn.EXPECT().Get(path).Return([]byte(lockOwerA), &zk.Stat{Version: 123}, nil).Times(1)
 
 	comp.unlockConn = unlockConn
@@ -139,6 +146,10 @@ func TestZookeeperLock_ALock_BLock_AUnlock_BLock_BUnlock(t *testing.T) {
 	lockConn.EXPECT().Create(path, []byte(lockOwerB), int32(zk.FlagEphemeral), zk.WorldACL(zk.PermAll)).Return("", zk.ErrNodeExists).Times(1)
 	lockConn.EXPECT().Create(path, []byte(lockOwerB), int32(zk.FlagEphemeral), zk.WorldACL(zk.PermAll)).Return("", nil).Times(1)
 	lockConn.EXPECT().Close().Return().Times(1)
+	gomock.InOrder(
+		lockConn.EXPECT().Get(path).Return([]byte(lockOwerA), &zk.Stat{Version: 0, Czxid: 100}, nil).Times(1),
+		lockConn.EXPECT().Get(path).Return([]byte(lockOwerB), &zk.Stat{Version: 0, Czxid: 102}, nil).Times(1),
+	)
 
 	unlockConn.EXPECT().Get(path).Return([]byte(lockOwerA), &zk.Stat{Version: 123}, nil).Times(1)
 	unlockConn.EXPECT().Get(path).Return([]byte(lockOwerB), &zk.Stat{Version: 124}, nil).Times(1)
@@ -180,6 +191,7 @@ func TestZookeeperLock_ALock_BLock_AUnlock_BLock_BUnlock(t *testing.T) {
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, true, tryLock.Success)
+	assert.Equal(t, int64(102), tryLock.FencingToken)
 
 	//B unlock
 	unlock, _ = comp.Unlock(context.TODO(), &lock.UnlockRequest{
@@ -188,9 +200,153 @@ func TestZookeeperLock_ALock_BLock_AUnlock_BLock_BUnlock(t *testing.T) {
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, lock.SUCCESS, unlock.Status)
+}
+
+// A lock, A keepalive, B keepalive, keepalive after unlock
+func TestZookeeperLock_LockKeepAlive(t *testing.T) {
+	comp := NewZookeeperLock(log.DefaultLogger)
+	comp.Init(cfg)
+
+	//mock
+	ctrl := gomock.NewController(t)
+	unlockConn := mock.NewMockZKConnection(ctrl)
+	lockConn := mock.NewMockZKConnection(ctrl)
+	factory := mock.NewMockConnectionFactory(ctrl)
+	path := "/" + resouseId
+	factory.EXPECT().NewConnection(time.Duration(expireTime)*time.Second, comp.metadata).Return(lockConn, nil).Times(1)
+	lockConn.EXPECT().Create(path, []byte(lockOwerA), int32(zk.FlagEphemeral), zk.WorldACL(zk.PermAll)).Return("", nil).Times(1)
+	lockConn.EXPECT().Get(path).Return([]byte(lockOwerA), &zk.Stat{Version: 0, Czxid: 100}, nil).Times(1)
+	gomock.InOrder(
+		unlockConn.EXPECT().Get(path).Return([]byte(lockOwerA), &zk.Stat{Version: 0, Czxid: 100}, nil).Times(2),
+		unlockConn.EXPECT().Get(path).Return(nil, nil, zk.ErrNoNode).Times(1),
+	)
+
+	comp.unlockConn = unlockConn
+	comp.factory = factory
+
+	//A lock
+	tryLock, err := comp.TryLock(context.TODO(), &lock.TryLockRequest{
+		ResourceId: resouseId,
+		LockOwner:  lockOwerA,
+		Expire:     expireTime,
+	})
+	assert.NoError(t, err)
+	assert.True(t, tryLock.Success)
+	l, ok := comp.leases.Load(path)
+	assert.True(t, ok)
+	before := l.(*lease).remaining()
+
+	//A keepalive
+	resp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resouseId,
+		LockOwner:  lockOwerA,
+		Expire:     expireTime * 2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.SUCCESS, resp.Status)
+	assert.Equal(t, tryLock.FencingToken, resp.FencingToken)
+	assert.True(t, l.(*lease).remaining() > before)
+
+	//B keepalive
+	resp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resouseId,
+		LockOwner:  lockOwerB,
+		Expire:     expireTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_BELONG_TO_OTHERS, resp.Status)
 
-	// not implement LockKeepAlive
-	keepAliveResp, err := comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{})
-	assert.Nil(t, keepAliveResp)
-	assert.Nil(t, err)
+	//keepalive after the node is deleted
+	resp, err = comp.LockKeepAlive(context.TODO(), &lock.LockKeepAliveRequest{
+		ResourceId: resouseId,
+		LockOwner:  lockOwerA,
+		Expire:     expireTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, lock.LOCK_UNEXIST, resp.Status)
+}
+
+// A waits behind another waiter and the current owner, then gets the lock
+func TestZookeeperLock_Lock(t *testing.T) {
+	comp := NewZookeeperLock(log.DefaultLogger)
+	comp.Init(cfg)
+
+	//mock
+	ctrl := gomock.NewController(t)
+	lockConn := mock.NewMockZKConnection(ctrl)
+	factory := mock.NewMockConnectionFactory(ctrl)
+	path := "/" + resouseId
+	queue := path + waitQueueSuffix
+	prev := waiterPrefix + "0000000000"
+	node := queue + "/" + waiterPrefix + "0000000001"
+	prevDeleted := make(chan zk.Event, 1)
+	lockDeleted := make(chan zk.Event, 1)
+	var prevCh, lockCh <-chan zk.Event = prevDeleted, lockDeleted
+	factory.EXPECT().NewConnection(time.Duration(expireTime)*time.Second, comp.metadata).Return(lockConn, nil).Times(1)
+	gomock.InOrder(
+		lockConn.EXPECT().Create(queue, nil, int32(0), zk.WorldACL(zk.PermAll)).Return("", zk.ErrNodeExists),
+		lockConn.EXPECT().Create(queue+"/"+waiterPrefix, []byte(lockOwerA), int32(zk.FlagEphemeral|zk.FlagSequence), zk.WorldACL(zk.PermAll)).Return(node, nil),
+		lockConn.EXPECT().Children(queue).Return([]string{prev, node[len(queue)+1:]}, &zk.Stat{}, nil),
+		lockConn.EXPECT().ExistsW(queue+"/"+prev).DoAndReturn(func(string) (bool, *zk.Stat, <-chan zk.Event, error) {
+			prevDeleted <- zk.Event{Type: zk.EventNodeDeleted}
+			return true, &zk.Stat{}, prevCh, nil
+		}),
+		lockConn.EXPECT().Children(queue).Return([]string{node[len(queue)+1:]}, &zk.Stat{}, nil),
+		lockConn.EXPECT().Create(path, []byte(lockOwerA), int32(zk.FlagEphemeral), zk.WorldACL(zk.PermAll)).Return("", zk.ErrNodeExists),
+		lockConn.EXPECT().ExistsW(path).DoAndReturn(func(string) (bool, *zk.Stat, <-chan zk.Event, error) {
+			lockDeleted <- zk.Event{Type: zk.EventNodeDeleted}
+			return true, &zk.Stat{}, lockCh, nil
+		}),
+		lockConn.EXPECT().Create(path, []byte(lockOwerA), int32(zk.FlagEphemeral), zk.WorldACL(zk.PermAll)).Return(path, nil),
+		lockConn.EXPECT().Get(path).Return([]byte(lockOwerA), &zk.Stat{Czxid: 200}, nil),
+		lockConn.EXPECT().Delete(node, int32(-1)).Return(nil),
+		lockConn.EXPECT().Delete(queue, int32(-1)).Return(nil),
+	)
+	comp.factory = factory
+
+	resp, err := comp.Lock(context.TODO(), &lock.LockRequest{
+		ResourceId:  resouseId,
+		LockOwner:   lockOwerA,
+		Expire:      expireTime,
+		WaitTimeout: time.Second,
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(200), resp.FencingToken)
+}
+
+// B waits for the lock held by A until timeout
+func TestZookeeperLock_LockTimeout(t *testing.T) {
+	comp := NewZookeeperLock(log.DefaultLogger)
+	comp.Init(cfg)
+
+	//mock
+	ctrl := gomock.NewController(t)
+	lockConn := mock.NewMockZKConnection(ctrl)
+	factory := mock.NewMockConnectionFactory(ctrl)
+	path := "/" + resouseId
+	queue := path + waitQueueSuffix
+	node := queue + "/" + waiterPrefix + "0000000000"
+	var never <-chan zk.Event = make(chan zk.Event)
+	factory.EXPECT().NewConnection(time.Duration(expireTime)*time.Second, comp.metadata).Return(lockConn, nil).Times(1)
+	gomock.InOrder(
+		lockConn.EXPECT().Create(queue, nil, int32(0), zk.WorldACL(zk.PermAll)).Return(queue, nil),
+		lockConn.EXPECT().Create(queue+"/"+waiterPrefix, []byte(lockOwerB), int32(zk.FlagEphemeral|zk.FlagSequence), zk.WorldACL(zk.PermAll)).Return(node, nil),
+		lockConn.EXPECT().Children(queue).Return([]string{node[len(queue)+1:]}, &zk.Stat{}, nil),
+		lockConn.EXPECT().Create(path, []byte(lockOwerB), int32(zk.FlagEphemeral), zk.WorldACL(zk.PermAll)).Return("", zk.ErrNodeExists),
+		lockConn.EXPECT().ExistsW(path).Return(true, &zk.Stat{}, never, nil),
+		lockConn.EXPECT().Delete(node, int32(-1)).Return(nil),
+		lockConn.EXPECT().Delete(queue, int32(-1)).Return(zk.ErrNotEmpty),
+		lockConn.EXPECT().Close().Return(),
+	)
+	comp.factory = factory
+
+	resp, err := comp.Lock(context.TODO(), &lock.LockRequest{
+		ResourceId:  resouseId,
+		LockOwner:   lockOwerB,
+		Expire:      expireTime,
+		WaitTimeout: 100 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
 }
@@ -257,7 +257,15 @@ func (a *AliyunOSS) ListObjects(ctx context.Context, req *l8oss.ListObjectsInput
 	if err != nil {
 		return nil, err
 	}
-	resp, err := bucket.ListObjects()
+	// aliyun oss pages with markers
+	options := []oss.Option{oss.Prefix(req.Prefix), oss.Marker(req.Marker), oss.Delimiter(req.Delimiter)}
+	if req.MaxKeys > 0 {
+		options = append(options, oss.MaxKeys(int(req.MaxKeys)))
+	}
+	if req.EncodingType != "" {
+		options = append(options, oss.EncodingType(req.EncodingType))
+	}
+	resp, err := bucket.ListObjects(options...)
 	if err != nil {
 		return nil, err
 	}
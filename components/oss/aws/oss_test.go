@@ -110,6 +110,8 @@ func TestAwsOss(t *testing.T) {
 	assert.NotNil(t, err)
 	_, err = instance.ListObjects(context.TODO(), &oss.ListObjectsInput{})
 	assert.NotNil(t, err)
+	_, err = instance.ListObjects(context.TODO(), &oss.ListObjectsInput{ContinuationToken: "token"})
+	assert.NotNil(t, err)
 	_, err = instance.ListObjectVersions(context.TODO(), &oss.ListObjectVersionsInput{})
 	assert.NotNil(t, err)
 
@@ -185,3 +187,12 @@ func TestDeepCopy(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, tovalue.Owner.DisplayName, value)
 }
+
+func TestNewListObjectsV2Input(t *testing.T) {
+	input, err := newListObjectsV2Input(&oss.ListObjectsInput{Bucket: "bucket", Marker: "a", ContinuationToken: "token"})
+	assert.Nil(t, err)
+	assert.True(t, input.FetchOwner)
+	assert.Equal(t, "bucket", *input.Bucket)
+	assert.Equal(t, "a", *input.StartAfter)
+	assert.Equal(t, "token", *input.ContinuationToken)
+}
@@ -261,6 +261,19 @@ func (c *CephOSS) ListObjects(ctx context.Context, req *oss.ListObjectsInput) (*
 		return nil, err
 	}
 
+	// v2 is only used to continue from a token, so that the providers without v2 still work
+	if req.ContinuationToken != "" {
+		input, err := newListObjectsV2Input(req)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.ListObjectsV2(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return oss.GetListObjectsV2Output(resp)
+	}
+
 	input := &s3.ListObjectsInput{}
 	err = copier.CopyWithOption(input, req, copier.Option{IgnoreEmpty: true, DeepCopy: true, Converters: []copier.TypeConverter{}})
 	if err != nil {
@@ -274,6 +287,19 @@ func (c *CephOSS) ListObjects(ctx context.Context, req *oss.ListObjectsInput) (*
 	return oss.GetListObjectsOutput(resp)
 }
 
+// newListObjectsV2Input fetches the owners as v1 does, and StartAfter works as the Marker of v1
+func newListObjectsV2Input(req *oss.ListObjectsInput) (*s3.ListObjectsV2Input, error) {
+	input := &s3.ListObjectsV2Input{FetchOwner: true}
+	err := copier.CopyWithOption(input, req, copier.Option{IgnoreEmpty: true, DeepCopy: true, Converters: []copier.TypeConverter{}})
+	if err != nil {
+		return nil, err
+	}
+	if req.Marker != "" {
+		input.StartAfter = &req.Marker
+	}
+	return input, nil
+}
+
 func (c *CephOSS) GetObjectCannedAcl(ctx context.Context, req *oss.GetObjectCannedAclInput) (*oss.GetObjectCannedAclOutput, error) {
 	client, err := c.getClient()
 	if err != nil {
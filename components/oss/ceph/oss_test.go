@@ -102,6 +102,8 @@ func TestCephOss(t *testing.T) {
 	assert.NotNil(t, err)
 	_, err = instance.ListObjects(context.TODO(), &oss.ListObjectsInput{})
 	assert.NotNil(t, err)
+	_, err = instance.ListObjects(context.TODO(), &oss.ListObjectsInput{ContinuationToken: "token"})
+	assert.NotNil(t, err)
 	_, err = instance.ListObjectVersions(context.TODO(), &oss.ListObjectVersionsInput{})
 	assert.NotNil(t, err)
 
@@ -160,3 +162,12 @@ func TestCephOss(t *testing.T) {
 	_, err = oss.GetListObjectVersionsOutput(&s3.ListObjectVersionsOutput{})
 	assert.Nil(t, err)
 }
+
+func TestNewListObjectsV2Input(t *testing.T) {
+	input, err := newListObjectsV2Input(&oss.ListObjectsInput{Bucket: "bucket", Marker: "a", ContinuationToken: "token"})
+	assert.Nil(t, err)
+	assert.True(t, input.FetchOwner)
+	assert.Equal(t, "bucket", *input.Bucket)
+	assert.Equal(t, "a", *input.StartAfter)
+	assert.Equal(t, "token", *input.ContinuationToken)
+}
@@ -0,0 +1,465 @@
+/*
+* Copyright 2021 Layotto Authors
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*     http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"mosn.io/layotto/components/oss"
+)
+
+const (
+	uploadFile = "upload.json"
+
+	minPartNumber = 1
+	maxPartNumber = 10000
+	// minPartSize is the minimum size of the parts except the last one, as S3 requires
+	minPartSize = 5 * 1024 * 1024
+)
+
+// upload is a multipart upload in progress
+type upload struct {
+	UploadId  string                `json:"uploadId"`
+	Key       string                `json:"key"`
+	Initiated time.Time             `json:"initiated"`
+	Object    *objectVersion        `json:"object"`
+	Parts     map[int32]*uploadPart `json:"parts"`
+}
+
+type uploadPart struct {
+	// DataId is the name of the part file in the upload directory
+	DataId       string    `json:"dataId"`
+	ETag         string    `json:"etag"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+func (l *LocalOss) uploadPath(bucket string, uploadId string) (string, error) {
+	path, err := l.store.bucketPath(bucket)
+	if err != nil {
+		return "", err
+	}
+	if uploadId == "" || strings.ContainsAny(uploadId, `/\.`) {
+		return "", ErrNoSuchUpload
+	}
+	return filepath.Join(path, uploadDir, uploadId), nil
+}
+
+func (l *LocalOss) loadUpload(bucket string, key string, uploadId string) (*upload, string, error) {
+	path, err := l.uploadPath(bucket, uploadId)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := ioutil.ReadFile(filepath.Join(path, uploadFile))
+	if os.IsNotExist(err) {
+		return nil, "", ErrNoSuchUpload
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	u := &upload{}
+	if err = json.Unmarshal(data, u); err != nil {
+		return nil, "", err
+	}
+	if key != "" && u.Key != key {
+		return nil, "", ErrNoSuchUpload
+	}
+	return u, path, nil
+}
+
+func (l *LocalOss) saveUpload(path string, u *upload) error {
+	data, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(path, uploadFile+"."+newId())
+	if err = ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, filepath.Join(path, uploadFile)); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+func (l *LocalOss) CreateMultipartUpload(ctx context.Context, req *oss.CreateMultipartUploadInput) (*oss.CreateMultipartUploadOutput, error) {
+	if err := checkAcl(req.ACL); err != nil {
+		return nil, err
+	}
+	if _, err := l.store.metaPath(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	uploadId := newId()
+	path, err := l.uploadPath(req.Bucket, uploadId)
+	if err != nil {
+		return nil, err
+	}
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	u := &upload{
+		UploadId:  uploadId,
+		Key:       req.Key,
+		Initiated: time.Now(),
+		Object: &objectVersion{
+			ContentType:        contentType,
+			CacheControl:       req.CacheControl,
+			ContentDisposition: req.ContentDisposition,
+			ContentEncoding:    req.ContentEncoding,
+			Expires:            req.Expires,
+			StorageClass:       req.StorageClass,
+			Meta:               copyMap(req.MetaData),
+			Tags:               copyMap(req.Tagging),
+			Acl:                req.ACL,
+		},
+		Parts: map[int32]*uploadPart{},
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if err = os.MkdirAll(path, 0755); err != nil {
+		return nil, err
+	}
+	if err = l.saveUpload(path, u); err != nil {
+		os.RemoveAll(path)
+		return nil, err
+	}
+	return &oss.CreateMultipartUploadOutput{Bucket: req.Bucket, Key: req.Key, UploadId: uploadId}, nil
+}
+
+func (l *LocalOss) UploadPart(ctx context.Context, req *oss.UploadPartInput) (*oss.UploadPartOutput, error) {
+	etag, err := l.uploadPart(req.Bucket, req.Key, req.UploadId, req.PartNumber, req.DataStream)
+	if err != nil {
+		return nil, err
+	}
+	return &oss.UploadPartOutput{ETag: quoteETag(etag)}, nil
+}
+
+func (l *LocalOss) UploadPartCopy(ctx context.Context, req *oss.UploadPartCopyInput) (*oss.UploadPartCopyOutput, error) {
+	if req.CopySource == nil {
+		return nil, oss.ErrInvalid
+	}
+	src, f, err := l.open(req.CopySource)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if req.StartPosition != 0 || req.PartSize != 0 {
+		end := src.Size
+		if req.PartSize != 0 {
+			end = req.StartPosition + req.PartSize
+		}
+		if req.StartPosition < 0 || req.StartPosition >= src.Size || end > src.Size || end <= req.StartPosition {
+			return nil, ErrInvalidRange
+		}
+		r = io.NewSectionReader(f, req.StartPosition, end-req.StartPosition)
+	}
+	etag, err := l.uploadPart(req.Bucket, req.Key, req.UploadId, req.PartNumber, r)
+	if err != nil {
+		return nil, err
+	}
+	return &oss.UploadPartCopyOutput{
+		CopyPartResult:      &oss.CopyPartResult{ETag: quoteETag(etag), LastModified: time.Now().Unix()},
+		CopySourceVersionId: l.versionId(src),
+	}, nil
+}
+
+// uploadPart writes the part into the upload directory, replacing the part with the same number if any
+func (l *LocalOss) uploadPart(bucket string, key string, uploadId string, partNumber int32, r io.Reader) (string, error) {
+	if partNumber < minPartNumber || partNumber > maxPartNumber {
+		return "", ErrInvalidPartNumber
+	}
+	l.lock.RLock()
+	_, path, err := l.loadUpload(bucket, key, uploadId)
+	l.lock.RUnlock()
+	if err != nil {
+		return "", err
+	}
+	dataId := newId()
+	etag, size, err := writePart(filepath.Join(path, dataId), r)
+	if err != nil {
+		return "", err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	// the upload may be completed or aborted when the part is being written
+	u, _, err := l.loadUpload(bucket, key, uploadId)
+	if err != nil {
+		os.Remove(filepath.Join(path, dataId))
+		return "", err
+	}
+	replaced := u.Parts[partNumber]
+	u.Parts[partNumber] = &uploadPart{DataId: dataId, ETag: etag, Size: size, LastModified: time.Now()}
+	if err = l.saveUpload(path, u); err != nil {
+		os.Remove(filepath.Join(path, dataId))
+		return "", err
+	}
+	if replaced != nil {
+		os.Remove(filepath.Join(path, replaced.DataId))
+	}
+	return etag, nil
+}
+
+func writePart(name string, r io.Reader) (string, int64, error) {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return "", 0, err
+	}
+	h := md5.New()
+	n, err := io.Copy(io.MultiWriter(f, h), r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(name)
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+// CompleteMultipartUpload concatenates the parts into the object. The ETag of the object is the md5 of
+// the md5 of the parts followed by the number of parts, as S3 does.
+func (l *LocalOss) CompleteMultipartUpload(ctx context.Context, req *oss.CompleteMultipartUploadInput) (*oss.CompleteMultipartUploadOutput, error) {
+	if req.MultipartUpload == nil || len(req.MultipartUpload.Parts) == 0 {
+		return nil, oss.ErrInvalid
+	}
+	l.lock.RLock()
+	u, path, err := l.loadUpload(req.Bucket, req.Key, req.UploadId)
+	l.lock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*os.File, 0, len(req.MultipartUpload.Parts))
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+	for i, p := range req.MultipartUpload.Parts {
+		if i > 0 && p.PartNumber <= req.MultipartUpload.Parts[i-1].PartNumber {
+			return nil, ErrInvalidPartOrder
+		}
+	}
+	readers := make([]io.Reader, 0, len(req.MultipartUpload.Parts))
+	h := md5.New()
+	for i, p := range req.MultipartUpload.Parts {
+		part, ok := u.Parts[p.PartNumber]
+		if !ok || unquoteETag(p.ETag) != part.ETag {
+			return nil, ErrInvalidPart
+		}
+		if i < len(req.MultipartUpload.Parts)-1 && part.Size < minPartSize {
+			return nil, ErrEntityTooSmall
+		}
+		sum, err := hex.DecodeString(part.ETag)
+		if err != nil {
+			return nil, err
+		}
+		h.Write(sum)
+		// the part files stay readable even if the upload is aborted concurrently
+		f, err := os.Open(filepath.Join(path, part.DataId))
+		if err != nil {
+			return nil, ErrInvalidPart
+		}
+		files = append(files, f)
+		readers = append(readers, f)
+	}
+	dataId, _, size, err := l.store.writeData(req.Bucket, io.MultiReader(readers...))
+	if err != nil {
+		return nil, err
+	}
+	v := u.Object
+	v.DataId = dataId
+	v.ETag = fmt.Sprintf("%s-%d", hex.EncodeToString(h.Sum(nil)), len(req.MultipartUpload.Parts))
+	v.Size = size
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if _, _, err = l.loadUpload(req.Bucket, req.Key, req.UploadId); err != nil {
+		l.store.removeData(req.Bucket, dataId)
+		return nil, err
+	}
+	if err = l.commitLocked(req.Bucket, req.Key, v); err != nil {
+		l.store.removeData(req.Bucket, dataId)
+		return nil, err
+	}
+	os.RemoveAll(path)
+	return &oss.CompleteMultipartUploadOutput{
+		Bucket:    req.Bucket,
+		Key:       req.Key,
+		ETag:      quoteETag(v.ETag),
+		VersionId: l.versionId(v),
+	}, nil
+}
+
+func (l *LocalOss) AbortMultipartUpload(ctx context.Context, req *oss.AbortMultipartUploadInput) (*oss.AbortMultipartUploadOutput, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	_, path, err := l.loadUpload(req.Bucket, req.Key, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.RemoveAll(path); err != nil {
+		return nil, err
+	}
+	return &oss.AbortMultipartUploadOutput{}, nil
+}
+
+func (l *LocalOss) ListMultipartUploads(ctx context.Context, req *oss.ListMultipartUploadsInput) (*oss.ListMultipartUploadsOutput, error) {
+	uploads, err := l.listUploads(req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	maxUploads := int32(req.MaxUploads)
+	if maxUploads <= 0 {
+		maxUploads = defaultMaxKeys
+	}
+	out := &oss.ListMultipartUploadsOutput{
+		Bucket:         req.Bucket,
+		Delimiter:      req.Delimiter,
+		EncodingType:   req.EncodingType,
+		KeyMarker:      req.KeyMarker,
+		MaxUploads:     maxUploads,
+		Prefix:         req.Prefix,
+		UploadIDMarker: req.UploadIdMarker,
+	}
+	var count int32
+	var lastKey, lastUploadId, lastPrefix string
+	for _, u := range uploads {
+		if !strings.HasPrefix(u.Key, req.Prefix) || u.Key < req.KeyMarker {
+			continue
+		}
+		// the upload id marker is ignored if there is no key marker, as S3 does
+		if u.Key == req.KeyMarker && (req.UploadIdMarker == "" || u.UploadId <= req.UploadIdMarker) {
+			continue
+		}
+		cp, ok := commonPrefix(u.Key, req.Prefix, req.Delimiter)
+		if ok && (cp == lastPrefix || cp <= req.KeyMarker) {
+			continue
+		}
+		if count == maxUploads {
+			out.IsTruncated = true
+			out.NextKeyMarker = lastKey
+			out.NextUploadIDMarker = lastUploadId
+			break
+		}
+		count++
+		if ok {
+			out.CommonPrefixes = append(out.CommonPrefixes, cp)
+			lastKey, lastUploadId, lastPrefix = cp, "", cp
+			continue
+		}
+		out.Uploads = append(out.Uploads, &oss.MultipartUpload{
+			Initiated:    u.Initiated.Unix(),
+			Key:          u.Key,
+			StorageClass: u.Object.StorageClass,
+			UploadId:     u.UploadId,
+		})
+		lastKey, lastUploadId = u.Key, u.UploadId
+	}
+	return out, nil
+}
+
+// listUploads returns the uploads in progress in the order of key and upload id
+func (l *LocalOss) listUploads(bucket string) ([]*upload, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	path, err := l.store.bucketPath(bucket)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := ioutil.ReadDir(filepath.Join(path, uploadDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	uploads := make([]*upload, 0, len(entries))
+	for _, entry := range entries {
+		u, _, err := l.loadUpload(bucket, "", entry.Name())
+		if err == ErrNoSuchUpload {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, u)
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		if uploads[i].Key != uploads[j].Key {
+			return uploads[i].Key < uploads[j].Key
+		}
+		return uploads[i].UploadId < uploads[j].UploadId
+	})
+	return uploads, nil
+}
+
+func (l *LocalOss) ListParts(ctx context.Context, req *oss.ListPartsInput) (*oss.ListPartsOutput, error) {
+	l.lock.RLock()
+	u, _, err := l.loadUpload(req.Bucket, req.Key, req.UploadId)
+	l.lock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	maxParts := req.MaxParts
+	if maxParts <= 0 {
+		maxParts = defaultMaxKeys
+	}
+	numbers := make([]int, 0, len(u.Parts))
+	for n := range u.Parts {
+		if int64(n) > req.PartNumberMarker {
+			numbers = append(numbers, int(n))
+		}
+	}
+	sort.Ints(numbers)
+	out := &oss.ListPartsOutput{
+		Bucket:   req.Bucket,
+		Key:      req.Key,
+		UploadId: req.UploadId,
+		MaxParts: maxParts,
+	}
+	for _, n := range numbers {
+		if int64(len(out.Parts)) == maxParts {
+			out.IsTruncated = true
+			break
+		}
+		part := u.Parts[int32(n)]
+		out.Parts = append(out.Parts, &oss.Part{
+			Etag:         quoteETag(part.ETag),
+			LastModified: part.LastModified.Unix(),
+			PartNumber:   int64(n),
+			Size:         part.Size,
+		})
+		out.NextPartNumberMarker = strconv.Itoa(n)
+	}
+	return out, nil
+}
@@ -0,0 +1,135 @@
+/*
+* Copyright 2021 Layotto Authors
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*     http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/oss"
+)
+
+func TestMultipartUpload(t *testing.T) {
+	l := newLocalOss(t, false)
+	create, err := l.CreateMultipartUpload(context.TODO(), &oss.CreateMultipartUploadInput{
+		Bucket: bucket, Key: "big", ContentType: "text/plain", MetaData: map[string]string{"k": "v"},
+	})
+	assert.Nil(t, err)
+	uploadId := create.UploadId
+
+	part1 := bytes.Repeat([]byte("a"), minPartSize)
+	up1, err := l.UploadPart(context.TODO(), &oss.UploadPartInput{
+		Bucket: bucket, Key: "big", UploadId: uploadId, PartNumber: 1, DataStream: bytes.NewReader(part1),
+	})
+	assert.Nil(t, err)
+	put(t, l, "src", "0123456789")
+	up2, err := l.UploadPartCopy(context.TODO(), &oss.UploadPartCopyInput{
+		Bucket: bucket, Key: "big", UploadId: uploadId, PartNumber: 2,
+		CopySource: &oss.CopySource{CopySourceBucket: bucket, CopySourceKey: "src"}, StartPosition: 2, PartSize: 3,
+	})
+	assert.Nil(t, err)
+
+	_, err = l.UploadPart(context.TODO(), &oss.UploadPartInput{
+		Bucket: bucket, Key: "big", UploadId: uploadId, PartNumber: 0, DataStream: strings.NewReader(""),
+	})
+	assert.Equal(t, ErrInvalidPartNumber, err)
+	_, err = l.UploadPart(context.TODO(), &oss.UploadPartInput{
+		Bucket: bucket, Key: "other", UploadId: uploadId, PartNumber: 1, DataStream: strings.NewReader(""),
+	})
+	assert.Equal(t, ErrNoSuchUpload, err)
+
+	uploads, err := l.ListMultipartUploads(context.TODO(), &oss.ListMultipartUploadsInput{Bucket: bucket})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(uploads.Uploads))
+	assert.Equal(t, uploadId, uploads.Uploads[0].UploadId)
+
+	parts, err := l.ListParts(context.TODO(), &oss.ListPartsInput{Bucket: bucket, Key: "big", UploadId: uploadId, MaxParts: 1})
+	assert.Nil(t, err)
+	assert.True(t, parts.IsTruncated)
+	assert.Equal(t, "1", parts.NextPartNumberMarker)
+	assert.Equal(t, int64(minPartSize), parts.Parts[0].Size)
+	parts, err = l.ListParts(context.TODO(), &oss.ListPartsInput{Bucket: bucket, Key: "big", UploadId: uploadId, PartNumberMarker: 1})
+	assert.Nil(t, err)
+	assert.False(t, parts.IsTruncated)
+	assert.Equal(t, int64(3), parts.Parts[0].Size)
+
+	completed := []*oss.CompletedPart{{PartNumber: 2, ETag: up2.CopyPartResult.ETag}, {PartNumber: 1, ETag: up1.ETag}}
+	_, err = l.CompleteMultipartUpload(context.TODO(), &oss.CompleteMultipartUploadInput{
+		Bucket: bucket, Key: "big", UploadId: uploadId, MultipartUpload: &oss.CompletedMultipartUpload{Parts: completed},
+	})
+	assert.Equal(t, ErrInvalidPartOrder, err)
+	completed = []*oss.CompletedPart{{PartNumber: 1, ETag: up1.ETag}, {PartNumber: 2, ETag: `"wrong"`}}
+	_, err = l.CompleteMultipartUpload(context.TODO(), &oss.CompleteMultipartUploadInput{
+		Bucket: bucket, Key: "big", UploadId: uploadId, MultipartUpload: &oss.CompletedMultipartUpload{Parts: completed},
+	})
+	assert.Equal(t, ErrInvalidPart, err)
+
+	completed = []*oss.CompletedPart{{PartNumber: 1, ETag: up1.ETag}, {PartNumber: 2, ETag: up2.CopyPartResult.ETag}}
+	out, err := l.CompleteMultipartUpload(context.TODO(), &oss.CompleteMultipartUploadInput{
+		Bucket: bucket, Key: "big", UploadId: uploadId, MultipartUpload: &oss.CompletedMultipartUpload{Parts: completed},
+	})
+	assert.Nil(t, err)
+	sum1, sum2 := md5.Sum(part1), md5.Sum([]byte("234"))
+	sum := md5.Sum(append(sum1[:], sum2[:]...))
+	assert.Equal(t, fmt.Sprintf(`"%s-2"`, hex.EncodeToString(sum[:])), out.ETag)
+
+	get, err := l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "big"})
+	assert.Nil(t, err)
+	get.DataStream.Close()
+	assert.Equal(t, int64(minPartSize+3), get.ContentLength)
+	assert.Equal(t, "text/plain", get.ContentType)
+	assert.Equal(t, map[string]string{"k": "v"}, get.Metadata)
+	assert.Equal(t, "a234", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "big", Start: minPartSize - 1}))
+
+	_, err = l.ListParts(context.TODO(), &oss.ListPartsInput{Bucket: bucket, Key: "big", UploadId: uploadId})
+	assert.Equal(t, ErrNoSuchUpload, err)
+}
+
+func TestAbortMultipartUpload(t *testing.T) {
+	l := newLocalOss(t, false)
+	create, err := l.CreateMultipartUpload(context.TODO(), &oss.CreateMultipartUploadInput{Bucket: bucket, Key: "key"})
+	assert.Nil(t, err)
+	up1, err := l.UploadPart(context.TODO(), &oss.UploadPartInput{
+		Bucket: bucket, Key: "key", UploadId: create.UploadId, PartNumber: 1, DataStream: strings.NewReader("small"),
+	})
+	assert.Nil(t, err)
+	up2, err := l.UploadPart(context.TODO(), &oss.UploadPartInput{
+		Bucket: bucket, Key: "key", UploadId: create.UploadId, PartNumber: 2, DataStream: strings.NewReader("last"),
+	})
+	assert.Nil(t, err)
+	_, err = l.CompleteMultipartUpload(context.TODO(), &oss.CompleteMultipartUploadInput{
+		Bucket: bucket, Key: "key", UploadId: create.UploadId, MultipartUpload: &oss.CompletedMultipartUpload{
+			Parts: []*oss.CompletedPart{{PartNumber: 1, ETag: up1.ETag}, {PartNumber: 2, ETag: up2.ETag}},
+		},
+	})
+	assert.Equal(t, ErrEntityTooSmall, err)
+
+	_, err = l.AbortMultipartUpload(context.TODO(), &oss.AbortMultipartUploadInput{Bucket: bucket, Key: "key", UploadId: create.UploadId})
+	assert.Nil(t, err)
+	uploads, err := l.ListMultipartUploads(context.TODO(), &oss.ListMultipartUploadsInput{Bucket: bucket})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(uploads.Uploads))
+	_, err = l.AbortMultipartUpload(context.TODO(), &oss.AbortMultipartUploadInput{Bucket: bucket, Key: "key", UploadId: create.UploadId})
+	assert.Equal(t, ErrNoSuchUpload, err)
+}
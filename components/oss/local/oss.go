@@ -0,0 +1,814 @@
+/*
+* Copyright 2021 Layotto Authors
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*     http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mosn.io/layotto/components/oss"
+)
+
+const (
+	defaultMaxKeys     = 1000
+	defaultContentType = "application/octet-stream"
+	replaceDirective   = "REPLACE"
+)
+
+var cannedAcls = map[string]bool{
+	"private":                   true,
+	"public-read":               true,
+	"public-read-write":         true,
+	"authenticated-read":        true,
+	"aws-exec-read":             true,
+	"bucket-owner-read":         true,
+	"bucket-owner-full-control": true,
+}
+
+// LocalOssMetadata is the basic configuration of the local oss
+type LocalOssMetadata struct {
+	// RootPath is the directory of the buckets, every sub directory of it is a bucket
+	RootPath string `json:"rootPath"`
+	// Buckets are created when the component is initialized
+	Buckets []string `json:"buckets"`
+	// Versioning keeps every version of the objects, as a versioning enabled S3 bucket does
+	Versioning bool `json:"versioning"`
+}
+
+// LocalOss implements oss.Oss with a local directory, which is useful to test applications
+// written against the s3 api without any cloud endpoint.
+type LocalOss struct {
+	lock     sync.RWMutex
+	store    *store
+	metadata *LocalOssMetadata
+	// presign is injected by the runtime to sign urls
+	presign oss.PresignFunc
+}
+
+func NewLocalOss() oss.Oss {
+	return &LocalOss{}
+}
+
+func (l *LocalOss) Init(ctx context.Context, config *oss.Config) error {
+	m := &LocalOssMetadata{}
+	if err := json.Unmarshal(config.Metadata[oss.BasicConfiguration], m); err != nil {
+		return oss.ErrInvalid
+	}
+	if m.RootPath == "" {
+		return oss.ErrInvalid
+	}
+	if err := os.MkdirAll(m.RootPath, 0755); err != nil {
+		return err
+	}
+	l.store = &store{root: m.RootPath}
+	for _, bucket := range m.Buckets {
+		if err := l.store.createBucket(bucket); err != nil {
+			return err
+		}
+	}
+	l.metadata = m
+	return nil
+}
+
+func (l *LocalOss) GetObject(ctx context.Context, req *oss.GetObjectInput) (*oss.GetObjectOutput, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	meta, err := l.store.loadMeta(req.Bucket, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	v, err := lookup(meta, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkConditions(v, req.IfMatch, req.IfNoneMatch, req.IfModifiedSince, req.IfUnmodifiedSince); err != nil {
+		return nil, err
+	}
+	start, end, err := parseRange(req.Start, req.End, v.Size)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(l.store.dataPath(req.Bucket, v.DataId))
+	if err != nil {
+		return nil, err
+	}
+	out := &oss.GetObjectOutput{
+		DataStream:         &sectionReadCloser{SectionReader: io.NewSectionReader(f, start, end-start), f: f},
+		CacheControl:       v.CacheControl,
+		ContentDisposition: v.ContentDisposition,
+		ContentEncoding:    v.ContentEncoding,
+		ContentLength:      end - start,
+		ContentType:        v.ContentType,
+		ETag:               quoteETag(v.ETag),
+		LastModified:       v.LastModified.Unix(),
+		VersionId:          l.versionId(v),
+		TagCount:           int64(len(v.Tags)),
+		StorageClass:       v.StorageClass,
+		Metadata:           copyMap(v.Meta),
+	}
+	if v.Expires != 0 {
+		out.Expires = time.Unix(v.Expires, 0).UTC().Format(http.TimeFormat)
+	}
+	if req.Start != 0 || req.End != 0 {
+		out.ContentRange = fmt.Sprintf("bytes %d-%d/%d", start, end-1, v.Size)
+	}
+	overrideString(&out.CacheControl, req.ResponseCacheControl)
+	overrideString(&out.ContentDisposition, req.ResponseContentDisposition)
+	overrideString(&out.ContentEncoding, req.ResponseContentEncoding)
+	overrideString(&out.ContentLanguage, req.ResponseContentLanguage)
+	overrideString(&out.ContentType, req.ResponseContentType)
+	overrideString(&out.Expires, req.ResponseExpires)
+	return out, nil
+}
+
+func (l *LocalOss) PutObject(ctx context.Context, req *oss.PutObjectInput) (*oss.PutObjectOutput, error) {
+	if err := checkAcl(req.ACL); err != nil {
+		return nil, err
+	}
+	if _, err := l.store.metaPath(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	dataId, etag, size, err := l.store.writeData(req.Bucket, req.DataStream)
+	if err != nil {
+		return nil, err
+	}
+	v := &objectVersion{
+		DataId:             dataId,
+		ETag:               etag,
+		Size:               size,
+		ContentType:        defaultContentType,
+		CacheControl:       req.CacheControl,
+		ContentDisposition: req.ContentDisposition,
+		ContentEncoding:    req.ContentEncoding,
+		Expires:            req.Expires,
+		StorageClass:       req.StorageClass,
+		Meta:               copyMap(req.Meta),
+		Tags:               copyMap(req.Tagging),
+		Acl:                req.ACL,
+	}
+	if err = l.commit(req.Bucket, req.Key, v); err != nil {
+		l.store.removeData(req.Bucket, dataId)
+		return nil, err
+	}
+	return &oss.PutObjectOutput{ETag: quoteETag(etag), Metadata: map[string]string{}}, nil
+}
+
+func (l *LocalOss) DeleteObject(ctx context.Context, req *oss.DeleteObjectInput) (*oss.DeleteObjectOutput, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	deleted, err := l.deleteObject(req.Bucket, req.Key, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	return &oss.DeleteObjectOutput{DeleteMarker: deleted.DeleteMarker, VersionId: deleted.VersionId}, nil
+}
+
+func (l *LocalOss) PutObjectTagging(ctx context.Context, req *oss.PutObjectTaggingInput) (*oss.PutObjectTaggingOutput, error) {
+	err := l.update(req.Bucket, req.Key, req.VersionId, func(v *objectVersion) error {
+		v.Tags = copyMap(req.Tags)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &oss.PutObjectTaggingOutput{}, nil
+}
+
+func (l *LocalOss) DeleteObjectTagging(ctx context.Context, req *oss.DeleteObjectTaggingInput) (*oss.DeleteObjectTaggingOutput, error) {
+	var versionId string
+	err := l.update(req.Bucket, req.Key, req.VersionId, func(v *objectVersion) error {
+		v.Tags = nil
+		versionId = l.versionId(v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &oss.DeleteObjectTaggingOutput{VersionId: versionId}, nil
+}
+
+func (l *LocalOss) GetObjectTagging(ctx context.Context, req *oss.GetObjectTaggingInput) (*oss.GetObjectTaggingOutput, error) {
+	v, err := l.get(req.Bucket, req.Key, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	tags := copyMap(v.Tags)
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	return &oss.GetObjectTaggingOutput{Tags: tags, VersionId: l.versionId(v)}, nil
+}
+
+func (l *LocalOss) CopyObject(ctx context.Context, req *oss.CopyObjectInput) (*oss.CopyObjectOutput, error) {
+	if req.CopySource == nil {
+		return nil, oss.ErrInvalid
+	}
+	if _, err := l.store.metaPath(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	src, f, err := l.open(req.CopySource)
+	if err != nil {
+		return nil, err
+	}
+	dataId, etag, size, err := l.store.writeData(req.Bucket, f)
+	f.Close()
+	if err != nil {
+		return nil, err
+	}
+	v := &objectVersion{
+		DataId:             dataId,
+		ETag:               etag,
+		Size:               size,
+		ContentType:        src.ContentType,
+		CacheControl:       src.CacheControl,
+		ContentDisposition: src.ContentDisposition,
+		ContentEncoding:    src.ContentEncoding,
+		Expires:            src.Expires,
+		StorageClass:       src.StorageClass,
+		Meta:               copyMap(src.Meta),
+		Tags:               copyMap(src.Tags),
+	}
+	if strings.EqualFold(req.MetadataDirective, replaceDirective) {
+		v.Meta = copyMap(req.Metadata)
+		v.Expires = req.Expires
+	}
+	if req.Tagging != nil {
+		v.Tags = copyMap(req.Tagging)
+	}
+	if err = l.commit(req.Bucket, req.Key, v); err != nil {
+		l.store.removeData(req.Bucket, dataId)
+		return nil, err
+	}
+	return &oss.CopyObjectOutput{
+		CopyObjectResult: &oss.CopyObjectResult{ETag: quoteETag(etag), LastModified: v.LastModified.Unix()},
+	}, nil
+}
+
+func (l *LocalOss) DeleteObjects(ctx context.Context, req *oss.DeleteObjectsInput) (*oss.DeleteObjectsOutput, error) {
+	if req.Delete == nil {
+		return nil, oss.ErrInvalid
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	out := &oss.DeleteObjectsOutput{}
+	for _, object := range req.Delete.Objects {
+		deleted, err := l.deleteObject(req.Bucket, object.Key, object.VersionId)
+		if err != nil {
+			return nil, err
+		}
+		if req.Delete.Quiet {
+			continue
+		}
+		d := &oss.DeletedObject{Key: object.Key, VersionId: object.VersionId, DeleteMarker: deleted.DeleteMarker}
+		if deleted.DeleteMarker {
+			d.DeleteMarkerVersionId = deleted.VersionId
+		}
+		out.Deleted = append(out.Deleted, d)
+	}
+	return out, nil
+}
+
+func (l *LocalOss) ListObjects(ctx context.Context, req *oss.ListObjectsInput) (*oss.ListObjectsOutput, error) {
+	l.lock.RLock()
+	metas, err := l.store.listMeta(req.Bucket)
+	l.lock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	maxKeys := req.MaxKeys
+	if maxKeys <= 0 {
+		maxKeys = defaultMaxKeys
+	}
+	out := &oss.ListObjectsOutput{
+		Delimiter:    req.Delimiter,
+		EncodingType: req.EncodingType,
+		Marker:       req.Marker,
+		MaxKeys:      maxKeys,
+		Name:         req.Bucket,
+		Prefix:       req.Prefix,
+	}
+	var count int32
+	var last string
+	for _, meta := range metas {
+		v := meta.latest()
+		if v == nil || v.DeleteMarker || !strings.HasPrefix(meta.Key, req.Prefix) || meta.Key <= req.Marker {
+			continue
+		}
+		cp, ok := commonPrefix(meta.Key, req.Prefix, req.Delimiter)
+		if ok && (cp == last || cp <= req.Marker) {
+			continue
+		}
+		if count == maxKeys {
+			out.IsTruncated = true
+			out.NextMarker = last
+			break
+		}
+		count++
+		if ok {
+			out.CommonPrefixes = append(out.CommonPrefixes, cp)
+			last = cp
+			continue
+		}
+		out.Contents = append(out.Contents, &oss.Object{
+			ETag:         quoteETag(v.ETag),
+			Key:          meta.Key,
+			LastModified: v.LastModified.Unix(),
+			Size:         v.Size,
+			StorageClass: v.StorageClass,
+		})
+		last = meta.Key
+	}
+	return out, nil
+}
+
+func (l *LocalOss) GetObjectCannedAcl(ctx context.Context, req *oss.GetObjectCannedAclInput) (*oss.GetObjectCannedAclOutput, error) {
+	v, err := l.get(req.Bucket, req.Key, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	acl := v.Acl
+	if acl == "" {
+		acl = defaultAcl
+	}
+	return &oss.GetObjectCannedAclOutput{CannedAcl: acl}, nil
+}
+
+func (l *LocalOss) PutObjectCannedAcl(ctx context.Context, req *oss.PutObjectCannedAclInput) (*oss.PutObjectCannedAclOutput, error) {
+	if req.Acl == "" {
+		return nil, ErrInvalidAcl
+	}
+	if err := checkAcl(req.Acl); err != nil {
+		return nil, err
+	}
+	err := l.update(req.Bucket, req.Key, req.VersionId, func(v *objectVersion) error {
+		v.Acl = req.Acl
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &oss.PutObjectCannedAclOutput{}, nil
+}
+
+func (l *LocalOss) RestoreObject(ctx context.Context, req *oss.RestoreObjectInput) (*oss.RestoreObjectOutput, error) {
+	return nil, ErrNotSupportRestore
+}
+
+func (l *LocalOss) ListObjectVersions(ctx context.Context, req *oss.ListObjectVersionsInput) (*oss.ListObjectVersionsOutput, error) {
+	l.lock.RLock()
+	metas, err := l.store.listMeta(req.Bucket)
+	l.lock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	maxKeys := req.MaxKeys
+	if maxKeys <= 0 {
+		maxKeys = defaultMaxKeys
+	}
+	out := &oss.ListObjectVersionsOutput{
+		Delimiter:       req.Delimiter,
+		EncodingType:    req.EncodingType,
+		KeyMarker:       req.KeyMarker,
+		MaxKeys:         maxKeys,
+		Name:            req.Bucket,
+		Prefix:          req.Prefix,
+		VersionIdMarker: req.VersionIdMarker,
+	}
+	var count int32
+	var lastKey, lastVersion, lastPrefix string
+	for _, meta := range metas {
+		if !strings.HasPrefix(meta.Key, req.Prefix) || meta.Key < req.KeyMarker {
+			continue
+		}
+		if meta.Key == req.KeyMarker && req.VersionIdMarker == "" {
+			continue
+		}
+		cp, ok := commonPrefix(meta.Key, req.Prefix, req.Delimiter)
+		if ok && (cp == lastPrefix || cp <= req.KeyMarker) {
+			continue
+		}
+		if ok {
+			if count == maxKeys {
+				out.IsTruncated = true
+				out.NextKeyMarker = lastKey
+				out.NextVersionIdMarker = lastVersion
+				return out, nil
+			}
+			count++
+			out.CommonPrefixes = append(out.CommonPrefixes, cp)
+			lastKey, lastVersion, lastPrefix = cp, "", cp
+			continue
+		}
+		versions := meta.Versions
+		if meta.Key == req.KeyMarker {
+			versions = versionsAfter(versions, req.VersionIdMarker)
+		}
+		for i, v := range versions {
+			if count == maxKeys {
+				out.IsTruncated = true
+				out.NextKeyMarker = lastKey
+				out.NextVersionIdMarker = lastVersion
+				return out, nil
+			}
+			count++
+			isLatest := i == 0 && len(versions) == len(meta.Versions)
+			if v.DeleteMarker {
+				out.DeleteMarkers = append(out.DeleteMarkers, &oss.DeleteMarkerEntry{
+					IsLatest:     isLatest,
+					Key:          meta.Key,
+					LastModified: v.LastModified.Unix(),
+					VersionId:    v.VersionId,
+				})
+			} else {
+				out.Versions = append(out.Versions, &oss.ObjectVersion{
+					ETag:         quoteETag(v.ETag),
+					IsLatest:     isLatest,
+					Key:          meta.Key,
+					LastModified: v.LastModified.Unix(),
+					Size:         v.Size,
+					StorageClass: v.StorageClass,
+					VersionId:    v.VersionId,
+				})
+			}
+			lastKey, lastVersion = meta.Key, v.VersionId
+		}
+	}
+	return out, nil
+}
+
+func (l *LocalOss) HeadObject(ctx context.Context, req *oss.HeadObjectInput) (*oss.HeadObjectOutput, error) {
+	v, err := l.get(req.Bucket, req.Key, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkConditions(v, req.IfMatch, req.IfNoneMatch, req.IfModifiedSince, req.IfUnmodifiedSince); err != nil {
+		return nil, err
+	}
+	out := &oss.HeadObjectOutput{ResultMetadata: copyMap(v.Meta)}
+	if out.ResultMetadata == nil {
+		out.ResultMetadata = map[string]string{}
+	}
+	if req.WithDetails {
+		out.ResultMetadata["Content-Length"] = strconv.FormatInt(v.Size, 10)
+		out.ResultMetadata["Content-Type"] = v.ContentType
+		out.ResultMetadata["Etag"] = quoteETag(v.ETag)
+		out.ResultMetadata["Last-Modified"] = v.LastModified.UTC().Format(http.TimeFormat)
+		if l.metadata.Versioning {
+			out.ResultMetadata["X-Amz-Version-Id"] = v.VersionId
+		}
+	}
+	return out, nil
+}
+
+func (l *LocalOss) IsObjectExist(ctx context.Context, req *oss.IsObjectExistInput) (*oss.IsObjectExistOutput, error) {
+	_, err := l.get(req.Bucket, req.Key, "")
+	if err == ErrNoSuchKey {
+		return &oss.IsObjectExistOutput{FileExist: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &oss.IsObjectExistOutput{FileExist: true}, nil
+}
+
+func (l *LocalOss) UpdateDownloadBandwidthRateLimit(ctx context.Context, req *oss.UpdateBandwidthRateLimitInput) error {
+	return ErrNotSupportBandwidth
+}
+
+func (l *LocalOss) UpdateUploadBandwidthRateLimit(ctx context.Context, req *oss.UpdateBandwidthRateLimitInput) error {
+	return ErrNotSupportBandwidth
+}
+
+// AppendObject appends the data to an appendable object, or creates one if the object doesn't exist.
+// The ETag of an appendable object is chained from the ETag before appending and the md5 of the data appended.
+func (l *LocalOss) AppendObject(ctx context.Context, req *oss.AppendObjectInput) (*oss.AppendObjectOutput, error) {
+	if err := checkAcl(req.ACL); err != nil {
+		return nil, err
+	}
+	if _, err := l.store.metaPath(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	// receive the data first, so that a slow client doesn't hold the lock
+	tmpId, tmpETag, size, err := l.store.writeData(req.Bucket, req.DataStream)
+	if err != nil {
+		return nil, err
+	}
+	defer l.store.removeData(req.Bucket, tmpId)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	meta, err := l.store.loadMeta(req.Bucket, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	v := meta.latest()
+	if v == nil || v.DeleteMarker {
+		if req.Position != 0 {
+			return nil, ErrPositionNotEqualToSize
+		}
+		v = &objectVersion{
+			VersionId:          l.newVersionId(),
+			DataId:             newId(),
+			ContentType:        defaultContentType,
+			CacheControl:       req.CacheControl,
+			ContentDisposition: req.ContentDisposition,
+			ContentEncoding:    req.ContentEncoding,
+			Expires:            req.Expires,
+			StorageClass:       req.StorageClass,
+			Tags:               copyMap(req.Tags),
+			Acl:                req.ACL,
+			Appendable:         true,
+		}
+		meta.Versions = append([]*objectVersion{v}, meta.Versions...)
+	} else if !v.Appendable {
+		return nil, ErrNotAppendable
+	} else if v.Size != req.Position {
+		return nil, ErrPositionNotEqualToSize
+	}
+	if err = l.appendData(req.Bucket, v.DataId, tmpId); err != nil {
+		return nil, err
+	}
+	sum := md5.Sum([]byte(v.ETag + tmpETag))
+	v.ETag = hex.EncodeToString(sum[:])
+	v.Size += size
+	v.LastModified = time.Now()
+	if err = l.store.saveMeta(req.Bucket, meta); err != nil {
+		return nil, err
+	}
+	return &oss.AppendObjectOutput{AppendPosition: v.Size}, nil
+}
+
+func (l *LocalOss) appendData(bucket string, dataId string, tmpId string) error {
+	src, err := os.Open(l.store.dataPath(bucket, tmpId))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(l.store.dataPath(bucket, dataId), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// commit makes v the latest version of the object
+func (l *LocalOss) commit(bucket string, key string, v *objectVersion) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.commitLocked(bucket, key, v)
+}
+
+// commitLocked is the same as commit, but must be called with the write lock held
+func (l *LocalOss) commitLocked(bucket string, key string, v *objectVersion) error {
+	meta, err := l.store.loadMeta(bucket, key)
+	if err != nil {
+		return err
+	}
+	v.VersionId = l.newVersionId()
+	v.LastModified = time.Now()
+	var replaced *objectVersion
+	if !l.metadata.Versioning {
+		replaced = meta.removeVersion(nullVersionId)
+	}
+	meta.Versions = append([]*objectVersion{v}, meta.Versions...)
+	if err = l.store.saveMeta(bucket, meta); err != nil {
+		return err
+	}
+	if replaced != nil {
+		l.store.removeData(bucket, replaced.DataId)
+	}
+	return nil
+}
+
+// deleteObject removes the version specified, or makes a delete marker the latest version if
+// versioning is enabled. It must be called with the write lock held.
+func (l *LocalOss) deleteObject(bucket string, key string, versionId string) (*objectVersion, error) {
+	meta, err := l.store.loadMeta(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	if versionId == "" && l.metadata.Versioning {
+		marker := &objectVersion{VersionId: l.newVersionId(), DeleteMarker: true, LastModified: time.Now()}
+		meta.Versions = append([]*objectVersion{marker}, meta.Versions...)
+		return marker, l.store.saveMeta(bucket, meta)
+	}
+	if versionId == "" {
+		versionId = nullVersionId
+	}
+	removed := meta.removeVersion(versionId)
+	if removed == nil {
+		// deleting an object which doesn't exist succeeds, as S3 does
+		return &objectVersion{}, nil
+	}
+	if err = l.store.saveMeta(bucket, meta); err != nil {
+		return nil, err
+	}
+	l.store.removeData(bucket, removed.DataId)
+	if !l.metadata.Versioning {
+		removed = &objectVersion{DeleteMarker: removed.DeleteMarker}
+	}
+	return removed, nil
+}
+
+func (l *LocalOss) get(bucket string, key string, versionId string) (*objectVersion, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	meta, err := l.store.loadMeta(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	return lookup(meta, versionId)
+}
+
+func (l *LocalOss) update(bucket string, key string, versionId string, f func(v *objectVersion) error) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	meta, err := l.store.loadMeta(bucket, key)
+	if err != nil {
+		return err
+	}
+	v, err := lookup(meta, versionId)
+	if err != nil {
+		return err
+	}
+	if err = f(v); err != nil {
+		return err
+	}
+	return l.store.saveMeta(bucket, meta)
+}
+
+// open opens the data of the copy source. The data file stays readable after the lock is
+// released, because the data of a version is never rewritten but only appended or removed.
+func (l *LocalOss) open(src *oss.CopySource) (*objectVersion, *os.File, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	meta, err := l.store.loadMeta(src.CopySourceBucket, src.CopySourceKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	v, err := lookup(meta, src.CopySourceVersionId)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(l.store.dataPath(src.CopySourceBucket, v.DataId))
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, f, nil
+}
+
+func (l *LocalOss) newVersionId() string {
+	if !l.metadata.Versioning {
+		return nullVersionId
+	}
+	return newId()
+}
+
+// versionId returns the version id shown to users, which is empty if versioning is disabled
+func (l *LocalOss) versionId(v *objectVersion) string {
+	if !l.metadata.Versioning {
+		return ""
+	}
+	return v.VersionId
+}
+
+// lookup returns the version specified, which must not be a delete marker
+func lookup(meta *objectMeta, versionId string) (*objectVersion, error) {
+	v := meta.version(versionId)
+	if v == nil {
+		if versionId != "" && len(meta.Versions) != 0 {
+			return nil, ErrNoSuchVersion
+		}
+		return nil, ErrNoSuchKey
+	}
+	if v.DeleteMarker {
+		if versionId != "" {
+			return nil, ErrMethodNotAllowed
+		}
+		return nil, ErrNoSuchKey
+	}
+	return v, nil
+}
+
+// checkConditions evaluates the conditional headers in the order of RFC 7232
+func checkConditions(v *objectVersion, ifMatch string, ifNoneMatch string, ifModifiedSince int64, ifUnmodifiedSince int64) error {
+	if ifMatch != "" && !matchETag(ifMatch, v.ETag) {
+		return ErrPreconditionFailed
+	}
+	if ifMatch == "" && ifUnmodifiedSince != 0 && v.LastModified.Unix() > ifUnmodifiedSince {
+		return ErrPreconditionFailed
+	}
+	if ifNoneMatch != "" && matchETag(ifNoneMatch, v.ETag) {
+		return ErrNotModified
+	}
+	if ifNoneMatch == "" && ifModifiedSince != 0 && v.LastModified.Unix() <= ifModifiedSince {
+		return ErrNotModified
+	}
+	return nil
+}
+
+func matchETag(condition string, etag string) bool {
+	for _, c := range strings.Split(condition, ",") {
+		c = strings.TrimSpace(c)
+		if c == "*" || unquoteETag(c) == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRange returns the range [start, end) of the object, end is inclusive in the request and 0 means the end of the object
+func parseRange(start int64, end int64, size int64) (int64, int64, error) {
+	if start == 0 && end == 0 {
+		return 0, size, nil
+	}
+	if end == 0 || end >= size {
+		end = size - 1
+	}
+	if start < 0 || start >= size || end < start {
+		return 0, 0, ErrInvalidRange
+	}
+	return start, end + 1, nil
+}
+
+func checkAcl(acl string) error {
+	if acl != "" && !cannedAcls[acl] {
+		return ErrInvalidAcl
+	}
+	return nil
+}
+
+// commonPrefix returns the common prefix the key rolls up into, if there is a delimiter after the prefix
+func commonPrefix(key string, prefix string, delimiter string) (string, bool) {
+	if delimiter == "" {
+		return "", false
+	}
+	i := strings.Index(key[len(prefix):], delimiter)
+	if i < 0 {
+		return "", false
+	}
+	return key[:len(prefix)+i+len(delimiter)], true
+}
+
+func versionsAfter(versions []*objectVersion, versionIdMarker string) []*objectVersion {
+	for i, v := range versions {
+		if v.VersionId == versionIdMarker {
+			return versions[i+1:]
+		}
+	}
+	return nil
+}
+
+func overrideString(field *string, value string) {
+	if value != "" {
+		*field = value
+	}
+}
+
+func copyMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	res := make(map[string]string, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
+
+type sectionReadCloser struct {
+	*io.SectionReader
+	f *os.File
+}
+
+func (s *sectionReadCloser) Close() error {
+	return s.f.Close()
+}
@@ -0,0 +1,314 @@
+/*
+* Copyright 2021 Layotto Authors
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*     http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/oss"
+)
+
+const bucket = "bucket"
+
+func newLocalOss(t *testing.T, versioning bool) *LocalOss {
+	conf := fmt.Sprintf(`{"rootPath": %q, "buckets": [%q], "versioning": %t}`,
+		t.TempDir(), bucket, versioning)
+	l := NewLocalOss().(*LocalOss)
+	err := l.Init(context.TODO(), &oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: []byte(conf)}})
+	assert.Nil(t, err)
+	return l
+}
+
+func put(t *testing.T, l *LocalOss, key string, data string) *oss.PutObjectOutput {
+	out, err := l.PutObject(context.TODO(), &oss.PutObjectInput{Bucket: bucket, Key: key, DataStream: strings.NewReader(data)})
+	assert.Nil(t, err)
+	return out
+}
+
+func read(t *testing.T, l *LocalOss, req *oss.GetObjectInput) string {
+	out, err := l.GetObject(context.TODO(), req)
+	assert.Nil(t, err)
+	defer out.DataStream.Close()
+	data, err := ioutil.ReadAll(out.DataStream)
+	assert.Nil(t, err)
+	return string(data)
+}
+
+func TestInit(t *testing.T) {
+	l := NewLocalOss()
+	err := l.Init(context.TODO(), &oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: []byte("hello")}})
+	assert.Equal(t, oss.ErrInvalid, err)
+	err = l.Init(context.TODO(), &oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: []byte("{}")}})
+	assert.Equal(t, oss.ErrInvalid, err)
+}
+
+func TestPutAndGetObject(t *testing.T) {
+	l := newLocalOss(t, false)
+	out, err := l.PutObject(context.TODO(), &oss.PutObjectInput{
+		Bucket:       bucket,
+		Key:          "a/b.txt",
+		DataStream:   strings.NewReader("hello world"),
+		CacheControl: "no-cache",
+		Meta:         map[string]string{"k": "v"},
+		Tagging:      map[string]string{"t": "1"},
+	})
+	assert.Nil(t, err)
+	// md5 of "hello world"
+	assert.Equal(t, `"5eb63bbbe01eeed093cb22bb8f5acdc3"`, out.ETag)
+
+	get, err := l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt"})
+	assert.Nil(t, err)
+	get.DataStream.Close()
+	assert.Equal(t, int64(11), get.ContentLength)
+	assert.Equal(t, out.ETag, get.ETag)
+	assert.Equal(t, "no-cache", get.CacheControl)
+	assert.Equal(t, defaultContentType, get.ContentType)
+	assert.Equal(t, map[string]string{"k": "v"}, get.Metadata)
+	assert.Equal(t, int64(1), get.TagCount)
+	assert.Equal(t, "", get.VersionId)
+	assert.Equal(t, "", get.ContentRange)
+
+	assert.Equal(t, "hello world", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt"}))
+	assert.Equal(t, "world", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt", Start: 6}))
+	assert.Equal(t, "ello", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt", Start: 1, End: 4}))
+	get, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt", Start: 1, End: 4})
+	assert.Nil(t, err)
+	get.DataStream.Close()
+	assert.Equal(t, "bytes 1-4/11", get.ContentRange)
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt", Start: 11})
+	assert.Equal(t, ErrInvalidRange, err)
+
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt", IfMatch: `"other"`})
+	assert.Equal(t, ErrPreconditionFailed, err)
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt", IfNoneMatch: out.ETag})
+	assert.Equal(t, ErrNotModified, err)
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt", IfModifiedSince: get.LastModified})
+	assert.Equal(t, ErrNotModified, err)
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt", IfUnmodifiedSince: get.LastModified - 1})
+	assert.Equal(t, ErrPreconditionFailed, err)
+	assert.Equal(t, "hello world", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt", IfMatch: out.ETag}))
+
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "none"})
+	assert.Equal(t, ErrNoSuchKey, err)
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: "none", Key: "a/b.txt"})
+	assert.Equal(t, ErrNoSuchBucket, err)
+	_, err = l.PutObject(context.TODO(), &oss.PutObjectInput{Bucket: bucket, Key: "a", ACL: "wrong", DataStream: strings.NewReader("")})
+	assert.Equal(t, ErrInvalidAcl, err)
+
+	put(t, l, "a/b.txt", "overwritten")
+	assert.Equal(t, "overwritten", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "a/b.txt"}))
+
+	head, err := l.HeadObject(context.TODO(), &oss.HeadObjectInput{Bucket: bucket, Key: "a/b.txt", WithDetails: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "11", head.ResultMetadata["Content-Length"])
+	exist, err := l.IsObjectExist(context.TODO(), &oss.IsObjectExistInput{Bucket: bucket, Key: "a/b.txt"})
+	assert.Nil(t, err)
+	assert.True(t, exist.FileExist)
+
+	_, err = l.DeleteObject(context.TODO(), &oss.DeleteObjectInput{Bucket: bucket, Key: "a/b.txt"})
+	assert.Nil(t, err)
+	exist, err = l.IsObjectExist(context.TODO(), &oss.IsObjectExistInput{Bucket: bucket, Key: "a/b.txt"})
+	assert.Nil(t, err)
+	assert.False(t, exist.FileExist)
+	// deleting an object which doesn't exist succeeds
+	_, err = l.DeleteObject(context.TODO(), &oss.DeleteObjectInput{Bucket: bucket, Key: "a/b.txt"})
+	assert.Nil(t, err)
+}
+
+func TestObjectVersions(t *testing.T) {
+	l := newLocalOss(t, true)
+	put(t, l, "key", "v1")
+	put(t, l, "key", "v2")
+	del, err := l.DeleteObject(context.TODO(), &oss.DeleteObjectInput{Bucket: bucket, Key: "key"})
+	assert.Nil(t, err)
+	assert.True(t, del.DeleteMarker)
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "key"})
+	assert.Equal(t, ErrNoSuchKey, err)
+
+	versions, err := l.ListObjectVersions(context.TODO(), &oss.ListObjectVersionsInput{Bucket: bucket})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(versions.DeleteMarkers))
+	assert.True(t, versions.DeleteMarkers[0].IsLatest)
+	assert.Equal(t, del.VersionId, versions.DeleteMarkers[0].VersionId)
+	assert.Equal(t, 2, len(versions.Versions))
+	assert.False(t, versions.Versions[0].IsLatest)
+	v2, v1 := versions.Versions[0].VersionId, versions.Versions[1].VersionId
+	assert.Equal(t, "v1", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "key", VersionId: v1}))
+	assert.Equal(t, "v2", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "key", VersionId: v2}))
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "key", VersionId: del.VersionId})
+	assert.Equal(t, ErrMethodNotAllowed, err)
+	_, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "key", VersionId: "none"})
+	assert.Equal(t, ErrNoSuchVersion, err)
+
+	page, err := l.ListObjectVersions(context.TODO(), &oss.ListObjectVersionsInput{Bucket: bucket, MaxKeys: 2})
+	assert.Nil(t, err)
+	assert.True(t, page.IsTruncated)
+	assert.Equal(t, "key", page.NextKeyMarker)
+	assert.Equal(t, v2, page.NextVersionIdMarker)
+	page, err = l.ListObjectVersions(context.TODO(), &oss.ListObjectVersionsInput{
+		Bucket: bucket, KeyMarker: page.NextKeyMarker, VersionIdMarker: page.NextVersionIdMarker,
+	})
+	assert.Nil(t, err)
+	assert.False(t, page.IsTruncated)
+	assert.Equal(t, 1, len(page.Versions))
+	assert.Equal(t, v1, page.Versions[0].VersionId)
+
+	// removing the delete marker restores the object
+	_, err = l.DeleteObject(context.TODO(), &oss.DeleteObjectInput{Bucket: bucket, Key: "key", VersionId: del.VersionId})
+	assert.Nil(t, err)
+	assert.Equal(t, "v2", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "key"}))
+	_, err = l.DeleteObject(context.TODO(), &oss.DeleteObjectInput{Bucket: bucket, Key: "key", VersionId: v2})
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "key"}))
+}
+
+func TestListObjects(t *testing.T) {
+	l := newLocalOss(t, false)
+	for _, key := range []string{"a", "b/1", "b/2", "c/1", "d"} {
+		put(t, l, key, key)
+	}
+	out, err := l.ListObjects(context.TODO(), &oss.ListObjectsInput{Bucket: bucket})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(out.Contents))
+	assert.Equal(t, int64(3), out.Contents[1].Size)
+
+	out, err = l.ListObjects(context.TODO(), &oss.ListObjectsInput{Bucket: bucket, Delimiter: "/"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b/", "c/"}, out.CommonPrefixes)
+	assert.Equal(t, 2, len(out.Contents))
+
+	out, err = l.ListObjects(context.TODO(), &oss.ListObjectsInput{Bucket: bucket, Delimiter: "/", MaxKeys: 2})
+	assert.Nil(t, err)
+	assert.True(t, out.IsTruncated)
+	assert.Equal(t, "b/", out.NextMarker)
+	out, err = l.ListObjects(context.TODO(), &oss.ListObjectsInput{Bucket: bucket, Delimiter: "/", Marker: out.NextMarker})
+	assert.Nil(t, err)
+	assert.False(t, out.IsTruncated)
+	assert.Equal(t, []string{"c/"}, out.CommonPrefixes)
+	assert.Equal(t, "d", out.Contents[0].Key)
+
+	out, err = l.ListObjects(context.TODO(), &oss.ListObjectsInput{Bucket: bucket, Prefix: "b/"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(out.Contents))
+
+	deleted, err := l.DeleteObjects(context.TODO(), &oss.DeleteObjectsInput{Bucket: bucket, Delete: &oss.Delete{
+		Objects: []*oss.ObjectIdentifier{{Key: "b/1"}, {Key: "b/2"}},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(deleted.Deleted))
+	out, err = l.ListObjects(context.TODO(), &oss.ListObjectsInput{Bucket: bucket, Prefix: "b/"})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(out.Contents))
+}
+
+func TestTaggingAndAcl(t *testing.T) {
+	l := newLocalOss(t, false)
+	put(t, l, "key", "data")
+	tags, err := l.GetObjectTagging(context.TODO(), &oss.GetObjectTaggingInput{Bucket: bucket, Key: "key"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{}, tags.Tags)
+	_, err = l.PutObjectTagging(context.TODO(), &oss.PutObjectTaggingInput{Bucket: bucket, Key: "key", Tags: map[string]string{"a": "b"}})
+	assert.Nil(t, err)
+	tags, err = l.GetObjectTagging(context.TODO(), &oss.GetObjectTaggingInput{Bucket: bucket, Key: "key"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"a": "b"}, tags.Tags)
+	_, err = l.DeleteObjectTagging(context.TODO(), &oss.DeleteObjectTaggingInput{Bucket: bucket, Key: "key"})
+	assert.Nil(t, err)
+	tags, err = l.GetObjectTagging(context.TODO(), &oss.GetObjectTaggingInput{Bucket: bucket, Key: "key"})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tags.Tags))
+	_, err = l.PutObjectTagging(context.TODO(), &oss.PutObjectTaggingInput{Bucket: bucket, Key: "none"})
+	assert.Equal(t, ErrNoSuchKey, err)
+
+	acl, err := l.GetObjectCannedAcl(context.TODO(), &oss.GetObjectCannedAclInput{Bucket: bucket, Key: "key"})
+	assert.Nil(t, err)
+	assert.Equal(t, "private", acl.CannedAcl)
+	_, err = l.PutObjectCannedAcl(context.TODO(), &oss.PutObjectCannedAclInput{Bucket: bucket, Key: "key", Acl: "public-read"})
+	assert.Nil(t, err)
+	acl, err = l.GetObjectCannedAcl(context.TODO(), &oss.GetObjectCannedAclInput{Bucket: bucket, Key: "key"})
+	assert.Nil(t, err)
+	assert.Equal(t, "public-read", acl.CannedAcl)
+	_, err = l.PutObjectCannedAcl(context.TODO(), &oss.PutObjectCannedAclInput{Bucket: bucket, Key: "key", Acl: "wrong"})
+	assert.Equal(t, ErrInvalidAcl, err)
+}
+
+func TestCopyObject(t *testing.T) {
+	l := newLocalOss(t, false)
+	_, err := l.PutObject(context.TODO(), &oss.PutObjectInput{
+		Bucket: bucket, Key: "src", DataStream: strings.NewReader("data"),
+		Meta: map[string]string{"k": "v"}, Tagging: map[string]string{"t": "1"},
+	})
+	assert.Nil(t, err)
+	out, err := l.CopyObject(context.TODO(), &oss.CopyObjectInput{
+		Bucket: bucket, Key: "dst", CopySource: &oss.CopySource{CopySourceBucket: bucket, CopySourceKey: "src"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `"8d777f385d3dfec8815d20f7496026dc"`, out.CopyObjectResult.ETag)
+	get, err := l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "dst"})
+	assert.Nil(t, err)
+	get.DataStream.Close()
+	assert.Equal(t, map[string]string{"k": "v"}, get.Metadata)
+	assert.Equal(t, int64(1), get.TagCount)
+
+	_, err = l.CopyObject(context.TODO(), &oss.CopyObjectInput{
+		Bucket: bucket, Key: "dst", CopySource: &oss.CopySource{CopySourceBucket: bucket, CopySourceKey: "src"},
+		MetadataDirective: "REPLACE", Metadata: map[string]string{"k": "new"},
+	})
+	assert.Nil(t, err)
+	get, err = l.GetObject(context.TODO(), &oss.GetObjectInput{Bucket: bucket, Key: "dst"})
+	assert.Nil(t, err)
+	get.DataStream.Close()
+	assert.Equal(t, map[string]string{"k": "new"}, get.Metadata)
+
+	_, err = l.CopyObject(context.TODO(), &oss.CopyObjectInput{
+		Bucket: bucket, Key: "dst", CopySource: &oss.CopySource{CopySourceBucket: bucket, CopySourceKey: "none"},
+	})
+	assert.Equal(t, ErrNoSuchKey, err)
+}
+
+func TestAppendObject(t *testing.T) {
+	l := newLocalOss(t, false)
+	out, err := l.AppendObject(context.TODO(), &oss.AppendObjectInput{Bucket: bucket, Key: "log", DataStream: bytes.NewReader([]byte("hello "))})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), out.AppendPosition)
+	_, err = l.AppendObject(context.TODO(), &oss.AppendObjectInput{Bucket: bucket, Key: "log", Position: 1, DataStream: strings.NewReader("world")})
+	assert.Equal(t, ErrPositionNotEqualToSize, err)
+	out, err = l.AppendObject(context.TODO(), &oss.AppendObjectInput{Bucket: bucket, Key: "log", Position: 6, DataStream: strings.NewReader("world")})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), out.AppendPosition)
+	assert.Equal(t, "hello world", read(t, l, &oss.GetObjectInput{Bucket: bucket, Key: "log"}))
+
+	put(t, l, "normal", "data")
+	_, err = l.AppendObject(context.TODO(), &oss.AppendObjectInput{Bucket: bucket, Key: "normal", Position: 4, DataStream: strings.NewReader("more")})
+	assert.Equal(t, ErrNotAppendable, err)
+}
+
+func TestNotSupported(t *testing.T) {
+	l := newLocalOss(t, false)
+	_, err := l.RestoreObject(context.TODO(), &oss.RestoreObjectInput{})
+	assert.Equal(t, ErrNotSupportRestore, err)
+	assert.Equal(t, ErrNotSupportBandwidth, l.UpdateDownloadBandwidthRateLimit(context.TODO(), &oss.UpdateBandwidthRateLimitInput{}))
+	assert.Equal(t, ErrNotSupportBandwidth, l.UpdateUploadBandwidthRateLimit(context.TODO(), &oss.UpdateBandwidthRateLimitInput{}))
+}
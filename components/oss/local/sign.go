@@ -0,0 +1,52 @@
+/*
+* Copyright 2021 Layotto Authors
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*     http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"mosn.io/layotto/components/oss"
+)
+
+// SetPresignFunc is invoked by the runtime to inject the function signing the urls of this store
+func (l *LocalOss) SetPresignFunc(f oss.PresignFunc) {
+	l.presign = f
+}
+
+// SignURL delegates to the presign of the runtime, and the url is served by the presign server of the runtime.
+func (l *LocalOss) SignURL(ctx context.Context, req *oss.SignURLInput) (*oss.SignURLOutput, error) {
+	if l.presign == nil {
+		return nil, ErrPresignDisabled
+	}
+	method := strings.ToUpper(req.Method)
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodHead, http.MethodDelete:
+	default:
+		return nil, fmt.Errorf("not supported method %+v now", req.Method)
+	}
+	if _, err := l.store.metaPath(req.Bucket, req.Key); err != nil {
+		return nil, err
+	}
+	u, err := l.presign(req.Bucket, req.Key, method, req.ExpiredInSec)
+	if err != nil {
+		return nil, err
+	}
+	return &oss.SignURLOutput{SignedUrl: u}, nil
+}
@@ -0,0 +1,47 @@
+/*
+* Copyright 2021 Layotto Authors
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*     http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/oss"
+)
+
+func TestSignURL(t *testing.T) {
+	l := newLocalOss(t, false)
+	_, err := l.SignURL(context.TODO(), &oss.SignURLInput{Bucket: bucket, Key: "key", Method: "GET", ExpiredInSec: 60})
+	assert.Equal(t, ErrPresignDisabled, err)
+
+	var signed []interface{}
+	l.SetPresignFunc(func(bucket string, key string, method string, expiresInSec int64) (string, error) {
+		signed = []interface{}{bucket, key, method, expiresInSec}
+		return "http://127.0.0.1:34905/presign/oss/oss_demo/" + bucket + "/" + key, nil
+	})
+	out, err := l.SignURL(context.TODO(), &oss.SignURLInput{Bucket: bucket, Key: "dir/a.txt", Method: "put", ExpiredInSec: 60})
+	assert.Nil(t, err)
+	assert.Equal(t, "http://127.0.0.1:34905/presign/oss/oss_demo/bucket/dir/a.txt", out.SignedUrl)
+	assert.Equal(t, []interface{}{bucket, "dir/a.txt", "PUT", int64(60)}, signed)
+
+	_, err = l.SignURL(context.TODO(), &oss.SignURLInput{Bucket: bucket, Key: "key", Method: "POST"})
+	assert.NotNil(t, err)
+	_, err = l.SignURL(context.TODO(), &oss.SignURLInput{Bucket: "none", Key: "key", Method: "GET"})
+	assert.Equal(t, ErrNoSuchBucket, err)
+}
@@ -0,0 +1,277 @@
+/*
+* Copyright 2021 Layotto Authors
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*     http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+ */
+
+package local
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	metaDir   = ".meta"
+	dataDir   = ".data"
+	uploadDir = ".uploads"
+
+	// nullVersionId is the version id of the objects when versioning is disabled
+	nullVersionId = "null"
+	defaultAcl    = "private"
+)
+
+// objectVersion is a version of an object, or a delete marker
+type objectVersion struct {
+	VersionId    string `json:"versionId"`
+	DeleteMarker bool   `json:"deleteMarker,omitempty"`
+	// DataId is the name of the data file, which never changes during the version lifetime
+	DataId             string            `json:"dataId,omitempty"`
+	ETag               string            `json:"etag,omitempty"`
+	Size               int64             `json:"size"`
+	LastModified       time.Time         `json:"lastModified"`
+	ContentType        string            `json:"contentType,omitempty"`
+	CacheControl       string            `json:"cacheControl,omitempty"`
+	ContentDisposition string            `json:"contentDisposition,omitempty"`
+	ContentEncoding    string            `json:"contentEncoding,omitempty"`
+	Expires            int64             `json:"expires,omitempty"`
+	StorageClass       string            `json:"storageClass,omitempty"`
+	Meta               map[string]string `json:"meta,omitempty"`
+	Tags               map[string]string `json:"tags,omitempty"`
+	Acl                string            `json:"acl,omitempty"`
+	// Appendable is true if the object is created by AppendObject
+	Appendable bool `json:"appendable,omitempty"`
+}
+
+// objectMeta keeps all the versions of an object, from the latest to the oldest
+type objectMeta struct {
+	Key      string           `json:"key"`
+	Versions []*objectVersion `json:"versions"`
+}
+
+func (m *objectMeta) latest() *objectVersion {
+	if len(m.Versions) == 0 {
+		return nil
+	}
+	return m.Versions[0]
+}
+
+// version returns the version specified, or the latest one if versionId is empty
+func (m *objectMeta) version(versionId string) *objectVersion {
+	if versionId == "" {
+		return m.latest()
+	}
+	for _, v := range m.Versions {
+		if v.VersionId == versionId {
+			return v
+		}
+	}
+	return nil
+}
+
+func (m *objectMeta) removeVersion(versionId string) *objectVersion {
+	for i, v := range m.Versions {
+		if v.VersionId == versionId {
+			m.Versions = append(m.Versions[:i], m.Versions[i+1:]...)
+			return v
+		}
+	}
+	return nil
+}
+
+// store lays out a bucket directory as:
+//
+//	<bucket>/.meta/<sha256 of key>.json  versions of the object
+//	<bucket>/.data/<data id>             data of a version
+//	<bucket>/.uploads/<upload id>/       multipart upload in progress
+//
+// so any key is supported whatever the file system limits on the names are.
+type store struct {
+	root string
+}
+
+func (s *store) bucketPath(bucket string) (string, error) {
+	if bucket == "" || strings.ContainsAny(bucket, `/\`) || strings.HasPrefix(bucket, ".") {
+		return "", ErrInvalidBucketName
+	}
+	path := filepath.Join(s.root, bucket)
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() {
+		return "", ErrNoSuchBucket
+	}
+	return path, nil
+}
+
+func (s *store) createBucket(bucket string) error {
+	if bucket == "" || strings.ContainsAny(bucket, `/\`) || strings.HasPrefix(bucket, ".") {
+		return ErrInvalidBucketName
+	}
+	return os.MkdirAll(filepath.Join(s.root, bucket), 0755)
+}
+
+func (s *store) metaPath(bucket string, key string) (string, error) {
+	if key == "" {
+		return "", ErrInvalidKey
+	}
+	path, err := s.bucketPath(bucket)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(path, metaDir, hex.EncodeToString(sum[:])+".json"), nil
+}
+
+func (s *store) dataPath(bucket string, dataId string) string {
+	return filepath.Join(s.root, bucket, dataDir, dataId)
+}
+
+// loadMeta returns the versions of the object, which are empty if the object doesn't exist
+func (s *store) loadMeta(bucket string, key string) (*objectMeta, error) {
+	path, err := s.metaPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &objectMeta{Key: key}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	meta := &objectMeta{}
+	if err = json.Unmarshal(data, meta); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// saveMeta writes the versions of the object atomically, and removes the object if there is no version
+func (s *store) saveMeta(bucket string, meta *objectMeta) error {
+	path, err := s.metaPath(bucket, meta.Key)
+	if err != nil {
+		return err
+	}
+	if len(meta.Versions) == 0 {
+		err = os.Remove(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp := path + "." + newId()
+	if err = ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// listMeta returns all the objects in the bucket, in the order of key
+func (s *store) listMeta(bucket string) ([]*objectMeta, error) {
+	path, err := s.bucketPath(bucket)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := ioutil.ReadDir(filepath.Join(path, metaDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	metas := make([]*objectMeta, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(path, metaDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		meta := &objectMeta{}
+		if err = json.Unmarshal(data, meta); err != nil {
+			return nil, err
+		}
+		metas = append(metas, meta)
+	}
+	sort.Slice(metas, func(i, j int) bool {
+		return metas[i].Key < metas[j].Key
+	})
+	return metas, nil
+}
+
+// writeData writes the data to a new data file, and returns the data id with the md5 and the size of the data
+func (s *store) writeData(bucket string, r io.Reader) (string, string, int64, error) {
+	if err := os.MkdirAll(filepath.Join(s.root, bucket, dataDir), 0755); err != nil {
+		return "", "", 0, err
+	}
+	dataId := newId()
+	f, err := os.OpenFile(s.dataPath(bucket, dataId), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return "", "", 0, err
+	}
+	h := md5.New()
+	n, err := io.Copy(io.MultiWriter(f, h), r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		s.removeData(bucket, dataId)
+		return "", "", 0, err
+	}
+	return dataId, hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+func (s *store) removeData(bucket string, dataId string) {
+	if dataId != "" {
+		os.Remove(s.dataPath(bucket, dataId))
+	}
+}
+
+func newId() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// quoteETag returns the ETag in the form of S3, which is quoted
+func quoteETag(etag string) string {
+	return `"` + etag + `"`
+}
+
+func unquoteETag(etag string) string {
+	return strings.Trim(etag, `"`)
+}
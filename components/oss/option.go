@@ -24,6 +24,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"github.com/jinzhu/copier"
 )
@@ -111,14 +112,50 @@ func GetCopyObjectOutput(resp *s3.CopyObjectOutput) (*CopyObjectOutput, error) {
 func GetListObjectsOutput(resp *s3.ListObjectsOutput) (*ListObjectsOutput, error) {
 	output := &ListObjectsOutput{}
 	err := copier.CopyWithOption(output, resp, copier.Option{IgnoreEmpty: true, DeepCopy: true, Converters: []copier.TypeConverter{TimeToInt64}})
+	output.CommonPrefixes = commonPrefixes(resp.CommonPrefixes)
 	// if not return NextMarker, use the value of the last Key in the response as the marker
 	if output.IsTruncated && output.NextMarker == "" {
-		index := len(output.Contents) - 1
-		output.NextMarker = output.Contents[index].Key
+		output.NextMarker = LastListed(output)
 	}
 	return output, err
 }
 
+func GetListObjectsV2Output(resp *s3.ListObjectsV2Output) (*ListObjectsOutput, error) {
+	output := &ListObjectsOutput{}
+	err := copier.CopyWithOption(output, resp, copier.Option{IgnoreEmpty: true, DeepCopy: true, Converters: []copier.TypeConverter{TimeToInt64}})
+	output.CommonPrefixes = commonPrefixes(resp.CommonPrefixes)
+	if resp.StartAfter != nil {
+		output.Marker = *resp.StartAfter
+	}
+	// the last listed one is the marker of the next page as well, for the callers paging with markers
+	if output.IsTruncated {
+		output.NextMarker = LastListed(output)
+	}
+	return output, err
+}
+
+func commonPrefixes(prefixes []types.CommonPrefix) []string {
+	var out []string
+	for _, p := range prefixes {
+		if p.Prefix != nil {
+			out = append(out, *p.Prefix)
+		}
+	}
+	return out
+}
+
+// LastListed returns the greater one of the last key and the last common prefix, which is the marker of the next page
+func LastListed(output *ListObjectsOutput) string {
+	last := ""
+	if n := len(output.Contents); n > 0 {
+		last = output.Contents[n-1].Key
+	}
+	if n := len(output.CommonPrefixes); n > 0 && output.CommonPrefixes[n-1] > last {
+		last = output.CommonPrefixes[n-1]
+	}
+	return last
+}
+
 func GetGetObjectCannedAclOutput(resp *s3.GetObjectAclOutput) (*GetObjectCannedAclOutput, error) {
 	out := &GetObjectCannedAclOutput{}
 	err := copier.CopyWithOption(out, resp, copier.Option{IgnoreEmpty: true, DeepCopy: true, Converters: []copier.TypeConverter{}})
@@ -23,6 +23,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"github.com/stretchr/testify/assert"
 
@@ -83,3 +84,25 @@ func TestGetOutput(t *testing.T) {
 	_, err = GetListObjectVersionsOutput(&s3.ListObjectVersionsOutput{})
 	assert.Nil(t, err)
 }
+
+func TestGetListObjectsV2Output(t *testing.T) {
+	key, prefix, token, startAfter := "a.txt", "b/", "token", "0.txt"
+	output, err := GetListObjectsV2Output(&s3.ListObjectsV2Output{
+		Contents:              []types.Object{{Key: &key, Size: 1}},
+		CommonPrefixes:        []types.CommonPrefix{{Prefix: &prefix}},
+		IsTruncated:           true,
+		NextContinuationToken: &token,
+		StartAfter:            &startAfter,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b/"}, output.CommonPrefixes)
+	assert.Equal(t, "a.txt", output.Contents[0].Key)
+	assert.Equal(t, "token", output.NextContinuationToken)
+	assert.Equal(t, "0.txt", output.Marker)
+	// the common prefix is listed after the key
+	assert.Equal(t, "b/", output.NextMarker)
+
+	output, err = GetListObjectsOutput(&s3.ListObjectsOutput{IsTruncated: true, CommonPrefixes: []types.CommonPrefix{{Prefix: &prefix}}})
+	assert.Nil(t, err)
+	assert.Equal(t, "b/", output.NextMarker)
+}
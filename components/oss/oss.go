@@ -57,6 +57,15 @@ type Oss interface {
 	ListParts(context.Context, *ListPartsInput) (*ListPartsOutput, error)
 }
 
+// PresignFunc signs a url served by the runtime, which grants the method on the object and expires after expiresInSec
+type PresignFunc func(bucket string, key string, method string, expiresInSec int64) (string, error)
+
+// Presignable is implemented by the components unable to sign urls by themselves, e.g. the local oss.
+// The runtime injects a PresignFunc signing the urls with its presign config.
+type Presignable interface {
+	SetPresignFunc(f PresignFunc)
+}
+
 type GetObjectInput struct {
 	Bucket                     string `json:"bucket,omitempty"`
 	ExpectedBucketOwner        string `json:"expected_bucket_owner,omitempty"`
@@ -233,6 +242,8 @@ type ListObjectsInput struct {
 	MaxKeys             int32  `json:"maxKeys,omitempty"`
 	Prefix              string `json:"prefix,omitempty"`
 	RequestPayer        string `json:"request_payer,omitempty"`
+	// ContinuationToken is the NextContinuationToken of the previous page, for the providers listing with tokens
+	ContinuationToken string `json:"continuation_token,omitempty"`
 }
 type ListObjectsOutput struct {
 	CommonPrefixes []string          `json:"common_prefixes,omitempty"`
@@ -246,6 +257,8 @@ type ListObjectsOutput struct {
 	NextMarker     string            `json:"next_marker,omitempty"`
 	Prefix         string            `json:"prefix,omitempty"`
 	Metadata       map[string]string `json:"metadata,omitempty"`
+	// NextContinuationToken is only returned by the providers listing with tokens
+	NextContinuationToken string `json:"next_continuation_token,omitempty"`
 }
 type Object struct {
 	ETag         string `json:"etag,omitempty"`
@@ -57,6 +57,26 @@ func Errorf(code int, format string, a ...interface{}) CommonError {
 	return &commonError{code: code, msg: fmt.Sprintf(format, a...)}
 }
 
+// StatusError is an error status responded by the upstream, which means the upstream has received the request.
+// Its Code is UnavailebleCode, the same as the other errors of the upstream.
+type StatusError interface {
+	CommonError
+	Status() int
+}
+
+type statusError struct {
+	commonError
+	status int
+}
+
+func (se *statusError) Status() int {
+	return se.status
+}
+
+func StatusErrorf(status int, format string, a ...interface{}) StatusError {
+	return &statusError{commonError: commonError{code: UnavailebleCode, msg: fmt.Sprintf(format, a...)}, status: status}
+}
+
 func ToGrpcError(err error) error {
 	switch v := err.(type) {
 	case CommonError:
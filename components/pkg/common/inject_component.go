@@ -18,6 +18,7 @@ package common
 
 import (
 	"mosn.io/layotto/components/configstores"
+	"mosn.io/layotto/components/lock"
 
 	"github.com/dapr/components-contrib/secretstores"
 )
@@ -26,3 +27,9 @@ type SetComponent interface {
 	SetConfigStore(cs configstores.Store) (err error)
 	SetSecretStore(ss secretstores.SecretStore) (err error)
 }
+
+// SetLockStore is implemented by the components depending on a lock store,
+// which is injected according to `component_ref.lock_store` in the config.
+type SetLockStore interface {
+	SetLockStore(ls lock.LockStore) (err error)
+}
@@ -101,6 +101,38 @@ func (mr *MockConsulKVMockRecorder) Acquire(p, q interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockConsulKV)(nil).Acquire), p, q)
 }
 
+// Get mocks base method.
+func (m *MockConsulKV) Get(key string, q *api.QueryOptions) (*api.KVPair, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key, q)
+	ret0, _ := ret[0].(*api.KVPair)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockConsulKVMockRecorder) Get(key, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockConsulKV)(nil).Get), key, q)
+}
+
+// List mocks base method.
+func (m *MockConsulKV) List(prefix string, q *api.QueryOptions) (api.KVPairs, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", prefix, q)
+	ret0, _ := ret[0].(api.KVPairs)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockConsulKVMockRecorder) List(prefix, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockConsulKV)(nil).List), prefix, q)
+}
+
 // Release mocks base method.
 func (m *MockConsulKV) Release(p *api.KVPair, q *api.WriteOptions) (bool, *api.WriteMeta, error) {
 	m.ctrl.T.Helper()
@@ -170,3 +202,19 @@ func (mr *MockSessionFactoryMockRecorder) Destroy(id, q interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockSessionFactory)(nil).Destroy), id, q)
 }
+
+// Renew mocks base method.
+func (m *MockSessionFactory) Renew(id string, q *api.WriteOptions) (*api.SessionEntry, *api.WriteMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Renew", id, q)
+	ret0, _ := ret[0].(*api.SessionEntry)
+	ret1, _ := ret[1].(*api.WriteMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Renew indicates an expected call of Renew.
+func (mr *MockSessionFactoryMockRecorder) Renew(id, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Renew", reflect.TypeOf((*MockSessionFactory)(nil).Renew), id, q)
+}
@@ -72,8 +72,37 @@ func (f *MockMongoFactory) NewSingleResult(sr *mongo.SingleResult) utils.MongoSi
 }
 
 func (mc *MockMongoCollection) FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) *mongo.SingleResult {
-	result := mongo.SingleResult{}
-	return &result
+	if doc, ok := mc.find(filter.(bson.M)); ok {
+		return mongo.NewSingleResultFromDocument(doc, nil, nil)
+	}
+	return mongo.NewSingleResultFromDocument(bson.M{}, mongo.ErrNoDocuments, nil)
+}
+
+// find returns the cached document matching the '_id' and 'LockOwner' of the filter
+func (mc *MockMongoCollection) find(filter bson.M) (bson.M, bool) {
+	doc, ok := mc.Result[filter["_id"].(string)]
+	if !ok {
+		return nil, false
+	}
+	if owner, ok := filter["LockOwner"]; ok && doc["LockOwner"] != owner {
+		return nil, false
+	}
+	return doc, true
+}
+
+// update applies the '$set' and '$inc' operators to the document
+func (mc *MockMongoCollection) update(doc bson.M, update bson.M) {
+	if set, ok := update["$set"].(bson.M); ok {
+		for k, v := range set {
+			doc[k] = v
+		}
+	}
+	if inc, ok := update["$inc"].(bson.M); ok {
+		for k, v := range inc {
+			current, _ := doc[k].(int64)
+			doc[k] = current + v.(int64)
+		}
+	}
 }
 
 func (mc *MockMongoCollection) InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
@@ -112,11 +141,33 @@ func (mc *MockMongoCollection) Indexes() mongo.IndexView {
 }
 
 func (mc *MockMongoCollection) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
-	return nil, nil
+	res := &mongo.UpdateResult{}
+	if doc, ok := mc.find(filter.(bson.M)); ok {
+		mc.update(doc, update.(bson.M))
+		res.MatchedCount = 1
+		res.ModifiedCount = 1
+	}
+	return res, nil
 }
 
 func (mc *MockMongoCollection) FindOneAndUpdate(ctx context.Context, filter interface{}, update interface{}, opts ...*options.FindOneAndUpdateOptions) *mongo.SingleResult {
-	return nil
+	f := filter.(bson.M)
+	doc, ok := mc.find(f)
+	if !ok {
+		upsert := false
+		for _, opt := range opts {
+			if opt != nil && opt.Upsert != nil {
+				upsert = *opt.Upsert
+			}
+		}
+		if !upsert {
+			return mongo.NewSingleResultFromDocument(bson.M{}, mongo.ErrNoDocuments, nil)
+		}
+		doc = bson.M{"_id": f["_id"]}
+		mc.Result[f["_id"].(string)] = doc
+	}
+	mc.update(doc, update.(bson.M))
+	return mongo.NewSingleResultFromDocument(doc, nil, nil)
 }
 
 func (c *MockMongoClient) StartSession(opts ...*options.SessionOptions) (mongo.Session, error) {
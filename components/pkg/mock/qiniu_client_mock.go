@@ -89,6 +89,20 @@ func (m *MockBucketManager) EXPECT() *MockBucketManagerMockRecorder {
 	return m.recorder
 }
 
+// Copy mocks base method.
+func (m *MockBucketManager) Copy(srcBucket, srcKey, destBucket, destKey string, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", srcBucket, srcKey, destBucket, destKey, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockBucketManagerMockRecorder) Copy(srcBucket, srcKey, destBucket, destKey, force interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockBucketManager)(nil).Copy), srcBucket, srcKey, destBucket, destKey, force)
+}
+
 // Delete mocks base method.
 func (m *MockBucketManager) Delete(bucket, key string) error {
 	m.ctrl.T.Helper()
@@ -121,6 +135,20 @@ func (mr *MockBucketManagerMockRecorder) ListFiles(bucket, prefix, delimiter, ma
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockBucketManager)(nil).ListFiles), bucket, prefix, delimiter, marker, limit)
 }
 
+// Move mocks base method.
+func (m *MockBucketManager) Move(srcBucket, srcKey, destBucket, destKey string, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", srcBucket, srcKey, destBucket, destKey, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockBucketManagerMockRecorder) Move(srcBucket, srcKey, destBucket, destKey, force interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockBucketManager)(nil).Move), srcBucket, srcKey, destBucket, destKey, force)
+}
+
 // Stat mocks base method.
 func (m *MockBucketManager) Stat(bucket, key string) (storage.FileInfo, error) {
 	m.ctrl.T.Helper()
@@ -135,3 +163,83 @@ func (mr *MockBucketManagerMockRecorder) Stat(bucket, key interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stat", reflect.TypeOf((*MockBucketManager)(nil).Stat), bucket, key)
 }
+
+// MockResumeUploader is a mock of ResumeUploader interface.
+type MockResumeUploader struct {
+	ctrl     *gomock.Controller
+	recorder *MockResumeUploaderMockRecorder
+}
+
+// MockResumeUploaderMockRecorder is the mock recorder for MockResumeUploader.
+type MockResumeUploaderMockRecorder struct {
+	mock *MockResumeUploader
+}
+
+// NewMockResumeUploader creates a new mock instance.
+func NewMockResumeUploader(ctrl *gomock.Controller) *MockResumeUploader {
+	mock := &MockResumeUploader{ctrl: ctrl}
+	mock.recorder = &MockResumeUploaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResumeUploader) EXPECT() *MockResumeUploaderMockRecorder {
+	return m.recorder
+}
+
+// CompleteParts mocks base method.
+func (m *MockResumeUploader) CompleteParts(ctx context.Context, upToken, upHost string, ret interface{}, bucket, key string, hasKey bool, uploadId string, extra *storage.RputV2Extra) error {
+	m.ctrl.T.Helper()
+	ret_2 := m.ctrl.Call(m, "CompleteParts", ctx, upToken, upHost, ret, bucket, key, hasKey, uploadId, extra)
+	ret0, _ := ret_2[0].(error)
+	return ret0
+}
+
+// CompleteParts indicates an expected call of CompleteParts.
+func (mr *MockResumeUploaderMockRecorder) CompleteParts(ctx, upToken, upHost, ret, bucket, key, hasKey, uploadId, extra interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteParts", reflect.TypeOf((*MockResumeUploader)(nil).CompleteParts), ctx, upToken, upHost, ret, bucket, key, hasKey, uploadId, extra)
+}
+
+// InitParts mocks base method.
+func (m *MockResumeUploader) InitParts(ctx context.Context, upToken, upHost, bucket, key string, hasKey bool, ret *storage.InitPartsRet) error {
+	m.ctrl.T.Helper()
+	ret_2 := m.ctrl.Call(m, "InitParts", ctx, upToken, upHost, bucket, key, hasKey, ret)
+	ret0, _ := ret_2[0].(error)
+	return ret0
+}
+
+// InitParts indicates an expected call of InitParts.
+func (mr *MockResumeUploaderMockRecorder) InitParts(ctx, upToken, upHost, bucket, key, hasKey, ret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitParts", reflect.TypeOf((*MockResumeUploader)(nil).InitParts), ctx, upToken, upHost, bucket, key, hasKey, ret)
+}
+
+// UpHost mocks base method.
+func (m *MockResumeUploader) UpHost(ak, bucket string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpHost", ak, bucket)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpHost indicates an expected call of UpHost.
+func (mr *MockResumeUploaderMockRecorder) UpHost(ak, bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpHost", reflect.TypeOf((*MockResumeUploader)(nil).UpHost), ak, bucket)
+}
+
+// UploadParts mocks base method.
+func (m *MockResumeUploader) UploadParts(ctx context.Context, upToken, upHost, bucket, key string, hasKey bool, uploadId string, partNumber int64, partMD5 string, ret *storage.UploadPartsRet, body io.Reader, size int) error {
+	m.ctrl.T.Helper()
+	ret_2 := m.ctrl.Call(m, "UploadParts", ctx, upToken, upHost, bucket, key, hasKey, uploadId, partNumber, partMD5, ret, body, size)
+	ret0, _ := ret_2[0].(error)
+	return ret0
+}
+
+// UploadParts indicates an expected call of UploadParts.
+func (mr *MockResumeUploaderMockRecorder) UploadParts(ctx, upToken, upHost, bucket, key, hasKey, uploadId, partNumber, partMD5, ret, body, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadParts", reflect.TypeOf((*MockResumeUploader)(nil).UploadParts), ctx, upToken, upHost, bucket, key, hasKey, uploadId, partNumber, partMD5, ret, body, size)
+}
@@ -88,6 +88,22 @@ func (m *MockZKConnection) EXPECT() *MockZKConnectionMockRecorder {
 	return m.recorder
 }
 
+// Children mocks base method.
+func (m *MockZKConnection) Children(path string) ([]string, *zk.Stat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Children", path)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(*zk.Stat)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Children indicates an expected call of Children.
+func (mr *MockZKConnectionMockRecorder) Children(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Children", reflect.TypeOf((*MockZKConnection)(nil).Children), path)
+}
+
 // Close mocks base method.
 func (m *MockZKConnection) Close() {
 	m.ctrl.T.Helper()
@@ -129,6 +145,23 @@ func (mr *MockZKConnectionMockRecorder) Delete(path, version interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockZKConnection)(nil).Delete), path, version)
 }
 
+// ExistsW mocks base method.
+func (m *MockZKConnection) ExistsW(path string) (bool, *zk.Stat, <-chan zk.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsW", path)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*zk.Stat)
+	ret2, _ := ret[2].(<-chan zk.Event)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ExistsW indicates an expected call of ExistsW.
+func (mr *MockZKConnectionMockRecorder) ExistsW(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsW", reflect.TypeOf((*MockZKConnection)(nil).ExistsW), path)
+}
+
 // Get mocks base method.
 func (m *MockZKConnection) Get(path string) ([]byte, *zk.Stat, error) {
 	m.ctrl.T.Helper()
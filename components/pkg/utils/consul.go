@@ -27,10 +27,13 @@ type ConsulClient interface {
 type ConsulKV interface {
 	Acquire(p *api.KVPair, q *api.WriteOptions) (bool, *api.WriteMeta, error)
 	Release(p *api.KVPair, q *api.WriteOptions) (bool, *api.WriteMeta, error)
+	Get(key string, q *api.QueryOptions) (*api.KVPair, *api.QueryMeta, error)
+	List(prefix string, q *api.QueryOptions) (api.KVPairs, *api.QueryMeta, error)
 }
 type SessionFactory interface {
 	Create(se *api.SessionEntry, q *api.WriteOptions) (string, *api.WriteMeta, error)
 	Destroy(id string, q *api.WriteOptions) (*api.WriteMeta, error)
+	Renew(id string, q *api.WriteOptions) (*api.SessionEntry, *api.WriteMeta, error)
 }
 
 const (
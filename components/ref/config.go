@@ -36,4 +36,5 @@ type SecretRefConfig struct {
 type ComponentRefConfig struct {
 	SecretStore string `json:"secret_store"`
 	ConfigStore string `json:"config_store"`
+	LockStore   string `json:"lock_store"`
 }
@@ -18,9 +18,12 @@ package callback
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"mosn.io/pkg/log"
 
+	"mosn.io/layotto/components/pkg/common"
 	"mosn.io/layotto/components/rpc"
 )
 
@@ -44,6 +47,13 @@ type BeforeFactory interface {
 	Create() func(*rpc.RPCRequest) (*rpc.RPCRequest, error)
 }
 
+// DataDependent is optionally implemented by the BeforeFactory whose filter reads or rewrites the request data,
+// which makes the filter unusable on the streams
+type DataDependent interface {
+	// DependsOnData reports whether the initialized filter depends on the request data
+	DependsOnData() bool
+}
+
 // AfterFactory is handled RPCResponse
 type AfterFactory interface {
 	// Name is create afterFactory name
@@ -61,6 +71,36 @@ var (
 	afterInvokeRegistry = map[string]AfterFactory{}
 )
 
+// unmarshalConfig parses the config of a filter, which can be empty if the filter has defaults for everything
+func unmarshalConfig(name string, config json.RawMessage, v interface{}) error {
+	if len(config) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(config, v); err != nil {
+		return fmt.Errorf("invalid config of rpc filter %s: %v", name, err)
+	}
+	return nil
+}
+
+// lookupHeader finds the header case-insensitively, since the channels return the headers in different cases
+func lookupHeader(header rpc.RPCHeader, key string) (string, bool) {
+	for k, v := range header {
+		if strings.EqualFold(k, key) {
+			return strings.Join(v, ","), true
+		}
+	}
+	return "", false
+}
+
+// removeHeader removes the header case-insensitively
+func removeHeader(header rpc.RPCHeader, key string) {
+	for k := range header {
+		if strings.EqualFold(k, key) {
+			delete(header, k)
+		}
+	}
+}
+
 // NewCallback is created Callback
 func NewCallback() rpc.Callback {
 	return &callback{}
@@ -69,6 +109,8 @@ func NewCallback() rpc.Callback {
 type callback struct {
 	beforeInvoke []func(*rpc.RPCRequest) (*rpc.RPCRequest, error)
 	afterInvoke  []func(*rpc.RPCResponse) (*rpc.RPCResponse, error)
+	// dataFilters are the names of the before filters depending on the request data
+	dataFilters []string
 }
 
 // AddBeforeInvoke is add beforeInvoke into callback.beforeInvoke
@@ -83,6 +125,9 @@ func (c *callback) AddBeforeInvoke(conf rpc.CallbackFunc) {
 		return
 	}
 	c.beforeInvoke = append(c.beforeInvoke, f.Create())
+	if d, ok := f.(DataDependent); ok && d.DependsOnData() {
+		c.dataFilters = append(c.dataFilters, conf.Name)
+	}
 }
 
 // AddAfterInvoke is used to add beforeInvoke into callback.afterInvoke
@@ -110,6 +155,15 @@ func (c *callback) BeforeInvoke(request *rpc.RPCRequest) (*rpc.RPCRequest, error
 	return request, err
 }
 
+// BeforeInvokeStream is used to invoke beforeInvoke callbacks on a stream request,
+// which is rejected if any of them depends on the request data that the streams never buffer
+func (c *callback) BeforeInvokeStream(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	if len(c.dataFilters) > 0 {
+		return nil, common.Errorf(common.InvalidArgsCode, "rpc filter %s depends on the request data, which is not supported by the streams", c.dataFilters[0])
+	}
+	return c.BeforeInvoke(request)
+}
+
 // AfterInvoke is used to invoke afterInvoke callbacks
 func (c *callback) AfterInvoke(response *rpc.RPCResponse) (*rpc.RPCResponse, error) {
 	var err error
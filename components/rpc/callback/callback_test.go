@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"mosn.io/layotto/components/pkg/common"
 	"mosn.io/layotto/components/rpc"
 )
 
@@ -77,3 +78,34 @@ func TestCallback(t *testing.T) {
 	cb.AfterInvoke(resp)
 	assert.Equal(t, "after", string(resp.Data))
 }
+
+func TestCallback_BeforeInvokeStream(t *testing.T) {
+	setTestSecretStores()
+	defer SetSecretStores(nil)
+
+	t.Run("data independent", func(t *testing.T) {
+		cb := NewCallback()
+		cb.AddBeforeInvoke(rpc.CallbackFunc{Name: "sign", Config: json.RawMessage(`{"type": "bearer", "secret": {"store_name": "local", "key": "rpc-token"}}`)})
+		req, err := cb.BeforeInvokeStream(&rpc.RPCRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, "Bearer token1", req.Header.Get("authorization"))
+	})
+
+	t.Run("data dependent", func(t *testing.T) {
+		for name, config := range map[string]string{
+			"sign":         `{"type": "hmac", "secret": {"store_name": "local", "key": "rpc-keys", "sub_key": "hmac"}}`,
+			"gzip":         ``,
+			"json_mapping": `{"remove": ["a"]}`,
+		} {
+			cb := NewCallback()
+			cb.AddBeforeInvoke(rpc.CallbackFunc{Name: "header"})
+			cb.AddBeforeInvoke(rpc.CallbackFunc{Name: name, Config: json.RawMessage(config)})
+			_, err := cb.BeforeInvokeStream(&rpc.RPCRequest{})
+			assert.Equal(t, common.InvalidArgsCode, err.(common.CommonError).Code())
+			assert.Equal(t, "rpc filter "+name+" depends on the request data, which is not supported by the streams", err.(common.CommonError).Msg())
+			// the unary invocations are still allowed
+			_, err = cb.BeforeInvoke(&rpc.RPCRequest{Header: rpc.RPCHeader{}, Data: []byte(`{"a": 1}`)})
+			assert.Nil(t, err)
+		}
+	})
+}
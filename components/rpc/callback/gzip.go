@@ -0,0 +1,142 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+const contentEncoding = "content-encoding"
+
+func init() {
+	RegisterBeforeInvoke(&gzipBeforeFactory{})
+	RegisterAfterInvoke(&gzipAfterFactory{})
+}
+
+// gzipConfig is the config of the gzip before filter
+type gzipConfig struct {
+	// MinSize is the minimum size of the request data to compress
+	MinSize int `json:"min_size"`
+	// Level is the compression level from 1 to 9, and it's gzip.DefaultCompression by default
+	Level *int `json:"level"`
+}
+
+// gzipBeforeFactory is BeforeFactory implement, which compresses the request data
+type gzipBeforeFactory struct {
+	minSize int
+	level   int
+}
+
+func (g *gzipBeforeFactory) Name() string {
+	return "gzip"
+}
+
+func (g *gzipBeforeFactory) Init(config json.RawMessage) error {
+	var conf gzipConfig
+	if err := unmarshalConfig(g.Name(), config, &conf); err != nil {
+		return err
+	}
+	level := gzip.DefaultCompression
+	if conf.Level != nil {
+		level = *conf.Level
+		if level < gzip.BestSpeed || level > gzip.BestCompression {
+			return fmt.Errorf("invalid gzip level %d, which should be from %d to %d", level, gzip.BestSpeed, gzip.BestCompression)
+		}
+	}
+	g.minSize, g.level = conf.MinSize, level
+	return nil
+}
+
+// DependsOnData is true since the request data is compressed
+func (g *gzipBeforeFactory) DependsOnData() bool {
+	return true
+}
+
+// Create compresses the request data which isn't smaller than min_size, and sets the content-encoding header.
+// The data which is already encoded is left as it is.
+func (g *gzipBeforeFactory) Create() func(*rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	minSize, level := g.minSize, g.level
+	return func(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+		if len(request.Data) == 0 || len(request.Data) < minSize {
+			return request, nil
+		}
+		if _, ok := lookupHeader(request.Header, contentEncoding); ok {
+			return request, nil
+		}
+		var buf bytes.Buffer
+		w, err := gzip.NewWriterLevel(&buf, level)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(request.Data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		if request.Header == nil {
+			request.Header = rpc.RPCHeader{}
+		}
+		request.Header[contentEncoding] = []string{"gzip"}
+		request.Data = buf.Bytes()
+		return request, nil
+	}
+}
+
+// gzipAfterFactory is AfterFactory implement, which decompresses the response data
+type gzipAfterFactory struct{}
+
+func (g *gzipAfterFactory) Name() string {
+	return "gzip"
+}
+
+func (g *gzipAfterFactory) Init(config json.RawMessage) error {
+	return nil
+}
+
+// Create decompresses the response data whose content-encoding is gzip, and removes the content-encoding header
+func (g *gzipAfterFactory) Create() func(*rpc.RPCResponse) (*rpc.RPCResponse, error) {
+	return func(response *rpc.RPCResponse) (*rpc.RPCResponse, error) {
+		encoding, ok := lookupHeader(response.Header, contentEncoding)
+		if !ok || !strings.EqualFold(strings.TrimSpace(encoding), "gzip") {
+			return response, nil
+		}
+		removeHeader(response.Header, contentEncoding)
+		if len(response.Data) == 0 {
+			return response, nil
+		}
+		r, err := gzip.NewReader(bytes.NewReader(response.Data))
+		if err != nil {
+			return nil, common.Errorf(common.InternalCode, "decompress gzip response error: %v", err)
+		}
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, common.Errorf(common.InternalCode, "decompress gzip response error: %v", err)
+		}
+		response.Data = data
+		return response, nil
+	}
+}
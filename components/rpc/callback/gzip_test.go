@@ -0,0 +1,85 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+func gunzip(t *testing.T, data []byte) string {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	assert.Nil(t, err)
+	out, err := io.ReadAll(r)
+	assert.Nil(t, err)
+	return string(out)
+}
+
+func Test_gzipBeforeFactory(t *testing.T) {
+	g := &gzipBeforeFactory{}
+	assert.Equal(t, "gzip", g.Name())
+	assert.Equal(t, "invalid gzip level 10, which should be from 1 to 9", g.Init(json.RawMessage(`{"level": 10}`)).Error())
+	assert.Nil(t, g.Init(json.RawMessage(`{"min_size": 5, "level": 9}`)))
+	f := g.Create()
+
+	req, err := f(&rpc.RPCRequest{Data: []byte("hello world")})
+	assert.Nil(t, err)
+	assert.Equal(t, "gzip", req.Header.Get(contentEncoding))
+	assert.Equal(t, "hello world", gunzip(t, req.Data))
+
+	// the small data isn't compressed
+	req, err = f(&rpc.RPCRequest{Data: []byte("hi")})
+	assert.Nil(t, err)
+	assert.Equal(t, "hi", string(req.Data))
+	assert.Empty(t, req.Header)
+
+	// the encoded data isn't compressed again
+	req, err = f(&rpc.RPCRequest{Data: []byte("hello world"), Header: rpc.RPCHeader{"Content-Encoding": []string{"br"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", string(req.Data))
+}
+
+func Test_gzipAfterFactory(t *testing.T) {
+	g := &gzipAfterFactory{}
+	assert.Equal(t, "gzip", g.Name())
+	assert.Nil(t, g.Init(nil))
+	f := g.Create()
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte("hello world"))
+	w.Close()
+	resp, err := f(&rpc.RPCResponse{Data: buf.Bytes(), Header: rpc.RPCHeader{"Content-Encoding": []string{"gzip"}, "x-echo": []string{"1"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", string(resp.Data))
+	assert.Equal(t, rpc.RPCHeader{"x-echo": []string{"1"}}, resp.Header)
+
+	resp, err = f(&rpc.RPCResponse{Data: []byte("hello")})
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(resp.Data))
+
+	_, err = f(&rpc.RPCResponse{Data: []byte("hello"), Header: rpc.RPCHeader{"content-encoding": []string{"gzip"}}})
+	assert.Equal(t, common.InternalCode, err.(common.CommonError).Code())
+}
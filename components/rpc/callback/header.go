@@ -0,0 +1,112 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/template"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+func init() {
+	RegisterBeforeInvoke(&headerFactory{})
+}
+
+// headerConfig is the config of the header filter.
+// The values of Set are templates of the request, e.g. "{{.Id}}/{{.Method}}", "{{.Header.Get \"x-token\"}}" or "{{env \"APP_NAME\"}}",
+// and a value without any action is a static value.
+type headerConfig struct {
+	Set    map[string]string `json:"set"`
+	Remove []string          `json:"remove"`
+}
+
+// headerTemplateData is the data of the header templates
+type headerTemplateData struct {
+	Id          string
+	Method      string
+	ContentType string
+	Timeout     int32
+	Header      rpc.RPCHeader
+}
+
+var headerTemplateFuncs = template.FuncMap{
+	"env": os.Getenv,
+}
+
+// headerFactory is BeforeFactory implement, which sets and removes the request headers
+type headerFactory struct {
+	remove []string
+	set    map[string]*template.Template
+}
+
+func (h *headerFactory) Name() string {
+	return "header"
+}
+
+func (h *headerFactory) Init(config json.RawMessage) error {
+	var conf headerConfig
+	if err := unmarshalConfig(h.Name(), config, &conf); err != nil {
+		return err
+	}
+	h.remove = conf.Remove
+	h.set = make(map[string]*template.Template, len(conf.Set))
+	for key, value := range conf.Set {
+		t, err := template.New(key).Funcs(headerTemplateFuncs).Option("missingkey=error").Parse(value)
+		if err != nil {
+			return fmt.Errorf("invalid template of header %s: %v", key, err)
+		}
+		h.set[key] = t
+	}
+	return nil
+}
+
+// Create removes the headers, and then sets the headers rendered from the request
+func (h *headerFactory) Create() func(*rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	remove, set := h.remove, h.set
+	return func(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+		if request.Header == nil {
+			request.Header = rpc.RPCHeader{}
+		}
+		for _, key := range remove {
+			delete(request.Header, key)
+		}
+		data := &headerTemplateData{
+			Id:          request.Id,
+			Method:      request.Method,
+			ContentType: request.ContentType,
+			Timeout:     request.Timeout,
+			Header:      request.Header,
+		}
+		values := make(map[string]string, len(set))
+		for key, t := range set {
+			var buf bytes.Buffer
+			if err := t.Execute(&buf, data); err != nil {
+				return nil, fmt.Errorf("render header %s error: %v", key, err)
+			}
+			values[key] = buf.String()
+		}
+		// the templates are rendered with the original headers, so they don't depend on each other
+		for key, value := range values {
+			request.Header[key] = []string{value}
+		}
+		return request, nil
+	}
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+func Test_headerFactory(t *testing.T) {
+	h := &headerFactory{}
+	assert.Equal(t, "header", h.Name())
+	assert.NotNil(t, h.Init(json.RawMessage(`{"set": {"x-a": "{{.Id"}}`)))
+
+	os.Setenv("RPC_HEADER_TEST_APP", "app1")
+	defer os.Unsetenv("RPC_HEADER_TEST_APP")
+	err := h.Init(json.RawMessage(`{
+		"set": {
+			"x-static": "layotto",
+			"x-target": "{{.Id}}/{{.Method}}",
+			"x-token": "Token {{.Header.Get \"x-token\"}}",
+			"x-app": "{{env \"RPC_HEADER_TEST_APP\"}}"
+		},
+		"remove": ["x-internal"]
+	}`))
+	assert.Nil(t, err)
+	f := h.Create()
+
+	req := &rpc.RPCRequest{
+		Id:     "HelloService:1.0",
+		Method: "/hello",
+		Header: rpc.RPCHeader{"x-token": []string{"abc"}, "x-internal": []string{"1"}},
+	}
+	req, err = f(req)
+	assert.Nil(t, err)
+	assert.Equal(t, "layotto", req.Header.Get("x-static"))
+	assert.Equal(t, "HelloService:1.0//hello", req.Header.Get("x-target"))
+	assert.Equal(t, "Token abc", req.Header.Get("x-token"))
+	assert.Equal(t, "app1", req.Header.Get("x-app"))
+	_, ok := req.Header["x-internal"]
+	assert.False(t, ok)
+
+	// the filters created before are not changed by the next Init
+	assert.Nil(t, h.Init(json.RawMessage(`{"set": {"x-static": "other"}}`)))
+	req, err = f(&rpc.RPCRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, "layotto", req.Header.Get("x-static"))
+}
@@ -0,0 +1,243 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+func init() {
+	RegisterBeforeInvoke(&jsonMappingBeforeFactory{})
+	RegisterAfterInvoke(&jsonMappingAfterFactory{})
+}
+
+// jsonMappingConfig is the config of the json_mapping filters.
+// The fields are referred by the paths separated by dots, e.g. user.name.
+type jsonMappingConfig struct {
+	// Rename moves the fields from the old paths to the new paths
+	Rename map[string]string `json:"rename"`
+	// Remove removes the fields
+	Remove []string `json:"remove"`
+	// Set sets the fields to the json values, and creates the parent objects if they don't exist
+	Set map[string]json.RawMessage `json:"set"`
+}
+
+// jsonMapping renames, removes and sets the fields of a json object in order
+type jsonMapping struct {
+	rename [][2][]string
+	remove [][]string
+	set    []jsonField
+}
+
+type jsonField struct {
+	path  []string
+	value interface{}
+}
+
+func newJsonMapping(name string, config json.RawMessage) (*jsonMapping, error) {
+	var conf jsonMappingConfig
+	if err := unmarshalConfig(name, config, &conf); err != nil {
+		return nil, err
+	}
+	m := &jsonMapping{}
+	// the fields are handled in the order of the paths, so the result doesn't depend on the order of the map
+	for _, from := range sortedKeys(conf.Rename) {
+		m.rename = append(m.rename, [2][]string{splitPath(from), splitPath(conf.Rename[from])})
+	}
+	for _, path := range conf.Remove {
+		m.remove = append(m.remove, splitPath(path))
+	}
+	paths := make([]string, 0, len(conf.Set))
+	for path := range conf.Set {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		value, err := decodeJson(conf.Set[path])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of field %s: %v", path, err)
+		}
+		m.set = append(m.set, jsonField{path: splitPath(path), value: value})
+	}
+	return m, nil
+}
+
+// transform maps the fields of the json object in data. Empty data is returned as it is.
+func (m *jsonMapping) transform(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	value, err := decodeJson(data)
+	if err != nil {
+		return nil, common.Errorf(common.InvalidArgsCode, "invalid json data: %v", err)
+	}
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, common.Error(common.InvalidArgsCode, "json mapping only supports json objects")
+	}
+	for _, r := range m.rename {
+		if v, ok := getField(obj, r[0]); ok {
+			deleteField(obj, r[0])
+			if err := setField(obj, r[1], v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, path := range m.remove {
+		deleteField(obj, path)
+	}
+	for _, f := range m.set {
+		if err := setField(obj, f.path, f.value); err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(obj); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// jsonMappingBeforeFactory is BeforeFactory implement, which maps the fields of the request data
+type jsonMappingBeforeFactory struct {
+	mapping *jsonMapping
+}
+
+func (j *jsonMappingBeforeFactory) Name() string {
+	return "json_mapping"
+}
+
+func (j *jsonMappingBeforeFactory) Init(config json.RawMessage) (err error) {
+	j.mapping, err = newJsonMapping(j.Name(), config)
+	return err
+}
+
+// DependsOnData is true since the request data is rewritten
+func (j *jsonMappingBeforeFactory) DependsOnData() bool {
+	return true
+}
+
+func (j *jsonMappingBeforeFactory) Create() func(*rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	mapping := j.mapping
+	return func(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+		data, err := mapping.transform(request.Data)
+		if err != nil {
+			return nil, err
+		}
+		request.Data = data
+		return request, nil
+	}
+}
+
+// jsonMappingAfterFactory is AfterFactory implement, which maps the fields of the response data
+type jsonMappingAfterFactory struct {
+	mapping *jsonMapping
+}
+
+func (j *jsonMappingAfterFactory) Name() string {
+	return "json_mapping"
+}
+
+func (j *jsonMappingAfterFactory) Init(config json.RawMessage) (err error) {
+	j.mapping, err = newJsonMapping(j.Name(), config)
+	return err
+}
+
+func (j *jsonMappingAfterFactory) Create() func(*rpc.RPCResponse) (*rpc.RPCResponse, error) {
+	mapping := j.mapping
+	return func(response *rpc.RPCResponse) (*rpc.RPCResponse, error) {
+		data, err := mapping.transform(response.Data)
+		if err != nil {
+			return nil, err
+		}
+		response.Data = data
+		return response, nil
+	}
+}
+
+// decodeJson keeps the numbers as they are, so the big integers don't lose precision
+func decodeJson(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func splitPath(path string) []string {
+	return strings.Split(path, ".")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func getField(obj map[string]interface{}, path []string) (interface{}, bool) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := obj[key].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		obj = child
+	}
+	v, ok := obj[path[len(path)-1]]
+	return v, ok
+}
+
+func deleteField(obj map[string]interface{}, path []string) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := obj[key].(map[string]interface{})
+		if !ok {
+			return
+		}
+		obj = child
+	}
+	delete(obj, path[len(path)-1])
+}
+
+func setField(obj map[string]interface{}, path []string, value interface{}) error {
+	for i, key := range path[:len(path)-1] {
+		child, ok := obj[key]
+		if !ok {
+			child = map[string]interface{}{}
+			obj[key] = child
+		}
+		childObj, ok := child.(map[string]interface{})
+		if !ok {
+			return common.Errorf(common.InvalidArgsCode, "field %s is not a json object", strings.Join(path[:i+1], "."))
+		}
+		obj = childObj
+	}
+	obj[path[len(path)-1]] = value
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+func Test_jsonMapping(t *testing.T) {
+	m, err := newJsonMapping("json_mapping", json.RawMessage(`{
+		"rename": {"userName": "user.name", "user.age": "age"},
+		"remove": ["password"],
+		"set": {"user.source": "layotto", "version": 2}
+	}`))
+	assert.Nil(t, err)
+
+	data, err := m.transform([]byte(`{"userName": "tom", "user": {"age": 18}, "password": "<secret>", "id": 12345678901234567890}`))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"user": {"name": "tom", "source": "layotto"}, "age": 18, "version": 2, "id": 12345678901234567890}`, string(data))
+	// the big integers and the html characters are kept as they are
+	assert.Contains(t, string(data), `12345678901234567890`)
+
+	data, err = m.transform(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, data)
+
+	_, err = m.transform([]byte(`[1, 2]`))
+	assert.Equal(t, common.InvalidArgsCode, err.(common.CommonError).Code())
+	_, err = m.transform([]byte(`{"user": "tom"}`))
+	assert.Equal(t, "field user is not a json object", err.(common.CommonError).Msg())
+
+	_, err = newJsonMapping("json_mapping", json.RawMessage(`{"set": {"a": tom}}`))
+	assert.NotNil(t, err)
+}
+
+func Test_jsonMappingFactory(t *testing.T) {
+	before := &jsonMappingBeforeFactory{}
+	assert.Equal(t, "json_mapping", before.Name())
+	assert.Nil(t, before.Init(json.RawMessage(`{"rename": {"name": "userName"}}`)))
+	req, err := before.Create()(&rpc.RPCRequest{Data: []byte(`{"name": "<tom>"}`)})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"userName":"<tom>"}`, string(req.Data))
+
+	after := &jsonMappingAfterFactory{}
+	assert.Equal(t, "json_mapping", after.Name())
+	assert.Nil(t, after.Init(json.RawMessage(`{"remove": ["internal"]}`)))
+	resp, err := after.Create()(&rpc.RPCResponse{Data: []byte(`{"name": "tom", "internal": true}`)})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"tom"}`, string(resp.Data))
+	_, err = after.Create()(&rpc.RPCResponse{Data: []byte(`{`)})
+	assert.Equal(t, common.InvalidArgsCode, err.(common.CommonError).Code())
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dapr/components-contrib/secretstores"
+
+	"mosn.io/layotto/components/ref"
+)
+
+var (
+	secretStoresMu sync.RWMutex
+	// secretStores are the secret stores of the runtime, which keep the keys of the filters
+	secretStores map[string]secretstores.SecretStore
+)
+
+// SetSecretStores is called by the runtime before the rpc components are initialized,
+// so that the filters can read their keys from the secret stores
+func SetSecretStores(stores map[string]secretstores.SecretStore) {
+	secretStoresMu.Lock()
+	defer secretStoresMu.Unlock()
+	secretStores = stores
+}
+
+// getSecret reads the secret referred by the config, whose sub key is the key by default
+func getSecret(config *ref.SecretRefConfig) (string, error) {
+	secretStoresMu.RLock()
+	store, ok := secretStores[config.StoreName]
+	secretStoresMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("secret store %s not found", config.StoreName)
+	}
+	resp, err := store.GetSecret(context.Background(), secretstores.GetSecretRequest{Name: config.Key})
+	if err != nil {
+		return "", err
+	}
+	subKey := config.SubKey
+	if subKey == "" {
+		subKey = config.Key
+	}
+	secret, ok := resp.Data[subKey]
+	if !ok {
+		return "", fmt.Errorf("secret %s/%s not found in secret store %s", config.Key, subKey, config.StoreName)
+	}
+	return secret, nil
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"time"
+
+	"mosn.io/layotto/components/ref"
+	"mosn.io/layotto/components/rpc"
+)
+
+// signing types of the sign filter
+const (
+	SignBearer = "bearer"
+	SignHmac   = "hmac"
+)
+
+func init() {
+	RegisterBeforeInvoke(&signFactory{})
+}
+
+// signNow is the signing time of hmac, which is replaced in tests
+var signNow = time.Now
+
+// signConfig is the config of the sign filter, whose key is read from the secret store
+type signConfig struct {
+	// Type is bearer or hmac
+	Type   string               `json:"type"`
+	Secret *ref.SecretRefConfig `json:"secret"`
+	// Header is where the token or the signature is set, which is authorization for bearer and x-signature for hmac by default
+	Header string `json:"header"`
+	// Algorithm is the hash of hmac: sha256 (default) or sha512
+	Algorithm string `json:"algorithm"`
+	// TimestampHeader is where the signing time of hmac is set in unix seconds, x-timestamp by default
+	TimestampHeader string `json:"timestamp_header"`
+}
+
+// signFactory is BeforeFactory implement, which signs the requests with a bearer token
+// or a hmac signature of "<id>\n<method>\n<timestamp>\n<data>" in hex
+type signFactory struct {
+	config signConfig
+	key    string
+	hash   func() hash.Hash
+}
+
+func (s *signFactory) Name() string {
+	return "sign"
+}
+
+func (s *signFactory) Init(config json.RawMessage) error {
+	var conf signConfig
+	if err := unmarshalConfig(s.Name(), config, &conf); err != nil {
+		return err
+	}
+	if conf.Secret == nil {
+		return errors.New("missing secret of rpc filter sign")
+	}
+	switch conf.Type {
+	case SignBearer:
+		if conf.Header == "" {
+			conf.Header = "authorization"
+		}
+	case SignHmac:
+		if conf.Header == "" {
+			conf.Header = "x-signature"
+		}
+		if conf.TimestampHeader == "" {
+			conf.TimestampHeader = "x-timestamp"
+		}
+		switch conf.Algorithm {
+		case "", "sha256":
+			s.hash = sha256.New
+		case "sha512":
+			s.hash = sha512.New
+		default:
+			return fmt.Errorf("hmac algorithm %s not supported", conf.Algorithm)
+		}
+	default:
+		return fmt.Errorf("sign type %s not supported", conf.Type)
+	}
+	key, err := getSecret(conf.Secret)
+	if err != nil {
+		return err
+	}
+	s.config = conf
+	s.key = key
+	return nil
+}
+
+// DependsOnData reports whether the request data is signed, which is true for the hmac signing
+func (s *signFactory) DependsOnData() bool {
+	return s.config.Type == SignHmac
+}
+
+// Create sets the token or the signature to the request header
+func (s *signFactory) Create() func(*rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	config, key, newHash := s.config, s.key, s.hash
+	return func(request *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+		if request.Header == nil {
+			request.Header = rpc.RPCHeader{}
+		}
+		if config.Type == SignBearer {
+			request.Header[config.Header] = []string{"Bearer " + key}
+			return request, nil
+		}
+		timestamp := strconv.FormatInt(signNow().Unix(), 10)
+		mac := hmac.New(newHash, []byte(key))
+		mac.Write([]byte(request.Id + "\n" + request.Method + "\n" + timestamp + "\n"))
+		mac.Write(request.Data)
+		request.Header[config.TimestampHeader] = []string{timestamp}
+		request.Header[config.Header] = []string{hex.EncodeToString(mac.Sum(nil))}
+		return request, nil
+	}
+}
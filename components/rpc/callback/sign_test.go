@@ -0,0 +1,103 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+// fakeSecretStore keeps the secrets in memory
+type fakeSecretStore struct {
+	secretstores.SecretStore
+	secrets map[string]map[string]string
+}
+
+func (f *fakeSecretStore) GetSecret(ctx context.Context, req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	data, ok := f.secrets[req.Name]
+	if !ok {
+		return secretstores.GetSecretResponse{}, errors.New("secret not found")
+	}
+	return secretstores.GetSecretResponse{Data: data}, nil
+}
+
+func setTestSecretStores() {
+	SetSecretStores(map[string]secretstores.SecretStore{
+		"local": &fakeSecretStore{secrets: map[string]map[string]string{
+			"rpc-token": {"rpc-token": "token1"},
+			"rpc-keys":  {"hmac": "key1"},
+		}},
+	})
+}
+
+func Test_signFactory_Init(t *testing.T) {
+	setTestSecretStores()
+	defer SetSecretStores(nil)
+	s := &signFactory{}
+	assert.Equal(t, "sign", s.Name())
+
+	assert.Equal(t, "missing secret of rpc filter sign", s.Init(json.RawMessage(`{"type": "bearer"}`)).Error())
+	err := s.Init(json.RawMessage(`{"type": "basic", "secret": {"store_name": "local", "key": "rpc-token"}}`))
+	assert.Equal(t, "sign type basic not supported", err.Error())
+	err = s.Init(json.RawMessage(`{"type": "hmac", "algorithm": "md5", "secret": {"store_name": "local", "key": "rpc-keys"}}`))
+	assert.Equal(t, "hmac algorithm md5 not supported", err.Error())
+	err = s.Init(json.RawMessage(`{"type": "bearer", "secret": {"store_name": "vault", "key": "rpc-token"}}`))
+	assert.Equal(t, "secret store vault not found", err.Error())
+	err = s.Init(json.RawMessage(`{"type": "hmac", "secret": {"store_name": "local", "key": "rpc-keys"}}`))
+	assert.Equal(t, "secret rpc-keys/rpc-keys not found in secret store local", err.Error())
+}
+
+func Test_signFactory_Create(t *testing.T) {
+	setTestSecretStores()
+	defer SetSecretStores(nil)
+
+	t.Run("bearer", func(t *testing.T) {
+		s := &signFactory{}
+		assert.Nil(t, s.Init(json.RawMessage(`{"type": "bearer", "secret": {"store_name": "local", "key": "rpc-token"}}`)))
+		req, err := s.Create()(&rpc.RPCRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, "Bearer token1", req.Header.Get("authorization"))
+	})
+
+	t.Run("hmac", func(t *testing.T) {
+		signNow = func() time.Time {
+			return time.Unix(1000, 0)
+		}
+		defer func() {
+			signNow = time.Now
+		}()
+		s := &signFactory{}
+		assert.Nil(t, s.Init(json.RawMessage(`{"type": "hmac", "header": "x-sign", "secret": {"store_name": "local", "key": "rpc-keys", "sub_key": "hmac"}}`)))
+		req, err := s.Create()(&rpc.RPCRequest{Id: "HelloService", Method: "/hello", Data: []byte("hello"), Header: rpc.RPCHeader{}})
+		assert.Nil(t, err)
+		mac := hmac.New(sha256.New, []byte("key1"))
+		mac.Write([]byte("HelloService\n/hello\n1000\nhello"))
+		assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), req.Header.Get("x-sign"))
+		assert.Equal(t, "1000", req.Header.Get("x-timestamp"))
+	})
+}
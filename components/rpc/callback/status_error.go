@@ -0,0 +1,224 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+// errClassCodes maps the error classes to the error codes, and the classes are the same as the classes of the rpc policies
+var errClassCodes = map[string]int{
+	"timeout":          common.TimeoutCode,
+	"unavailable":      common.UnavailebleCode,
+	"internal":         common.InternalCode,
+	"invalid_argument": common.InvalidArgsCode,
+}
+
+func init() {
+	RegisterAfterInvoke(&statusErrorFactory{})
+}
+
+// statusErrorConfig is the config of the status_error filter.
+// The statuses in Success and Errors are either exact statuses, e.g. 404, or patterns where x matches any digit, e.g. 5xx.
+type statusErrorConfig struct {
+	// Header is the response header of the status
+	Header string `json:"header"`
+	// Field is the path of the status in the json response data, e.g. result.code, which is used when Header is empty
+	Field string `json:"field"`
+	// Success are the statuses of the successful responses. If it's empty, the statuses not in Errors are successful.
+	Success []string `json:"success"`
+	// Errors maps the statuses to the error classes: timeout, unavailable, internal or invalid_argument
+	Errors map[string]string `json:"errors"`
+	// Default is the error class of the statuses neither in Success nor in Errors, and it's internal by default
+	Default string `json:"default"`
+	// MessageHeader is the response header of the error message
+	MessageHeader string `json:"message_header"`
+	// MessageField is the path of the error message in the json response data
+	MessageField string `json:"message_field"`
+}
+
+type statusRule struct {
+	pattern string
+	code    int
+}
+
+// match returns true if the status is the pattern, or matches the pattern digit by digit
+func (r *statusRule) match(status string) bool {
+	if len(r.pattern) != len(status) {
+		return false
+	}
+	for i := 0; i < len(status); i++ {
+		p := r.pattern[i]
+		if (p == 'x' || p == 'X') && status[i] >= '0' && status[i] <= '9' {
+			continue
+		}
+		if p != status[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// statusError maps the status of the response to an error
+type statusError struct {
+	header        string
+	field         []string
+	success       []*statusRule
+	errors        []*statusRule
+	defaultCode   int
+	messageHeader string
+	messageField  []string
+}
+
+func newStatusError(name string, config json.RawMessage) (*statusError, error) {
+	var conf statusErrorConfig
+	if err := unmarshalConfig(name, config, &conf); err != nil {
+		return nil, err
+	}
+	if conf.Header == "" && conf.Field == "" {
+		return nil, fmt.Errorf("missing header or field of rpc filter %s", name)
+	}
+	s := &statusError{header: conf.Header, messageHeader: conf.MessageHeader}
+	if conf.Field != "" {
+		s.field = splitPath(conf.Field)
+	}
+	if conf.MessageField != "" {
+		s.messageField = splitPath(conf.MessageField)
+	}
+	for _, pattern := range conf.Success {
+		s.success = append(s.success, &statusRule{pattern: pattern})
+	}
+	for _, pattern := range sortedKeys(conf.Errors) {
+		code, ok := errClassCodes[conf.Errors[pattern]]
+		if !ok {
+			return nil, fmt.Errorf("unknown error class %s of status %s", conf.Errors[pattern], pattern)
+		}
+		s.errors = append(s.errors, &statusRule{pattern: pattern, code: code})
+	}
+	// the exact statuses are matched before the patterns, and the patterns with fewer wildcards go first
+	sort.SliceStable(s.errors, func(i, j int) bool {
+		return wildcards(s.errors[i].pattern) < wildcards(s.errors[j].pattern)
+	})
+	s.defaultCode = common.InternalCode
+	if conf.Default != "" {
+		code, ok := errClassCodes[conf.Default]
+		if !ok {
+			return nil, fmt.Errorf("unknown default error class %s", conf.Default)
+		}
+		s.defaultCode = code
+	}
+	return s, nil
+}
+
+func wildcards(pattern string) int {
+	return strings.Count(strings.ToLower(pattern), "x")
+}
+
+// check returns the error of the response, or nil if the response is successful.
+// The response without a status is successful.
+func (s *statusError) check(response *rpc.RPCResponse) error {
+	var data map[string]interface{}
+	if len(s.field) > 0 || len(s.messageField) > 0 {
+		// the data which isn't a json object has no status or message
+		if value, err := decodeJson(response.Data); err == nil {
+			data, _ = value.(map[string]interface{})
+		}
+	}
+	status, ok := s.lookup(response.Header, s.header, data, s.field)
+	if !ok {
+		return nil
+	}
+	code, failed := s.classify(status)
+	if !failed {
+		return nil
+	}
+	if msg, ok := s.lookup(response.Header, s.messageHeader, data, s.messageField); ok && msg != "" {
+		return common.Errorf(code, "status %s: %s", status, msg)
+	}
+	return common.Errorf(code, "status %s", status)
+}
+
+// lookup reads the value from the header, or from the field of the json data if the header isn't configured
+func (s *statusError) lookup(header rpc.RPCHeader, key string, data map[string]interface{}, field []string) (string, bool) {
+	if key != "" {
+		return lookupHeader(header, key)
+	}
+	if len(field) == 0 || data == nil {
+		return "", false
+	}
+	value, ok := getField(data, field)
+	if !ok || value == nil {
+		return "", false
+	}
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+func (s *statusError) classify(status string) (int, bool) {
+	for _, rule := range s.errors {
+		if rule.match(status) {
+			return rule.code, true
+		}
+	}
+	if len(s.success) == 0 {
+		return 0, false
+	}
+	for _, rule := range s.success {
+		if rule.match(status) {
+			return 0, false
+		}
+	}
+	return s.defaultCode, true
+}
+
+// statusErrorFactory is AfterFactory implement, which maps the status of the response to an error,
+// so the application gets the error instead of the response data.
+// The filters run after the rpc policies, so the errors aren't retried and aren't counted by the circuit breakers.
+type statusErrorFactory struct {
+	status *statusError
+}
+
+func (s *statusErrorFactory) Name() string {
+	return "status_error"
+}
+
+func (s *statusErrorFactory) Init(config json.RawMessage) (err error) {
+	s.status, err = newStatusError(s.Name(), config)
+	return err
+}
+
+func (s *statusErrorFactory) Create() func(*rpc.RPCResponse) (*rpc.RPCResponse, error) {
+	status := s.status
+	return func(response *rpc.RPCResponse) (*rpc.RPCResponse, error) {
+		if err := status.check(response); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
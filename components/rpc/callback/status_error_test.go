@@ -0,0 +1,108 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package callback
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+func Test_newStatusError(t *testing.T) {
+	_, err := newStatusError("status_error", nil)
+	assert.Equal(t, "missing header or field of rpc filter status_error", err.Error())
+	_, err = newStatusError("status_error", json.RawMessage(`{"header": "x-status", "errors": {"5xx": "refused"}}`))
+	assert.Equal(t, "unknown error class refused of status 5xx", err.Error())
+	_, err = newStatusError("status_error", json.RawMessage(`{"header": "x-status", "default": "refused"}`))
+	assert.Equal(t, "unknown default error class refused", err.Error())
+}
+
+func Test_statusErrorFactory_Header(t *testing.T) {
+	s := &statusErrorFactory{}
+	assert.Equal(t, "status_error", s.Name())
+	assert.Nil(t, s.Init(json.RawMessage(`{
+		"header": "x-status",
+		"success": ["2xx"],
+		"errors": {"5xx": "unavailable", "504": "timeout", "4xx": "invalid_argument"},
+		"message_header": "x-message"
+	}`)))
+	f := s.Create()
+	check := func(status string, header rpc.RPCHeader) error {
+		if header == nil {
+			header = rpc.RPCHeader{}
+		}
+		header["X-Status"] = []string{status}
+		_, err := f(&rpc.RPCResponse{Header: header, Data: []byte("data")})
+		return err
+	}
+
+	assert.Nil(t, check("200", nil))
+	assert.Nil(t, check("204", nil))
+	// the response without a status is successful
+	resp, err := f(&rpc.RPCResponse{Data: []byte("data")})
+	assert.Nil(t, err)
+	assert.Equal(t, "data", string(resp.Data))
+
+	err = check("503", rpc.RPCHeader{"x-message": []string{"overloaded"}})
+	assert.Equal(t, common.UnavailebleCode, err.(common.CommonError).Code())
+	assert.Equal(t, "status 503: overloaded", err.(common.CommonError).Msg())
+	// the exact status goes before the pattern
+	err = check("504", nil)
+	assert.Equal(t, common.TimeoutCode, err.(common.CommonError).Code())
+	assert.Equal(t, "status 504", err.(common.CommonError).Msg())
+	err = check("404", nil)
+	assert.Equal(t, common.InvalidArgsCode, err.(common.CommonError).Code())
+	// the status neither successful nor mapped is the default class
+	err = check("302", nil)
+	assert.Equal(t, common.InternalCode, err.(common.CommonError).Code())
+	err = check("5000", nil)
+	assert.Equal(t, common.InternalCode, err.(common.CommonError).Code())
+}
+
+func Test_statusErrorFactory_Field(t *testing.T) {
+	s := &statusErrorFactory{}
+	assert.Nil(t, s.Init(json.RawMessage(`{
+		"field": "result.code",
+		"errors": {"1xxx": "invalid_argument"},
+		"default": "unavailable",
+		"message_field": "result.msg"
+	}`)))
+	f := s.Create()
+
+	// without success statuses, the statuses not in errors are successful
+	_, err := f(&rpc.RPCResponse{Data: []byte(`{"result": {"code": 0}}`)})
+	assert.Nil(t, err)
+	_, err = f(&rpc.RPCResponse{Data: []byte(`{"result": {"code": 2001}}`)})
+	assert.Nil(t, err)
+	_, err = f(&rpc.RPCResponse{Data: []byte(`[1]`)})
+	assert.Nil(t, err)
+
+	_, err = f(&rpc.RPCResponse{Data: []byte(`{"result": {"code": 1001, "msg": "missing name"}}`)})
+	assert.Equal(t, common.InvalidArgsCode, err.(common.CommonError).Code())
+	assert.Equal(t, "status 1001: missing name", err.(common.CommonError).Msg())
+	_, err = f(&rpc.RPCResponse{Data: []byte(`{"result": {"code": "1002"}}`)})
+	assert.Equal(t, "status 1002", err.(common.CommonError).Msg())
+
+	assert.Nil(t, s.Init(json.RawMessage(`{"field": "ok", "success": ["true"]}`)))
+	_, err = s.Create()(&rpc.RPCResponse{Data: []byte(`{"ok": false}`)})
+	assert.Equal(t, common.InternalCode, err.(common.CommonError).Code())
+	assert.Equal(t, "status false", err.(common.CommonError).Msg())
+}
@@ -0,0 +1,308 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"mosn.io/pkg/log"
+
+	common "mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/rpc/invoker/mosn/channel"
+)
+
+// load balance strategies to pick a channel for each request
+const (
+	RoundRobin   = "round_robin"
+	Weighted     = "weighted"
+	LeastRequest = "least_request"
+)
+
+const (
+	defaultConsecutiveTimeouts = 5
+	defaultBaseEjectionTimeMs  = 30000
+	defaultMaxEjectionPercent  = 50
+	// the ejection time grows with the times a channel is ejected, up to maxEjectionMultiplier * base ejection time
+	maxEjectionMultiplier = 10
+)
+
+// OutlierDetection ejects a channel for a while when it keeps timing out,
+// so that the requests are sent to the other channels
+type OutlierDetection struct {
+	// ConsecutiveTimeouts is the number of timeouts in a row before a channel is ejected
+	ConsecutiveTimeouts int `json:"consecutive_timeouts"`
+	// BaseEjectionTimeMs is the ejection time of the first ejection, which is multiplied by the times the channel has been ejected
+	BaseEjectionTimeMs int `json:"base_ejection_time_ms"`
+	// MaxEjectionPercent is the max percent of the channels ejected at the same time. One channel can be ejected at least
+	MaxEjectionPercent int `json:"max_ejection_percent"`
+}
+
+func (o *OutlierDetection) withDefaults() *OutlierDetection {
+	result := *o
+	if result.ConsecutiveTimeouts <= 0 {
+		result.ConsecutiveTimeouts = defaultConsecutiveTimeouts
+	}
+	if result.BaseEjectionTimeMs <= 0 {
+		result.BaseEjectionTimeMs = defaultBaseEjectionTimeMs
+	}
+	if result.MaxEjectionPercent <= 0 || result.MaxEjectionPercent > 100 {
+		result.MaxEjectionPercent = defaultMaxEjectionPercent
+	}
+	return &result
+}
+
+// endpoint is a channel with its stats, which are updated atomically
+type endpoint struct {
+	config  channel.ChannelConfig
+	channel rpc.Channel
+	weight  int64
+
+	outstanding         int64
+	requests            uint64
+	failures            uint64
+	timeouts            uint64
+	consecutiveTimeouts int64
+	ejections           uint64
+	// ejectedUntil is the unix nano time when the ejection ends
+	ejectedUntil int64
+
+	// currentWeight is used by the smooth weighted round robin, guarded by balancer.mu
+	currentWeight int64
+}
+
+func (e *endpoint) ejected(now int64) bool {
+	return atomic.LoadInt64(&e.ejectedUntil) > now
+}
+
+func (e *endpoint) stats(now int64) rpc.ChannelStats {
+	return rpc.ChannelStats{
+		Protocol:    e.config.Protocol,
+		Listener:    e.config.Listener,
+		Weight:      int(e.weight),
+		Outstanding: atomic.LoadInt64(&e.outstanding),
+		Requests:    atomic.LoadUint64(&e.requests),
+		Failures:    atomic.LoadUint64(&e.failures),
+		Timeouts:    atomic.LoadUint64(&e.timeouts),
+		Ejected:     e.ejected(now),
+		Ejections:   atomic.LoadUint64(&e.ejections),
+	}
+}
+
+// balancer spreads the requests over the channels, and skips the channels ejected by the outlier detection.
+// If all the channels are ejected, it picks from all of them rather than failing the requests.
+type balancer struct {
+	endpoints []*endpoint
+	strategy  string
+	outlier   *OutlierDetection
+	next      uint64
+	mu        sync.Mutex
+	now       func() time.Time
+}
+
+func newBalancer(strategy string, outlier *OutlierDetection, configs []channel.ChannelConfig) (*balancer, error) {
+	switch strategy {
+	case "":
+		strategy = RoundRobin
+	case RoundRobin, Weighted, LeastRequest:
+	default:
+		return nil, fmt.Errorf("load balance %s not supported", strategy)
+	}
+	b := &balancer{strategy: strategy, now: time.Now}
+	if outlier != nil {
+		b.outlier = outlier.withDefaults()
+	}
+	for _, config := range configs {
+		if config.Weight < 0 {
+			return nil, fmt.Errorf("invalid weight %d of channel %s", config.Weight, config.Listener)
+		}
+		ch, err := channel.GetChannel(config)
+		if err != nil {
+			return nil, err
+		}
+		weight := int64(config.Weight)
+		if weight == 0 {
+			weight = 1
+		}
+		b.endpoints = append(b.endpoints, &endpoint{config: config, channel: ch, weight: weight})
+	}
+	return b, nil
+}
+
+// Do sends the request over the channel picked, and records the result to the stats of the channel
+func (b *balancer) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	e := b.pick()
+	atomic.AddUint64(&e.requests, 1)
+	atomic.AddInt64(&e.outstanding, 1)
+	resp, err := e.channel.Do(req)
+	atomic.AddInt64(&e.outstanding, -1)
+	b.record(e, err)
+	return resp, err
+}
+
+// DoStream starts a stream over the channel picked, which must be a rpc.StreamChannel.
+// The channel is outstanding and the result is recorded until the response header is received,
+// because the stream may last long after that.
+func (b *balancer) DoStream(req *rpc.RPCStreamRequest) (*rpc.RPCStreamResponse, error) {
+	e := b.pick()
+	sc, ok := e.channel.(rpc.StreamChannel)
+	if !ok {
+		return nil, common.Errorf(common.InternalCode, "channel %s/%s doesn't support streaming", e.config.Protocol, e.config.Listener)
+	}
+	atomic.AddUint64(&e.requests, 1)
+	atomic.AddInt64(&e.outstanding, 1)
+	resp, err := sc.DoStream(req)
+	atomic.AddInt64(&e.outstanding, -1)
+	b.record(e, err)
+	return resp, err
+}
+
+func (b *balancer) pick() *endpoint {
+	candidates := b.available()
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	switch b.strategy {
+	case Weighted:
+		return b.pickWeighted(candidates)
+	case LeastRequest:
+		return b.pickLeastRequest(candidates)
+	default:
+		return candidates[(atomic.AddUint64(&b.next, 1)-1)%uint64(len(candidates))]
+	}
+}
+
+func (b *balancer) available() []*endpoint {
+	if b.outlier == nil {
+		return b.endpoints
+	}
+	now := b.now().UnixNano()
+	candidates := make([]*endpoint, 0, len(b.endpoints))
+	for _, e := range b.endpoints {
+		if !e.ejected(now) {
+			candidates = append(candidates, e)
+		}
+	}
+	if len(candidates) == 0 {
+		return b.endpoints
+	}
+	return candidates
+}
+
+// pickWeighted is the smooth weighted round robin of nginx,
+// which spreads the requests of a heavy channel evenly instead of sending them in a burst
+func (b *balancer) pickWeighted(candidates []*endpoint) *endpoint {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var best *endpoint
+	var total int64
+	for _, e := range candidates {
+		e.currentWeight += e.weight
+		total += e.weight
+		if best == nil || e.currentWeight > best.currentWeight {
+			best = e
+		}
+	}
+	best.currentWeight -= total
+	return best
+}
+
+// pickLeastRequest picks the channel with the least outstanding requests,
+// and starts the scan from a rotating position so that the idle channels are used in turn
+func (b *balancer) pickLeastRequest(candidates []*endpoint) *endpoint {
+	start := int((atomic.AddUint64(&b.next, 1) - 1) % uint64(len(candidates)))
+	best := candidates[start]
+	least := atomic.LoadInt64(&best.outstanding)
+	for i := 1; i < len(candidates); i++ {
+		e := candidates[(start+i)%len(candidates)]
+		if n := atomic.LoadInt64(&e.outstanding); n < least {
+			best, least = e, n
+		}
+	}
+	return best
+}
+
+func (b *balancer) record(e *endpoint, err error) {
+	if err == nil {
+		atomic.StoreInt64(&e.consecutiveTimeouts, 0)
+		return
+	}
+	atomic.AddUint64(&e.failures, 1)
+	if !isTimeout(err) {
+		atomic.StoreInt64(&e.consecutiveTimeouts, 0)
+		return
+	}
+	atomic.AddUint64(&e.timeouts, 1)
+	n := atomic.AddInt64(&e.consecutiveTimeouts, 1)
+	if b.outlier != nil && n >= int64(b.outlier.ConsecutiveTimeouts) {
+		b.eject(e)
+	}
+}
+
+func (b *balancer) eject(e *endpoint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now().UnixNano()
+	if e.ejected(now) {
+		return
+	}
+	ejected := 0
+	for _, other := range b.endpoints {
+		if other.ejected(now) {
+			ejected++
+		}
+	}
+	max := len(b.endpoints) * b.outlier.MaxEjectionPercent / 100
+	if max < 1 {
+		max = 1
+	}
+	if ejected >= max {
+		return
+	}
+	times := atomic.AddUint64(&e.ejections, 1)
+	if times > maxEjectionMultiplier {
+		times = maxEjectionMultiplier
+	}
+	duration := time.Duration(b.outlier.BaseEjectionTimeMs) * time.Millisecond * time.Duration(times)
+	atomic.StoreInt64(&e.ejectedUntil, now+int64(duration))
+	atomic.StoreInt64(&e.consecutiveTimeouts, 0)
+	log.DefaultLogger.Warnf("[runtime][rpc]channel %s/%s is ejected for %v after %d consecutive timeouts",
+		e.config.Protocol, e.config.Listener, duration, b.outlier.ConsecutiveTimeouts)
+}
+
+// ChannelStats returns the stats of all the channels in the order of the config
+func (b *balancer) ChannelStats() []rpc.ChannelStats {
+	now := b.now().UnixNano()
+	stats := make([]rpc.ChannelStats, 0, len(b.endpoints))
+	for _, e := range b.endpoints {
+		stats = append(stats, e.stats(now))
+	}
+	return stats
+}
+
+func isTimeout(err error) bool {
+	if err == channel.ErrTimeout {
+		return true
+	}
+	if e, ok := err.(common.CommonError); ok {
+		return e.Code() == common.TimeoutCode
+	}
+	return false
+}
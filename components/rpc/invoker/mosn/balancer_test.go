@@ -0,0 +1,181 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	common "mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/components/rpc/invoker/mosn/channel"
+)
+
+// listenerChannel answers with its listener name, or fails with err if it's set
+type listenerChannel struct {
+	listener string
+	err      error
+}
+
+func (c *listenerChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &rpc.RPCResponse{Data: []byte(c.listener)}, nil
+}
+
+func newTestBalancer(t *testing.T, strategy string, outlier *OutlierDetection, configs ...channel.ChannelConfig) *balancer {
+	channel.RegistChannel("listener", func(config channel.ChannelConfig) (rpc.Channel, error) {
+		return &listenerChannel{listener: config.Listener}, nil
+	})
+	b, err := newBalancer(strategy, outlier, configs)
+	assert.Nil(t, err)
+	return b
+}
+
+func pickListeners(b *balancer, n int) []string {
+	result := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		resp, _ := b.Do(&rpc.RPCRequest{})
+		result = append(result, string(resp.Data))
+	}
+	return result
+}
+
+func TestNewBalancer(t *testing.T) {
+	_, err := newBalancer("random", nil, []channel.ChannelConfig{{Protocol: "listener"}})
+	assert.Equal(t, "load balance random not supported", err.Error())
+
+	_, err = newBalancer(Weighted, nil, []channel.ChannelConfig{{Protocol: "listener", Listener: "a", Weight: -1}})
+	assert.Equal(t, "invalid weight -1 of channel a", err.Error())
+
+	b := newTestBalancer(t, "", &OutlierDetection{ConsecutiveTimeouts: 2},
+		channel.ChannelConfig{Protocol: "listener", Listener: "a"})
+	assert.Equal(t, RoundRobin, b.strategy)
+	assert.Equal(t, 2, b.outlier.ConsecutiveTimeouts)
+	assert.Equal(t, defaultBaseEjectionTimeMs, b.outlier.BaseEjectionTimeMs)
+	assert.Equal(t, defaultMaxEjectionPercent, b.outlier.MaxEjectionPercent)
+	assert.Equal(t, 1, b.ChannelStats()[0].Weight)
+}
+
+func TestBalancer_Pick(t *testing.T) {
+	t.Run("round robin", func(t *testing.T) {
+		b := newTestBalancer(t, RoundRobin, nil,
+			channel.ChannelConfig{Protocol: "listener", Listener: "a"},
+			channel.ChannelConfig{Protocol: "listener", Listener: "b"},
+			channel.ChannelConfig{Protocol: "listener", Listener: "c"})
+		assert.Equal(t, []string{"a", "b", "c", "a", "b", "c"}, pickListeners(b, 6))
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		b := newTestBalancer(t, Weighted, nil,
+			channel.ChannelConfig{Protocol: "listener", Listener: "a", Weight: 5},
+			channel.ChannelConfig{Protocol: "listener", Listener: "b"},
+			channel.ChannelConfig{Protocol: "listener", Listener: "c"})
+		assert.Equal(t, []string{"a", "a", "b", "a", "c", "a", "a"}, pickListeners(b, 7))
+	})
+
+	t.Run("least request", func(t *testing.T) {
+		b := newTestBalancer(t, LeastRequest, nil,
+			channel.ChannelConfig{Protocol: "listener", Listener: "a"},
+			channel.ChannelConfig{Protocol: "listener", Listener: "b"},
+			channel.ChannelConfig{Protocol: "listener", Listener: "c"})
+		b.endpoints[0].outstanding = 3
+		b.endpoints[2].outstanding = 1
+		assert.Equal(t, []string{"b", "b", "b"}, pickListeners(b, 3))
+		b.endpoints[1].outstanding = 2
+		assert.Equal(t, []string{"c"}, pickListeners(b, 1))
+	})
+}
+
+func TestBalancer_OutlierDetection(t *testing.T) {
+	b := newTestBalancer(t, RoundRobin, &OutlierDetection{ConsecutiveTimeouts: 2, BaseEjectionTimeMs: 1000},
+		channel.ChannelConfig{Protocol: "listener", Listener: "a"},
+		channel.ChannelConfig{Protocol: "listener", Listener: "b"})
+	now := time.Unix(1000, 0)
+	b.now = func() time.Time {
+		return now
+	}
+	timeout := common.Error(common.TimeoutCode, channel.ErrTimeout.Error())
+	a := b.endpoints[0].channel.(*listenerChannel)
+
+	// other errors and successes break the consecutive timeouts
+	a.err = timeout
+	b.Do(&rpc.RPCRequest{})
+	a.err = errors.New("unavailable")
+	b.Do(&rpc.RPCRequest{})
+	b.Do(&rpc.RPCRequest{})
+	b.Do(&rpc.RPCRequest{})
+	stats := b.ChannelStats()
+	assert.Equal(t, uint64(2), stats[0].Requests)
+	assert.Equal(t, uint64(2), stats[0].Failures)
+	assert.Equal(t, uint64(1), stats[0].Timeouts)
+	assert.False(t, stats[0].Ejected)
+
+	a.err = timeout
+	b.Do(&rpc.RPCRequest{})
+	b.Do(&rpc.RPCRequest{})
+	b.Do(&rpc.RPCRequest{})
+	b.Do(&rpc.RPCRequest{})
+	stats = b.ChannelStats()
+	assert.True(t, stats[0].Ejected)
+	assert.Equal(t, uint64(1), stats[0].Ejections)
+	// all the requests go to b during the ejection
+	assert.Equal(t, []string{"b", "b", "b"}, pickListeners(b, 3))
+
+	// b can't be ejected, because at most half of the channels are ejected
+	b.endpoints[1].channel.(*listenerChannel).err = timeout
+	for i := 0; i < 4; i++ {
+		b.Do(&rpc.RPCRequest{})
+	}
+	assert.False(t, b.ChannelStats()[1].Ejected)
+
+	// the ejection ends after the ejection time
+	b.endpoints[1].channel.(*listenerChannel).err = nil
+	now = now.Add(time.Second)
+	a.err = nil
+	assert.ElementsMatch(t, []string{"a", "b"}, pickListeners(b, 2))
+	stats = b.ChannelStats()
+	assert.False(t, stats[0].Ejected)
+
+	// the ejection time grows with the ejections
+	a.err = timeout
+	for i := 0; i < 4; i++ {
+		b.Do(&rpc.RPCRequest{})
+	}
+	assert.Equal(t, uint64(2), b.ChannelStats()[0].Ejections)
+	now = now.Add(time.Second)
+	assert.True(t, b.ChannelStats()[0].Ejected)
+	now = now.Add(time.Second)
+	assert.False(t, b.ChannelStats()[0].Ejected)
+}
+
+func TestBalancer_AllEjected(t *testing.T) {
+	b := newTestBalancer(t, RoundRobin, &OutlierDetection{ConsecutiveTimeouts: 1, MaxEjectionPercent: 100},
+		channel.ChannelConfig{Protocol: "listener", Listener: "a"})
+	b.endpoints[0].channel.(*listenerChannel).err = channel.ErrTimeout
+	_, err := b.Do(&rpc.RPCRequest{})
+	assert.Equal(t, channel.ErrTimeout, err)
+	assert.True(t, b.ChannelStats()[0].Ejected)
+	// the ejected channel is still used when there is no other channel
+	_, err = b.Do(&rpc.RPCRequest{})
+	assert.Equal(t, channel.ErrTimeout, err)
+	assert.Equal(t, uint64(2), b.ChannelStats()[0].Requests)
+}
@@ -46,10 +46,12 @@ var (
 
 // ChannelConfig is Channel config
 type ChannelConfig struct {
-	Protocol string                 `json:"protocol"`
-	Listener string                 `json:"listener"`
-	Size     int                    `json:"size"`
-	Ext      map[string]interface{} `json:"ext"`
+	Protocol string `json:"protocol"`
+	Listener string `json:"listener"`
+	Size     int    `json:"size"`
+	// Weight is used by the weighted load balance of the invoker, and defaults to 1
+	Weight int                    `json:"weight"`
+	Ext    map[string]interface{} `json:"ext"`
 }
 
 // GetChannel creates a rpc.Channel according to config.Protocol
@@ -0,0 +1,328 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	// bridge to mosn
+	_ "mosn.io/mosn/pkg/stream/http2"
+	"mosn.io/pkg/log"
+	"mosn.io/pkg/utils"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+const (
+	// grpcServiceKey in ChannelConfig.Ext is the service of the methods without a service name, e.g. helloworld.Greeter
+	grpcServiceKey = "service"
+	// grpcAuthorityKey in ChannelConfig.Ext overrides the :authority header, which is the listener name by default
+	grpcAuthorityKey = "authority"
+)
+
+// init is regist grpc channel
+func init() {
+	RegistChannel("grpc", newGrpcChannel)
+}
+
+// rawCodec sends the request data as it is, and receives the response data without decoding,
+// so the protobuf messages are encoded and decoded by the callers.
+// It's named proto so that the content type is application/grpc+proto.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	switch data := v.(type) {
+	case []byte:
+		return data, nil
+	case *[]byte:
+		return *data, nil
+	default:
+		return nil, fmt.Errorf("raw codec can't marshal %T", v)
+	}
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	p, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("raw codec can't unmarshal to %T", v)
+	}
+	*p = append([]byte(nil), data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// grpcChannel is Channel implement, which makes unary gRPC calls with raw protobuf bytes
+type grpcChannel struct {
+	conns   []*grpc.ClientConn
+	next    uint64
+	service string
+}
+
+// newGrpcChannel is used to create rpc.Channel according to ChannelConfig
+func newGrpcChannel(config ChannelConfig) (rpc.Channel, error) {
+	gc := &grpcChannel{}
+	gc.service, _ = config.Ext[grpcServiceKey].(string)
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(rawCodec{})),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			_, _, err := net.SplitHostPort(config.Listener)
+			if err == nil {
+				var d net.Dialer
+				return d.DialContext(ctx, "tcp", config.Listener)
+			}
+			local, remote := net.Pipe()
+			if err := acceptFunc(&fakeTcpConn{c: remote}, config.Listener); err != nil {
+				local.Close()
+				remote.Close()
+				return nil, err
+			}
+			return &fakeTcpConn{c: local}, nil
+		}),
+	}
+	if authority, ok := config.Ext[grpcAuthorityKey].(string); ok && authority != "" {
+		opts = append(opts, grpc.WithAuthority(authority))
+	}
+	size := config.Size
+	if size <= 0 {
+		size = 1
+	}
+	for i := 0; i < size; i++ {
+		// the connections are established lazily by grpc
+		conn, err := grpc.Dial("passthrough:///"+config.Listener, opts...)
+		if err != nil {
+			gc.close()
+			return nil, err
+		}
+		gc.conns = append(gc.conns, conn)
+	}
+	return gc, nil
+}
+
+// Do is used to handle RPCRequest and return RPCResponse
+func (g *grpcChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	method, err := g.fullMethod(req.Method)
+	if err != nil {
+		return nil, err
+	}
+	// 1. context.WithTimeout, which is sent as the grpc-timeout header
+	timeout := time.Duration(req.Timeout) * time.Millisecond
+	ctx, cancel := context.WithTimeout(req.Ctx, timeout)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, toMetadata(req.Header))
+
+	// 2. invoke with one of the connections
+	conn := g.pick()
+	var header, trailer metadata.MD
+	var data []byte
+	err = conn.Invoke(ctx, method, req.Data, &data, grpc.Header(&header), grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, fromGrpcError(err)
+	}
+
+	// 3. convert result to rpc.RPCResponse
+	resp := &rpc.RPCResponse{
+		ContentType: "application/grpc",
+		Data:        data,
+		Header:      map[string][]string{},
+	}
+	for k, v := range header {
+		resp.Header[k] = v
+	}
+	for k, v := range trailer {
+		resp.Header[k] = append(resp.Header[k], v...)
+	}
+	if ct := header.Get("content-type"); len(ct) > 0 {
+		resp.ContentType = ct[0]
+	}
+	return resp, nil
+}
+
+// DoStream makes a bidi-streaming call, which serves the client-, server- and bidi-streaming methods alike.
+// Every chunk of the request body is sent as a message, and every message received is a chunk of the response body,
+// so the chunks must be whole protobuf messages. The trailers of the call are not returned.
+// The flow control of http2 propagates the back-pressure of the upstream to the caller.
+func (g *grpcChannel) DoStream(req *rpc.RPCStreamRequest) (*rpc.RPCStreamResponse, error) {
+	method, err := g.fullMethod(req.Method)
+	if err != nil {
+		return nil, err
+	}
+	// 1. the call is cancelled when the response body is closed
+	ctx, cancel := context.WithCancel(req.Ctx)
+	ctx = metadata.NewOutgoingContext(ctx, toMetadata(req.Header))
+	stream, err := g.pick().NewStream(ctx, &grpc.StreamDesc{ClientStreams: true, ServerStreams: true}, method)
+	if err != nil {
+		cancel()
+		return nil, fromGrpcError(err)
+	}
+
+	// 2. send the request messages in another goroutine, so the response can be received before the request ends
+	utils.GoWithRecover(func() {
+		if err := sendStream(stream, req.Body); err != nil {
+			log.DefaultLogger.Errorf("[runtime][rpc]send grpc stream error: %s", err.Error())
+			cancel()
+		}
+	}, nil)
+
+	// 3. wait for the response header until timeout
+	timer := time.AfterFunc(time.Duration(req.Timeout)*time.Millisecond, cancel)
+	header, err := stream.Header()
+	if !timer.Stop() {
+		cancel()
+		return nil, common.Error(common.TimeoutCode, ErrTimeout.Error())
+	}
+	if err != nil {
+		cancel()
+		return nil, fromGrpcError(err)
+	}
+
+	// 4. convert result to rpc.RPCStreamResponse
+	resp := &rpc.RPCStreamResponse{
+		ContentType: "application/grpc",
+		Header:      map[string][]string{},
+		Body:        &grpcStreamBody{stream: stream, cancel: cancel},
+	}
+	for k, v := range header {
+		resp.Header[k] = v
+	}
+	if ct := header.Get("content-type"); len(ct) > 0 {
+		resp.ContentType = ct[0]
+	}
+	return resp, nil
+}
+
+// sendStream sends every chunk of the body as a message, and closes the sending side at the end of the body
+func sendStream(stream grpc.ClientStream, body rpc.ChunkReader) error {
+	for {
+		chunk, err := body.Recv()
+		if err == io.EOF {
+			return stream.CloseSend()
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.SendMsg(chunk); err != nil {
+			// io.EOF means the call is over, and its status is returned by the receiving side
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// grpcStreamBody receives the response messages of a streaming call
+type grpcStreamBody struct {
+	stream grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+func (b *grpcStreamBody) Recv() ([]byte, error) {
+	var data []byte
+	if err := b.stream.RecvMsg(&data); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fromGrpcError(err)
+	}
+	return data, nil
+}
+
+func (b *grpcStreamBody) Close() error {
+	b.cancel()
+	return nil
+}
+
+func (g *grpcChannel) pick() *grpc.ClientConn {
+	return g.conns[(atomic.AddUint64(&g.next, 1)-1)%uint64(len(g.conns))]
+}
+
+// fullMethod maps the method to the full gRPC method name, /package.Service/Method.
+// The method can be a full method name, a method name without the leading slash, or a method of the configured service.
+func (g *grpcChannel) fullMethod(method string) (string, error) {
+	if strings.HasPrefix(method, "/") {
+		return method, nil
+	}
+	if strings.Contains(method, "/") {
+		return "/" + method, nil
+	}
+	if g.service != "" && method != "" {
+		return "/" + g.service + "/" + method, nil
+	}
+	return "", common.Errorf(common.InvalidArgsCode, "invalid grpc method %s, which should be /package.Service/Method", method)
+}
+
+func (g *grpcChannel) close() {
+	for _, conn := range g.conns {
+		conn.Close()
+	}
+}
+
+// toMetadata converts the header to the grpc metadata,
+// without the headers used by the runtime and the headers reserved by http2 or grpc
+func toMetadata(header rpc.RPCHeader) metadata.MD {
+	md := metadata.MD{}
+	for k, v := range header {
+		key := strings.ToLower(k)
+		if isReservedHeader(key) {
+			continue
+		}
+		md[key] = append(md[key], v...)
+	}
+	return md
+}
+
+func isReservedHeader(key string) bool {
+	if strings.HasPrefix(key, ":") || strings.HasPrefix(key, "grpc-") {
+		return true
+	}
+	switch key {
+	case rpc.RequestTimeoutMs, rpc.TargetAddress, "content-type", "user-agent", "te", "content-length", "connection", "host":
+		return true
+	default:
+		return false
+	}
+}
+
+func fromGrpcError(err error) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.DeadlineExceeded:
+		return common.Error(common.TimeoutCode, ErrTimeout.Error())
+	case codes.Unavailable:
+		return common.Error(common.UnavailebleCode, st.Message())
+	case codes.InvalidArgument:
+		return common.Error(common.InvalidArgsCode, st.Message())
+	default:
+		return common.Errorf(common.InternalCode, "grpc status %s: %s", st.Code(), st.Message())
+	}
+}
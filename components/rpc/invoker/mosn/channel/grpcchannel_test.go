@@ -0,0 +1,297 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+// echoServiceDesc is a service which answers "<value> world" to a StringValue,
+// with a unary method test.Echo/Say and a bidi-streaming method test.Echo/Chat
+var echoServiceDesc = grpc.ServiceDesc{
+	ServiceName: "test.Echo",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Say",
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := &wrapperspb.StringValue{}
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			md, _ := metadata.FromIncomingContext(ctx)
+			if len(md.Get(rpc.RequestTimeoutMs)) > 0 {
+				return nil, status.Error(codes.Internal, "runtime header leaked")
+			}
+			grpc.SetHeader(ctx, metadata.Pairs("x-echo", strings.Join(md.Get("x-token"), ",")))
+			grpc.SetTrailer(ctx, metadata.Pairs("x-trailer", "done"))
+			switch in.Value {
+			case "timeout":
+				<-ctx.Done()
+				return nil, ctx.Err()
+			case "invalid":
+				return nil, status.Error(codes.InvalidArgument, "invalid value")
+			case "missing":
+				return nil, status.Error(codes.NotFound, "value not found")
+			}
+			return wrapperspb.String(in.Value + " world"), nil
+		},
+	}},
+	Streams: []grpc.StreamDesc{{
+		StreamName:    "Chat",
+		ServerStreams: true,
+		ClientStreams: true,
+		Handler: func(srv interface{}, stream grpc.ServerStream) error {
+			md, _ := metadata.FromIncomingContext(stream.Context())
+			if err := stream.SendHeader(metadata.Pairs("x-echo", strings.Join(md.Get("x-token"), ","))); err != nil {
+				return err
+			}
+			for {
+				in := &wrapperspb.StringValue{}
+				if err := stream.RecvMsg(in); err == io.EOF {
+					return nil
+				} else if err != nil {
+					return err
+				}
+				if in.Value == "invalid" {
+					return status.Error(codes.InvalidArgument, "invalid value")
+				}
+				if err := stream.SendMsg(wrapperspb.String(in.Value + " world")); err != nil {
+					return err
+				}
+			}
+		},
+	}},
+}
+
+// testGrpcServer serves the connections accepted by acceptFunc, as mosn does
+type testGrpcServer struct {
+	server *grpc.Server
+	conns  chan net.Conn
+	done   chan struct{}
+}
+
+func (ts *testGrpcServer) Accept() (net.Conn, error) {
+	select {
+	case conn := <-ts.conns:
+		return conn, nil
+	case <-ts.done:
+		return nil, errors.New("listener closed")
+	}
+}
+
+func (ts *testGrpcServer) Close() error {
+	return nil
+}
+
+func (ts *testGrpcServer) Addr() net.Addr {
+	return &net.TCPAddr{}
+}
+
+func (ts *testGrpcServer) accept(conn net.Conn, listener string) error {
+	ts.conns <- conn
+	return nil
+}
+
+func (ts *testGrpcServer) stop() {
+	close(ts.done)
+	ts.server.Stop()
+}
+
+func startTestGrpcServer() *testGrpcServer {
+	ts := &testGrpcServer{
+		server: grpc.NewServer(),
+		conns:  make(chan net.Conn, 16),
+		done:   make(chan struct{}),
+	}
+	ts.server.RegisterService(&echoServiceDesc, struct{}{})
+	go ts.server.Serve(ts)
+	acceptFunc = ts.accept
+	return ts
+}
+
+func newEchoRequest(value string) *rpc.RPCRequest {
+	data, _ := protov2.Marshal(wrapperspb.String(value))
+	return &rpc.RPCRequest{
+		Ctx:     context.TODO(),
+		Id:      "foo",
+		Method:  "Say",
+		Data:    data,
+		Timeout: 1000,
+		Header: rpc.RPCHeader{
+			"x-token":            []string{"abc"},
+			rpc.RequestTimeoutMs: []string{"1000"},
+			"content-type":       []string{"application/grpc"},
+			":authority":         []string{"layotto"},
+		},
+	}
+}
+
+func decodeEcho(t *testing.T, resp *rpc.RPCResponse) string {
+	out := &wrapperspb.StringValue{}
+	assert.Nil(t, protov2.Unmarshal(resp.Data, out))
+	return out.Value
+}
+
+func TestGrpcChannel(t *testing.T) {
+	ts := startTestGrpcServer()
+	defer ts.stop()
+
+	channel, err := newGrpcChannel(ChannelConfig{Size: 2, Listener: "egress_runtime_grpc", Ext: map[string]interface{}{"service": "test.Echo"}})
+	assert.Nil(t, err)
+	defer channel.(*grpcChannel).close()
+
+	t.Run("success", func(t *testing.T) {
+		for _, method := range []string{"Say", "test.Echo/Say", "/test.Echo/Say"} {
+			req := newEchoRequest("hello")
+			req.Method = method
+			resp, err := channel.Do(req)
+			assert.Nil(t, err)
+			assert.Equal(t, "hello world", decodeEcho(t, resp))
+			assert.Equal(t, "application/grpc+proto", resp.ContentType)
+			assert.Equal(t, []string{"abc"}, resp.Header["x-echo"])
+			assert.Equal(t, []string{"done"}, resp.Header["x-trailer"])
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		req := newEchoRequest("timeout")
+		req.Timeout = 100
+		_, err := channel.Do(req)
+		assert.Equal(t, common.TimeoutCode, err.(common.CommonError).Code())
+		assert.Equal(t, ErrTimeout.Error(), err.(common.CommonError).Msg())
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		_, err := channel.Do(newEchoRequest("invalid"))
+		assert.Equal(t, common.InvalidArgsCode, err.(common.CommonError).Code())
+		assert.Equal(t, "invalid value", err.(common.CommonError).Msg())
+
+		_, err = channel.Do(newEchoRequest("missing"))
+		assert.Equal(t, common.InternalCode, err.(common.CommonError).Code())
+		assert.Equal(t, "grpc status NotFound: value not found", err.(common.CommonError).Msg())
+
+		req := newEchoRequest("hello")
+		req.Method = "/test.Echo/Unknown"
+		_, err = channel.Do(req)
+		assert.Equal(t, common.InternalCode, err.(common.CommonError).Code())
+	})
+
+	t.Run("invalid method", func(t *testing.T) {
+		noService, err := newGrpcChannel(ChannelConfig{Listener: "egress_runtime_grpc"})
+		assert.Nil(t, err)
+		defer noService.(*grpcChannel).close()
+		_, err = noService.Do(newEchoRequest("hello"))
+		assert.Equal(t, common.InvalidArgsCode, err.(common.CommonError).Code())
+	})
+}
+
+func TestGrpcChannel_TcpListener(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer()
+	server.RegisterService(&echoServiceDesc, struct{}{})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	channel, err := newGrpcChannel(ChannelConfig{Listener: lis.Addr().String()})
+	assert.Nil(t, err)
+	defer channel.(*grpcChannel).close()
+	req := newEchoRequest("hello")
+	req.Method = "/test.Echo/Say"
+	resp, err := channel.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", decodeEcho(t, resp))
+}
+
+func TestGrpcChannel_Unavailable(t *testing.T) {
+	acceptFunc = func(conn net.Conn, listener string) error {
+		return common.Error(common.InternalCode, "[rpc]invalid listener name")
+	}
+	channel, err := newGrpcChannel(ChannelConfig{Listener: "egress_runtime_grpc"})
+	assert.Nil(t, err)
+	defer channel.(*grpcChannel).close()
+	req := newEchoRequest("hello")
+	req.Method = "/test.Echo/Say"
+	req.Timeout = 1000
+	_, err = channel.Do(req)
+	assert.Equal(t, common.UnavailebleCode, err.(common.CommonError).Code())
+}
+
+func TestGrpcChannel_DoStream(t *testing.T) {
+	ts := startTestGrpcServer()
+	defer ts.stop()
+
+	channel, err := newGrpcChannel(ChannelConfig{Listener: "egress_runtime_grpc", Ext: map[string]interface{}{"service": "test.Echo"}})
+	assert.Nil(t, err)
+	defer channel.(*grpcChannel).close()
+	sc := channel.(rpc.StreamChannel)
+	newStreamReq := func(body chunks) *rpc.RPCStreamRequest {
+		return &rpc.RPCStreamRequest{
+			RPCRequest: rpc.RPCRequest{Ctx: context.TODO(), Id: "foo", Method: "Chat", Timeout: 1000, Header: rpc.RPCHeader{"x-token": []string{"abc"}}},
+			Body:       body,
+		}
+	}
+	marshal := func(value string) []byte {
+		data, _ := protov2.Marshal(wrapperspb.String(value))
+		return data
+	}
+
+	t.Run("bidi", func(t *testing.T) {
+		body := make(chunks)
+		resp, err := sc.DoStream(newStreamReq(body))
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, "application/grpc+proto", resp.ContentType)
+		assert.Equal(t, []string{"abc"}, resp.Header["x-echo"])
+		for _, value := range []string{"hello", "bye"} {
+			body <- marshal(value)
+			chunk, err := resp.Body.Recv()
+			assert.Nil(t, err)
+			assert.Equal(t, value+" world", decodeEcho(t, &rpc.RPCResponse{Data: chunk}))
+		}
+		close(body)
+		_, err = resp.Body.Recv()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		body := make(chunks, 1)
+		body <- marshal("invalid")
+		resp, err := sc.DoStream(newStreamReq(body))
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+		_, err = resp.Body.Recv()
+		assert.Equal(t, common.InvalidArgsCode, err.(common.CommonError).Code())
+		close(body)
+	})
+}
@@ -19,11 +19,18 @@ package channel
 import (
 	"bufio"
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"net/http/httputil"
+	"strings"
 	"time"
 
 	"mosn.io/pkg/buffer"
+	"mosn.io/pkg/log"
+	"mosn.io/pkg/utils"
 
 	"github.com/valyala/fasthttp"
 	// bridge to mosn
@@ -33,6 +40,13 @@ import (
 	"mosn.io/layotto/components/rpc"
 )
 
+const (
+	// streamChunkSize is the max size of the response chunks read from the upstream
+	streamChunkSize = 32 * 1024
+	// maxErrorBodySize is the max size of the response body in the error of a failed stream
+	maxErrorBodySize = 4 * 1024
+)
+
 // init is regist http channel
 func init() {
 	RegistChannel("http", newHttpChannel)
@@ -62,34 +76,17 @@ func (h *hstate) close() {
 
 // httpChannel is Channel implement
 type httpChannel struct {
-	pool *connPool
+	listener string
+	pool     *connPool
 }
 
 // newHttpChannel is used to create rpc.Channel according to ChannelConfig
 func newHttpChannel(config ChannelConfig) (rpc.Channel, error) {
-	hc := &httpChannel{}
+	hc := &httpChannel{listener: config.Listener}
 	hc.pool = newConnPool(
 		config.Size,
 		// dialFunc
-		func() (net.Conn, error) {
-			_, _, err := net.SplitHostPort(config.Listener)
-			if err == nil {
-				return net.Dial("tcp", config.Listener)
-			}
-			local, remote := net.Pipe()
-			localTcpConn := &fakeTcpConn{c: local}
-			remoteTcpConn := &fakeTcpConn{c: remote}
-			if err := acceptFunc(remoteTcpConn, config.Listener); err != nil {
-				return nil, err
-			}
-			// the goroutine model is:
-			// request goroutine --->  localTcpConn ---> 	mosn
-			//		^											|
-			//		|											|
-			//		|											|
-			// 		hstate(net.Pipe) <-- readloop goroutine <---
-			return localTcpConn, nil
-		},
+		hc.dial,
 		// stateFunc
 		func() interface{} {
 			// hstate is a pipe for readloop goroutine to communicate with request goroutine
@@ -103,6 +100,27 @@ func newHttpChannel(config ChannelConfig) (rpc.Channel, error) {
 	return hc, nil
 }
 
+// dial connects to the listener, which is a tcp address or the name of a mosn listener
+func (h *httpChannel) dial() (net.Conn, error) {
+	_, _, err := net.SplitHostPort(h.listener)
+	if err == nil {
+		return net.Dial("tcp", h.listener)
+	}
+	local, remote := net.Pipe()
+	localTcpConn := &fakeTcpConn{c: local}
+	remoteTcpConn := &fakeTcpConn{c: remote}
+	if err := acceptFunc(remoteTcpConn, h.listener); err != nil {
+		return nil, err
+	}
+	// the goroutine model is:
+	// request goroutine --->  localTcpConn ---> 	mosn
+	//		^											|
+	//		|											|
+	//		|											|
+	// 		hstate(net.Pipe) <-- readloop goroutine <---
+	return localTcpConn, nil
+}
+
 // Do is used to handle RPCRequest and return RPCResponse
 func (h *httpChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 	// 1. context.WithTimeout
@@ -149,7 +167,7 @@ func (h *httpChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 	// 6. convert result to rpc.RPCResponse,which is the response of rpc invoker
 	body := httpResp.Body()
 	if httpResp.StatusCode() != http.StatusOK {
-		return nil, common.Errorf(common.UnavailebleCode, "http response code %d, body: %s", httpResp.StatusCode(), string(body))
+		return nil, common.StatusErrorf(httpResp.StatusCode(), "http response code %d, body: %s", httpResp.StatusCode(), string(body))
 	}
 
 	rpcResp := &rpc.RPCResponse{
@@ -187,6 +205,152 @@ func (h *httpChannel) constructReq(req *rpc.RPCRequest) *fasthttp.Request {
 	return httpReq
 }
 
+// DoStream sends the request data with the chunked transfer encoding, and streams the response data back.
+// Every stream has a connection of its own rather than a pooled one, because it may last long.
+// The data is read from the request and the response lazily, so the back-pressure of the upstream
+// connection propagates to the caller.
+func (h *httpChannel) DoStream(req *rpc.RPCStreamRequest) (*rpc.RPCStreamResponse, error) {
+	conn, err := h.dial()
+	if err != nil {
+		return nil, common.Error(common.UnavailebleCode, err.Error())
+	}
+	// 1. close the connection once the stream is over, which stops the blocked reads and writes
+	ctx, cancel := context.WithCancel(req.Ctx)
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	// 2. write the request in another goroutine, so the response can be read before the request ends
+	utils.GoWithRecover(func() {
+		if err := h.writeStreamReq(conn, req); err != nil {
+			log.DefaultLogger.Errorf("[runtime][rpc]write stream request error: %s", err.Error())
+			cancel()
+		}
+	}, nil)
+
+	// 3. wait for the response header until timeout
+	timeout := time.Duration(req.Timeout) * time.Millisecond
+	conn.SetReadDeadline(time.Now().Add(timeout))
+	httpResp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	if err != nil {
+		cancel()
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return nil, common.Error(common.TimeoutCode, ErrTimeout.Error())
+		}
+		return nil, common.Error(common.UnavailebleCode, err.Error())
+	}
+	conn.SetReadDeadline(time.Time{})
+	if httpResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(httpResp.Body, maxErrorBodySize))
+		cancel()
+		return nil, common.StatusErrorf(httpResp.StatusCode, "http response code %d, body: %s", httpResp.StatusCode, string(body))
+	}
+
+	// 4. convert result to rpc.RPCStreamResponse
+	resp := &rpc.RPCStreamResponse{
+		ContentType: httpResp.Header.Get("Content-Type"),
+		Header:      map[string][]string{},
+		Body:        &httpStreamBody{body: httpResp.Body, cancel: cancel},
+	}
+	for k, v := range httpResp.Header {
+		// the hop-by-hop headers of http1 are invalid in the grpc response
+		if k == "Connection" || k == "Keep-Alive" {
+			continue
+		}
+		resp.Header[k] = v
+	}
+	return resp, nil
+}
+
+// writeStreamReq writes the request header, and then a chunk for every chunk of the request body.
+// The verb is POST by default, because the request has a body.
+func (h *httpChannel) writeStreamReq(conn net.Conn, req *rpc.RPCStreamRequest) error {
+	method := http.MethodPost
+	uri := req.Method
+	if !strings.HasPrefix(uri, "/") {
+		uri = "/" + uri
+	}
+	header := http.Header{}
+	for k, v := range req.Header {
+		switch strings.ToLower(k) {
+		case "verb":
+			method = strings.Join(v, ",")
+		case "query_string":
+			uri += "?" + strings.Join(v, ",")
+		case "host", "content-length", "transfer-encoding", "connection":
+		default:
+			if !strings.HasPrefix(k, ":") {
+				header.Set(k, strings.Join(v, ","))
+			}
+		}
+	}
+	header.Set("id", req.Id)
+	header.Set("Transfer-Encoding", "chunked")
+
+	w := bufio.NewWriter(conn)
+	fmt.Fprintf(w, "%s %s HTTP/1.1\r\nHost: localhost\r\n", method, uri)
+	header.Write(w)
+	w.WriteString("\r\n")
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	// every chunk is flushed as soon as it's written
+	cw := httputil.NewChunkedWriter(w)
+	for {
+		chunk, err := req.Body.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		// an empty chunk ends the body, so it's skipped
+		if len(chunk) == 0 {
+			continue
+		}
+		if _, err := cw.Write(chunk); err != nil {
+			return err
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	cw.Close()
+	// the chunked writer doesn't write the CRLF after the trailers
+	w.WriteString("\r\n")
+	return w.Flush()
+}
+
+// httpStreamBody reads the chunks of a response body, and closes the connection when it's closed
+type httpStreamBody struct {
+	body   io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *httpStreamBody) Recv() ([]byte, error) {
+	buf := make([]byte, streamChunkSize)
+	for {
+		n, err := b.body.Read(buf)
+		if n > 0 {
+			return buf[:n], nil
+		}
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, common.Error(common.UnavailebleCode, err.Error())
+		}
+	}
+}
+
+func (b *httpStreamBody) Close() error {
+	b.cancel()
+	return nil
+}
+
 func (h *httpChannel) onData(conn *wrapConn) error {
 	hstate := conn.state.(*hstate)
 	return hstate.onData(conn.buf)
@@ -19,8 +19,12 @@ package channel
 import (
 	"bufio"
 	"context"
+	"fmt"
+	"io"
 	"log"
 	"net"
+	"net/http"
+	"net/http/httputil"
 	"strconv"
 	"strings"
 	"sync"
@@ -30,6 +34,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/valyala/fasthttp"
 
+	"mosn.io/layotto/components/pkg/common"
 	"mosn.io/layotto/components/rpc"
 )
 
@@ -51,17 +56,18 @@ func (ts *testhttpServer) readLoop(conn net.Conn) {
 		}
 
 		content := string(req.Body())
+		resp := fasthttp.AcquireResponse()
+		resp.SetBody(req.Body())
 		switch content {
 		case "close":
 			return
 		case "timeout":
 			time.Sleep(2 * time.Second)
+		case "error":
+			resp.SetStatusCode(http.StatusInternalServerError)
 		default:
 		}
 
-		resp := fasthttp.AcquireResponse()
-		resp.SetBody(req.Body())
-
 		if _, err := resp.WriteTo(conn); err != nil {
 			log.Println("test server err:", err.Error())
 			break
@@ -84,6 +90,12 @@ func TestHttpChannel(t *testing.T) {
 	resp, err := channel.Do(req)
 	assert.Nil(t, err)
 	assert.Equal(t, "hello", string(resp.Data))
+
+	// the error status is distinguished from the other unavailable errors, because the request has been executed
+	req = &rpc.RPCRequest{Ctx: context.TODO(), Id: "foo", Method: "bar", Data: []byte("error"), Timeout: 1000}
+	_, err = channel.Do(req)
+	assert.Equal(t, common.UnavailebleCode, err.(common.CommonError).Code())
+	assert.Equal(t, http.StatusInternalServerError, err.(common.StatusError).Status())
 }
 
 func TestRenewHttpConn(t *testing.T) {
@@ -170,3 +182,104 @@ func TestConstructReq(t *testing.T) {
 		assert.Equal(t, "GET /bar HTTP/1.1\r\nHost: localhost\r\nContent-Length: 11\r\nId: foo\r\n\r\nhello world", sb.String())
 	}
 }
+
+// chunks is a ChunkReader of the chunks sent to it, which ends when it's closed
+type chunks chan []byte
+
+func (c chunks) Recv() ([]byte, error) {
+	chunk, ok := <-c
+	if !ok {
+		return nil, io.EOF
+	}
+	return chunk, nil
+}
+
+// streamHttpServer echoes the chunks of the request body as soon as they are received
+func streamHttpServer(conn net.Conn, listener string) error {
+	go func() {
+		defer conn.Close()
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			return
+		}
+		switch req.URL.Path {
+		case "/timeout":
+			io.Copy(io.Discard, req.Body)
+			return
+		case "/missing":
+			fmt.Fprintf(conn, "HTTP/1.1 404 Not Found\r\nContent-Length: 9\r\n\r\nnot found")
+			return
+		}
+		w := bufio.NewWriter(conn)
+		fmt.Fprintf(w, "HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\nTransfer-Encoding: chunked\r\nX-Request: %s %s %s %s\r\n\r\n",
+			req.Method, req.URL.RequestURI(), req.Header.Get("id"), req.Header.Get("x-token"))
+		w.Flush()
+		cw := httputil.NewChunkedWriter(w)
+		buf := make([]byte, 1024)
+		for {
+			n, err := req.Body.Read(buf)
+			if n > 0 {
+				cw.Write(buf[:n])
+				w.Flush()
+			}
+			if err != nil {
+				break
+			}
+		}
+		cw.Close()
+		w.WriteString("\r\n")
+		w.Flush()
+	}()
+	return nil
+}
+
+func TestHttpChannel_DoStream(t *testing.T) {
+	acceptFunc = streamHttpServer
+	channel, err := newHttpChannel(ChannelConfig{Size: 1})
+	assert.Nil(t, err)
+	sc := channel.(rpc.StreamChannel)
+
+	t.Run("bidi", func(t *testing.T) {
+		body := make(chunks)
+		req := &rpc.RPCStreamRequest{
+			RPCRequest: rpc.RPCRequest{
+				Ctx: context.TODO(), Id: "foo", Method: "bar", Timeout: 1000,
+				Header: rpc.RPCHeader{"x-token": []string{"abc"}, "query_string": []string{"a=1"}, ":authority": []string{"layotto"}},
+			},
+			Body: body,
+		}
+		resp, err := sc.DoStream(req)
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, "text/plain", resp.ContentType)
+		assert.Equal(t, "POST /bar?a=1 foo abc", resp.Header.Get("X-Request"))
+		// every chunk is echoed before the next one is sent
+		for _, data := range []string{"hello", "world"} {
+			body <- []byte(data)
+			chunk, err := resp.Body.Recv()
+			assert.Nil(t, err)
+			assert.Equal(t, data, string(chunk))
+		}
+		close(body)
+		_, err = resp.Body.Recv()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		body := make(chunks)
+		defer close(body)
+		req := &rpc.RPCStreamRequest{RPCRequest: rpc.RPCRequest{Ctx: context.TODO(), Id: "foo", Method: "timeout", Timeout: 100}, Body: body}
+		_, err := sc.DoStream(req)
+		assert.Equal(t, common.TimeoutCode, err.(common.CommonError).Code())
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		body := make(chunks)
+		close(body)
+		req := &rpc.RPCStreamRequest{RPCRequest: rpc.RPCRequest{Ctx: context.TODO(), Id: "foo", Method: "missing", Timeout: 1000}, Body: body}
+		_, err := sc.DoStream(req)
+		assert.Equal(t, common.UnavailebleCode, err.(common.CommonError).Code())
+		assert.Equal(t, "http response code 404, body: not found", err.(common.CommonError).Msg())
+		assert.Equal(t, 404, err.(common.StatusError).Status())
+	})
+}
@@ -259,9 +259,9 @@ func TestConncurrent(t *testing.T) {
 	//_, err = channel.Do(req)
 	//assert.Nil(t, err)
 
-	size = 100
-	wg.Add(size)
-	for i := 0; i < size; i++ {
+	echoes := 100
+	wg.Add(echoes)
+	for i := 0; i < echoes; i++ {
 		go func(i int) {
 			defer wg.Done()
 
@@ -290,7 +290,7 @@ func TestReadloopError(t *testing.T) {
 	go func() {
 		time.AfterFunc(100*time.Millisecond, func() {
 			req := &rpc.RPCRequest{Ctx: context.TODO(), Id: "foo", Method: "bar", Data: []byte("close"), Timeout: 500}
-			_, err = channel.Do(req)
+			_, err := channel.Do(req)
 			assert.True(t, strings.Contains(err.Error(), "EOF"))
 		})
 	}()
@@ -302,7 +302,7 @@ func TestReadloopError(t *testing.T) {
 		go func() {
 			defer wg.Done()
 			req := &rpc.RPCRequest{Ctx: context.TODO(), Id: "foo", Method: "bar", Data: []byte("timeout"), Timeout: 500}
-			_, err = channel.Do(req)
+			_, err := channel.Do(req)
 			t.Log(err)
 			assert.True(t, strings.Contains(err.Error(), "EOF"))
 		}()
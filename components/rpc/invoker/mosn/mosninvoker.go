@@ -38,8 +38,9 @@ const (
 
 // mosnInvoker is Invoker implement
 type mosnInvoker struct {
-	channel rpc.Channel
-	cb      rpc.Callback
+	channel  *balancer
+	policies policies
+	cb       rpc.Callback
 }
 
 // mosnConfig is mosn config
@@ -47,6 +48,12 @@ type mosnConfig struct {
 	Before  []rpc.CallbackFunc      `json:"before_invoke"`
 	After   []rpc.CallbackFunc      `json:"after_invoke"`
 	Channel []channel.ChannelConfig `json:"channel"`
+	// LoadBalance is how to pick a channel for each request: round_robin (default), weighted or least_request
+	LoadBalance string `json:"load_balance"`
+	// OutlierDetection is disabled if it's not configured
+	OutlierDetection *OutlierDetection `json:"outlier_detection"`
+	// Policies are the retry, deadline budget and circuit breaking policies per target id and method
+	Policies []PolicyConfig `json:"policies"`
 }
 
 // NewMosnInvoker is init mosnInvoker
@@ -74,15 +81,32 @@ func (m *mosnInvoker) Init(conf rpc.RpcConfig) error {
 		return errors.New("missing channel config")
 	}
 
-	// todo support multiple channel
-	channel, err := channel.GetChannel(config.Channel[0])
+	ps, err := newPolicies(config.Policies)
 	if err != nil {
 		return err
 	}
-	m.channel = channel
+	b, err := newBalancer(config.LoadBalance, config.OutlierDetection, config.Channel)
+	if err != nil {
+		return err
+	}
+	m.channel = b
+	m.policies = ps
 	return nil
 }
 
+// ChannelStats returns the stats of the channels, which are shown by the actuator
+func (m *mosnInvoker) ChannelStats() []rpc.ChannelStats {
+	if m.channel == nil {
+		return nil
+	}
+	return m.channel.ChannelStats()
+}
+
+// PolicyStats returns the stats of the resiliency policies, which are shown by the actuator
+func (m *mosnInvoker) PolicyStats() []rpc.PolicyStats {
+	return m.policies.stats()
+}
+
 // Invoke is invoke mosn RPCRequest and Context to RPCResponse
 func (m *mosnInvoker) Invoke(ctx context.Context, req *rpc.RPCRequest) (resp *rpc.RPCResponse, err error) {
 	defer func() {
@@ -93,15 +117,7 @@ func (m *mosnInvoker) Invoke(ctx context.Context, req *rpc.RPCRequest) (resp *rp
 	}()
 
 	// 1. validate request
-	if req.Timeout == 0 {
-		req.Timeout = rpc.DefaultRequestTimeoutMs
-		if ts, ok := req.Header[rpc.RequestTimeoutMs]; ok && len(ts) > 0 {
-			t, err := strconv.ParseInt(ts[0], 10, 32)
-			if err == nil && t != 0 {
-				req.Timeout = int32(t)
-			}
-		}
-	}
+	setTimeout(req)
 	req.Ctx = ctx
 	log.DefaultLogger.Debugf("[runtime][rpc]request %+v", req)
 	// 2. beforeInvoke callback
@@ -110,8 +126,12 @@ func (m *mosnInvoker) Invoke(ctx context.Context, req *rpc.RPCRequest) (resp *rp
 		log.DefaultLogger.Errorf("[runtime][rpc]before filter error %s", err.Error())
 		return nil, err
 	}
-	// 3. do invocation
-	resp, err = m.channel.Do(req)
+	// 3. do invocation, with the resiliency policy matched if any
+	if p := m.policies.match(req.Id, req.Method); p != nil {
+		resp, err = p.invoke(req, m.channel.Do)
+	} else {
+		resp, err = m.channel.Do(req)
+	}
 	if err != nil {
 		log.DefaultLogger.Errorf("[runtime][rpc]error %s", err.Error())
 		return nil, err
@@ -125,3 +145,49 @@ func (m *mosnInvoker) Invoke(ctx context.Context, req *rpc.RPCRequest) (resp *rp
 	}
 	return resp, nil
 }
+
+// InvokeStream starts a stream whose request and response data are transferred in chunks.
+// The beforeInvoke callbacks are applied to the request without the data, and the stream is rejected if any of them
+// depends on the data, e.g. hmac sign, gzip or json_mapping. The afterInvoke callbacks and the resiliency policies
+// are not applied, because the data is never buffered.
+func (m *mosnInvoker) InvokeStream(ctx context.Context, req *rpc.RPCStreamRequest) (resp *rpc.RPCStreamResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("[runtime][rpc]mosn invoker panic: %v", r)
+			log.DefaultLogger.Errorf("%v", err)
+		}
+	}()
+
+	// 1. validate request
+	setTimeout(&req.RPCRequest)
+	req.Ctx = ctx
+	log.DefaultLogger.Debugf("[runtime][rpc]stream request %+v", req.RPCRequest)
+	// 2. beforeInvoke callback
+	r, err := m.cb.BeforeInvokeStream(&req.RPCRequest)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime][rpc]before filter error %s", err.Error())
+		return nil, err
+	}
+	req.RPCRequest = *r
+	// 3. start the stream
+	resp, err = m.channel.DoStream(req)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime][rpc]stream error %s", err.Error())
+		return nil, err
+	}
+	return resp, nil
+}
+
+// setTimeout sets the timeout of the request from the rpc_request_timeout header, if it's not set
+func setTimeout(req *rpc.RPCRequest) {
+	if req.Timeout != 0 {
+		return
+	}
+	req.Timeout = rpc.DefaultRequestTimeoutMs
+	if ts, ok := req.Header[rpc.RequestTimeoutMs]; ok && len(ts) > 0 {
+		t, err := strconv.ParseInt(ts[0], 10, 32)
+		if err == nil && t != 0 {
+			req.Timeout = int32(t)
+		}
+	}
+}
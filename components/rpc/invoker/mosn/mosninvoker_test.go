@@ -18,6 +18,8 @@ package mosn
 
 import (
 	"context"
+	"errors"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -69,6 +71,36 @@ func Test_mosnInvoker_Init(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("multiple channels", func(t *testing.T) {
+		channel.RegistChannel("fake", func(config channel.ChannelConfig) (rpc.Channel, error) {
+			return &fakeChannel{}, nil
+		})
+		invoker := NewMosnInvoker()
+		conf := rpc.RpcConfig{
+			Config: []byte(`{"load_balance": "round", "channel": [{"protocol":"fake"}]}`),
+		}
+		err := invoker.Init(conf)
+		assert.Equal(t, "load balance round not supported", err.Error())
+
+		conf = rpc.RpcConfig{
+			Config: []byte(`{"channel": [{"protocol":"fake"}], "policies": [{"id": "hello", "retry": {"retryable": ["refused"]}}]}`),
+		}
+		err = invoker.Init(conf)
+		assert.Equal(t, "unknown error class refused of rpc policy hello/", err.Error())
+
+		conf = rpc.RpcConfig{
+			Config: []byte(`{"load_balance": "weighted", "outlier_detection": {"consecutive_timeouts": 3},
+				"channel": [{"protocol":"fake", "listener": "a", "weight": 3}, {"protocol":"fake", "listener": "b"}]}`),
+		}
+		err = invoker.Init(conf)
+		assert.Nil(t, err)
+		stats := invoker.(rpc.InspectableInvoker).ChannelStats()
+		assert.Equal(t, 2, len(stats))
+		assert.Equal(t, "a", stats[0].Listener)
+		assert.Equal(t, 3, stats[0].Weight)
+		assert.Equal(t, "b", stats[1].Listener)
+		assert.Equal(t, 1, stats[1].Weight)
+	})
 }
 
 func Test_mosnInvoker_Invoke(t *testing.T) {
@@ -113,6 +145,32 @@ func Test_mosnInvoker_Invoke(t *testing.T) {
 		assert.Equal(t, int32(100000), req.Timeout)
 	})
 
+	t.Run("retry policy", func(t *testing.T) {
+		channel.RegistChannel("flaky", func(config channel.ChannelConfig) (rpc.Channel, error) {
+			return &flakyChannel{}, nil
+		})
+		invoker := NewMosnInvoker()
+		conf := rpc.RpcConfig{
+			Config: []byte(`{"channel": [{"protocol":"flaky"}],
+				"policies": [{"id": "hello", "retry": {"max_attempts": 2, "initial_backoff_ms": 1, "retryable": ["unknown"]}}]}`),
+		}
+		err := invoker.Init(conf)
+		assert.Nil(t, err)
+
+		req := &rpc.RPCRequest{Id: "hello", Method: "Hello", Timeout: 100, Data: []byte("hello")}
+		rsp, err := invoker.Invoke(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, "hello world!", string(rsp.Data))
+		stats := invoker.(rpc.InspectableInvoker).PolicyStats()
+		assert.Equal(t, uint64(2), stats[0].Attempts)
+		assert.Equal(t, uint64(1), stats[0].Retries)
+
+		// there is no policy for the other target ids
+		req = &rpc.RPCRequest{Id: "world", Method: "Hello", Timeout: 100, Data: []byte("hello")}
+		_, err = invoker.Invoke(context.Background(), req)
+		assert.Equal(t, "flaky", err.Error())
+	})
+
 	t.Run("panic", func(t *testing.T) {
 		invoker := NewMosnInvoker()
 
@@ -130,6 +188,61 @@ func Test_mosnInvoker_Invoke(t *testing.T) {
 	})
 }
 
+func Test_mosnInvoker_InvokeStream(t *testing.T) {
+	channel.RegistChannel("stream", func(config channel.ChannelConfig) (rpc.Channel, error) {
+		return &fakeStreamChannel{}, nil
+	})
+	channel.RegistChannel("fake", func(config channel.ChannelConfig) (rpc.Channel, error) {
+		return &fakeChannel{}, nil
+	})
+
+	t.Run("success", func(t *testing.T) {
+		invoker := NewMosnInvoker()
+		err := invoker.Init(rpc.RpcConfig{Config: []byte(`{"channel": [{"protocol":"stream"}]}`)})
+		assert.Nil(t, err)
+
+		req := &rpc.RPCStreamRequest{
+			RPCRequest: rpc.RPCRequest{Id: "1", Method: "Hello", Header: rpc.RPCHeader{rpc.RequestTimeoutMs: []string{"100"}}},
+			Body:       &sliceChunks{chunks: [][]byte{[]byte("hello"), []byte("bye")}},
+		}
+		resp, err := invoker.(rpc.StreamInvoker).InvokeStream(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(100), req.Timeout)
+		assert.NotNil(t, req.Ctx)
+		var result []string
+		for {
+			chunk, err := resp.Body.Recv()
+			if err == io.EOF {
+				break
+			}
+			assert.Nil(t, err)
+			result = append(result, string(chunk))
+		}
+		assert.Equal(t, []string{"hello world!", "bye world!"}, result)
+		assert.Nil(t, resp.Body.Close())
+		assert.Equal(t, uint64(1), invoker.(rpc.InspectableInvoker).ChannelStats()[0].Requests)
+	})
+
+	t.Run("streaming not supported", func(t *testing.T) {
+		invoker := NewMosnInvoker()
+		err := invoker.Init(rpc.RpcConfig{Config: []byte(`{"channel": [{"protocol":"fake", "listener": "mosn"}]}`)})
+		assert.Nil(t, err)
+		req := &rpc.RPCStreamRequest{RPCRequest: rpc.RPCRequest{Id: "1", Method: "Hello"}, Body: &sliceChunks{}}
+		_, err = invoker.(rpc.StreamInvoker).InvokeStream(context.Background(), req)
+		assert.Equal(t, "channel fake/mosn doesn't support streaming", errMsg(err))
+	})
+
+	t.Run("data dependent filter", func(t *testing.T) {
+		invoker := NewMosnInvoker()
+		err := invoker.Init(rpc.RpcConfig{Config: []byte(`{"before_invoke": [{"name": "gzip"}], "channel": [{"protocol":"stream"}]}`)})
+		assert.Nil(t, err)
+		req := &rpc.RPCStreamRequest{RPCRequest: rpc.RPCRequest{Id: "1", Method: "Hello"}, Body: &sliceChunks{}}
+		_, err = invoker.(rpc.StreamInvoker).InvokeStream(context.Background(), req)
+		assert.Equal(t, "rpc filter gzip depends on the request data, which is not supported by the streams", errMsg(err))
+		assert.Equal(t, uint64(0), invoker.(rpc.InspectableInvoker).ChannelStats()[0].Requests)
+	})
+}
+
 type fakeChannel struct {
 }
 
@@ -143,3 +256,59 @@ func (c *fakeChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
 
 	return rsp, nil
 }
+
+// flakyChannel fails every other request
+type flakyChannel struct {
+	fakeChannel
+	requests int
+}
+
+func (c *flakyChannel) Do(req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	c.requests++
+	if c.requests%2 == 1 {
+		return nil, errors.New("flaky")
+	}
+	return c.fakeChannel.Do(req)
+}
+
+// fakeStreamChannel appends " world!" to every chunk of the request
+type fakeStreamChannel struct {
+	fakeChannel
+}
+
+func (c *fakeStreamChannel) DoStream(req *rpc.RPCStreamRequest) (*rpc.RPCStreamResponse, error) {
+	return &rpc.RPCStreamResponse{
+		Header:      req.Header,
+		ContentType: "text/plain",
+		Body:        &worldChunks{req.Body},
+	}, nil
+}
+
+type worldChunks struct {
+	rpc.ChunkReader
+}
+
+func (c *worldChunks) Recv() ([]byte, error) {
+	chunk, err := c.ChunkReader.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return append(chunk, []byte(" world!")...), nil
+}
+
+func (c *worldChunks) Close() error {
+	return nil
+}
+
+type sliceChunks struct {
+	chunks [][]byte
+}
+
+func (c *sliceChunks) Recv() ([]byte, error) {
+	if len(c.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := c.chunks[0]
+	c.chunks = c.chunks[1:]
+	return chunk, nil
+}
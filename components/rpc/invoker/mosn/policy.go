@@ -0,0 +1,404 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"mosn.io/pkg/log"
+
+	common "mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+// error classes of the failed attempts, which are used to decide whether to retry
+const (
+	ErrClassTimeout     = "timeout"
+	ErrClassUnavailable = "unavailable"
+	// ErrClassStatus is an error status responded by the upstream, e.g. the http status other than 200
+	ErrClassStatus          = "status"
+	ErrClassInternal        = "internal"
+	ErrClassInvalidArgument = "invalid_argument"
+	ErrClassUnknown         = "unknown"
+)
+
+// wildcard matches any target id or method in the policies, so does an empty string
+const wildcard = "*"
+
+const (
+	defaultMaxAttempts       = 3
+	defaultInitialBackoffMs  = 100
+	defaultMaxBackoffMs      = 1000
+	defaultBackoffMultiplier = 2
+	defaultConsecutiveErrors = 5
+	defaultOpenMs            = 30000
+)
+
+// PolicyConfig is the resiliency policy of the requests to a target id and a method
+type PolicyConfig struct {
+	Id     string `json:"id"`
+	Method string `json:"method"`
+	// DeadlineMs is the overall deadline of a request, which is shared by all the attempts and the backoffs
+	DeadlineMs     int                   `json:"deadline_ms"`
+	Retry          *RetryConfig          `json:"retry"`
+	CircuitBreaker *CircuitBreakerConfig `json:"circuit_breaker"`
+}
+
+// RetryConfig retries the attempts failed with the retryable error classes, with an exponential backoff between them
+type RetryConfig struct {
+	// MaxAttempts includes the first attempt
+	MaxAttempts       int     `json:"max_attempts"`
+	InitialBackoffMs  int     `json:"initial_backoff_ms"`
+	MaxBackoffMs      int     `json:"max_backoff_ms"`
+	BackoffMultiplier float64 `json:"backoff_multiplier"`
+	// Retryable defaults to unavailable, because a request timed out or responded with an error status may have been executed by the target
+	Retryable []string `json:"retryable"`
+}
+
+// CircuitBreakerConfig fails the requests fast after the attempts to a target id and a method fail consecutively.
+// After OpenMs, one request is let through to probe the target, and the breaker is closed if it succeeds.
+type CircuitBreakerConfig struct {
+	ConsecutiveErrors int `json:"consecutive_errors"`
+	OpenMs            int `json:"open_ms"`
+}
+
+// policies are sorted from the most specific to the least specific, and the first matched one applies
+type policies []*policy
+
+func newPolicies(configs []PolicyConfig) (policies, error) {
+	result := make(policies, 0, len(configs))
+	for _, config := range configs {
+		p, err := newPolicy(config)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].specificity() > result[j].specificity()
+	})
+	return result, nil
+}
+
+func (ps policies) match(id string, method string) *policy {
+	for _, p := range ps {
+		if matches(p.config.Id, id) && matches(p.config.Method, method) {
+			return p
+		}
+	}
+	return nil
+}
+
+func (ps policies) stats() []rpc.PolicyStats {
+	stats := make([]rpc.PolicyStats, 0, len(ps))
+	for _, p := range ps {
+		stats = append(stats, p.stats())
+	}
+	return stats
+}
+
+func matches(pattern string, value string) bool {
+	return pattern == "" || pattern == wildcard || pattern == value
+}
+
+type policy struct {
+	config    PolicyConfig
+	retry     RetryConfig
+	retryable map[string]bool
+	breaker   *CircuitBreakerConfig
+
+	mu sync.Mutex
+	// breakers are kept for each target id and method matched by the policy
+	breakers map[string]*breaker
+
+	calls           uint64
+	attempts        uint64
+	retries         uint64
+	failures        uint64
+	budgetExhausted uint64
+	rejected        uint64
+	breakerOpened   uint64
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newPolicy(config PolicyConfig) (*policy, error) {
+	if config.DeadlineMs < 0 {
+		return nil, fmt.Errorf("invalid deadline_ms %d of rpc policy %s/%s", config.DeadlineMs, config.Id, config.Method)
+	}
+	p := &policy{
+		config:    config,
+		retry:     RetryConfig{MaxAttempts: 1},
+		retryable: map[string]bool{},
+		breakers:  map[string]*breaker{},
+		now:       time.Now,
+		sleep:     sleep,
+	}
+	if config.Retry != nil {
+		p.retry = *config.Retry
+		if p.retry.MaxAttempts <= 0 {
+			p.retry.MaxAttempts = defaultMaxAttempts
+		}
+		if p.retry.InitialBackoffMs <= 0 {
+			p.retry.InitialBackoffMs = defaultInitialBackoffMs
+		}
+		if p.retry.MaxBackoffMs <= 0 {
+			p.retry.MaxBackoffMs = defaultMaxBackoffMs
+		}
+		if p.retry.BackoffMultiplier < 1 {
+			p.retry.BackoffMultiplier = defaultBackoffMultiplier
+		}
+		if len(p.retry.Retryable) == 0 {
+			p.retry.Retryable = []string{ErrClassUnavailable}
+		}
+		for _, class := range p.retry.Retryable {
+			switch class {
+			case ErrClassTimeout, ErrClassUnavailable, ErrClassStatus, ErrClassInternal, ErrClassInvalidArgument, ErrClassUnknown:
+				p.retryable[class] = true
+			default:
+				return nil, fmt.Errorf("unknown error class %s of rpc policy %s/%s", class, config.Id, config.Method)
+			}
+		}
+	}
+	if config.CircuitBreaker != nil {
+		cb := *config.CircuitBreaker
+		if cb.ConsecutiveErrors <= 0 {
+			cb.ConsecutiveErrors = defaultConsecutiveErrors
+		}
+		if cb.OpenMs <= 0 {
+			cb.OpenMs = defaultOpenMs
+		}
+		p.breaker = &cb
+	}
+	return p, nil
+}
+
+func (p *policy) specificity() int {
+	result := 0
+	if !matches(p.config.Id, "") {
+		result += 2
+	}
+	if !matches(p.config.Method, "") {
+		result++
+	}
+	return result
+}
+
+func (p *policy) getBreaker(id string, method string) *breaker {
+	if p.breaker == nil {
+		return nil
+	}
+	key := id + "/" + method
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	b, ok := p.breakers[key]
+	if !ok {
+		b = &breaker{config: p.breaker}
+		p.breakers[key] = b
+	}
+	return b
+}
+
+// invoke makes the attempts with do until one of them succeeds, or the policy gives up
+func (p *policy) invoke(req *rpc.RPCRequest, do func(*rpc.RPCRequest) (*rpc.RPCResponse, error)) (*rpc.RPCResponse, error) {
+	atomic.AddUint64(&p.calls, 1)
+	ctx := req.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// the deadline budget is bounded by the deadline of the caller too
+	var deadline time.Time
+	if p.config.DeadlineMs > 0 {
+		deadline = p.now().Add(time.Duration(p.config.DeadlineMs) * time.Millisecond)
+	}
+	if d, ok := ctx.Deadline(); ok && (deadline.IsZero() || d.Before(deadline)) {
+		deadline = d
+	}
+	cb := p.getBreaker(req.Id, req.Method)
+	backoff := time.Duration(p.retry.InitialBackoffMs) * time.Millisecond
+	var err error
+	for attempt := 1; ; attempt++ {
+		if cb != nil && !cb.allow(p.now()) {
+			atomic.AddUint64(&p.rejected, 1)
+			log.DefaultLogger.Warnf("[runtime][rpc]circuit breaker of %s/%s is open, reject the request", req.Id, req.Method)
+			if err == nil {
+				err = common.Errorf(common.UnavailebleCode, "circuit breaker of %s/%s is open", req.Id, req.Method)
+			}
+			break
+		}
+		attemptReq := cloneRequest(req)
+		if !deadline.IsZero() {
+			remaining := deadline.Sub(p.now()).Milliseconds()
+			if remaining <= 0 {
+				err = p.exhaust(req, attempt, err)
+				break
+			}
+			if remaining < int64(attemptReq.Timeout) {
+				attemptReq.Timeout = int32(remaining)
+			}
+		}
+		atomic.AddUint64(&p.attempts, 1)
+		var resp *rpc.RPCResponse
+		resp, err = do(attemptReq)
+		if cb != nil && cb.record(err == nil, p.now()) {
+			atomic.AddUint64(&p.breakerOpened, 1)
+			log.DefaultLogger.Warnf("[runtime][rpc]circuit breaker of %s/%s is opened for %dms, last error: %v",
+				req.Id, req.Method, p.breaker.OpenMs, err)
+		}
+		if err == nil {
+			return resp, nil
+		}
+		class := errorClass(err)
+		if attempt >= p.retry.MaxAttempts || !p.retryable[class] || ctx.Err() != nil {
+			break
+		}
+		if !deadline.IsZero() && !p.now().Add(backoff).Before(deadline) {
+			err = p.exhaust(req, attempt, err)
+			break
+		}
+		atomic.AddUint64(&p.retries, 1)
+		log.DefaultLogger.Infof("[runtime][rpc]retry %s/%s after %v, attempt %d failed with %s error: %v",
+			req.Id, req.Method, backoff, attempt, class, err)
+		if sleepErr := p.sleep(ctx, backoff); sleepErr != nil {
+			break
+		}
+		backoff = time.Duration(float64(backoff) * p.retry.BackoffMultiplier)
+		if max := time.Duration(p.retry.MaxBackoffMs) * time.Millisecond; backoff > max {
+			backoff = max
+		}
+	}
+	atomic.AddUint64(&p.failures, 1)
+	return nil, err
+}
+
+// exhaust records that there is no time left for the next attempt, and returns the error of the last attempt if any
+func (p *policy) exhaust(req *rpc.RPCRequest, attempt int, err error) error {
+	atomic.AddUint64(&p.budgetExhausted, 1)
+	log.DefaultLogger.Warnf("[runtime][rpc]deadline budget of %s/%s is exhausted after %d attempts", req.Id, req.Method, attempt)
+	if err != nil {
+		return err
+	}
+	return common.Errorf(common.TimeoutCode, "deadline budget of %s/%s is exhausted", req.Id, req.Method)
+}
+
+func (p *policy) stats() rpc.PolicyStats {
+	return rpc.PolicyStats{
+		Id:              p.config.Id,
+		Method:          p.config.Method,
+		Calls:           atomic.LoadUint64(&p.calls),
+		Attempts:        atomic.LoadUint64(&p.attempts),
+		Retries:         atomic.LoadUint64(&p.retries),
+		Failures:        atomic.LoadUint64(&p.failures),
+		BudgetExhausted: atomic.LoadUint64(&p.budgetExhausted),
+		Rejected:        atomic.LoadUint64(&p.rejected),
+		BreakerOpened:   atomic.LoadUint64(&p.breakerOpened),
+	}
+}
+
+// breaker is closed when openUntil is zero, open before openUntil, and half open after it,
+// when only one probe request is let through at a time
+type breaker struct {
+	config    *CircuitBreakerConfig
+	mu        sync.Mutex
+	errors    int
+	openUntil time.Time
+	probing   bool
+}
+
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.openUntil.IsZero() {
+		return true
+	}
+	if now.Before(b.openUntil) || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record returns true if the breaker is opened by the result
+func (b *breaker) record(success bool, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if success {
+		b.errors = 0
+		b.openUntil = time.Time{}
+		b.probing = false
+		return false
+	}
+	if b.probing {
+		b.probing = false
+		b.openUntil = now.Add(time.Duration(b.config.OpenMs) * time.Millisecond)
+		return true
+	}
+	b.errors++
+	if b.openUntil.IsZero() && b.errors >= b.config.ConsecutiveErrors {
+		b.errors = 0
+		b.openUntil = now.Add(time.Duration(b.config.OpenMs) * time.Millisecond)
+		return true
+	}
+	return false
+}
+
+func errorClass(err error) string {
+	if isTimeout(err) || err == context.DeadlineExceeded {
+		return ErrClassTimeout
+	}
+	if _, ok := err.(common.StatusError); ok {
+		return ErrClassStatus
+	}
+	if e, ok := err.(common.CommonError); ok {
+		switch e.Code() {
+		case common.UnavailebleCode:
+			return ErrClassUnavailable
+		case common.InternalCode:
+			return ErrClassInternal
+		case common.InvalidArgsCode:
+			return ErrClassInvalidArgument
+		}
+	}
+	return ErrClassUnknown
+}
+
+// cloneRequest copies the request for each attempt, because the channels may modify the header
+func cloneRequest(req *rpc.RPCRequest) *rpc.RPCRequest {
+	result := *req
+	result.Header = make(rpc.RPCHeader, len(req.Header))
+	for k, v := range req.Header {
+		result.Header[k] = v
+	}
+	return &result
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,259 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mosn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	common "mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+)
+
+// fakeClock lets the policy sleep without waiting
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newTestPolicy(t *testing.T, config PolicyConfig) (*policy, *fakeClock) {
+	p, err := newPolicy(config)
+	assert.Nil(t, err)
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	p.now = func() time.Time {
+		return clock.now
+	}
+	p.sleep = func(ctx context.Context, d time.Duration) error {
+		clock.sleeps = append(clock.sleeps, d)
+		clock.now = clock.now.Add(d)
+		return nil
+	}
+	return p, clock
+}
+
+// errMsg returns the message of the errors without the code
+func errMsg(err error) string {
+	if e, ok := err.(common.CommonError); ok {
+		return e.Msg()
+	}
+	return err.Error()
+}
+
+func TestNewPolicies(t *testing.T) {
+	_, err := newPolicies([]PolicyConfig{{Id: "a", Retry: &RetryConfig{Retryable: []string{"refused"}}}})
+	assert.Equal(t, "unknown error class refused of rpc policy a/", err.Error())
+
+	_, err = newPolicies([]PolicyConfig{{Id: "a", Method: "m", DeadlineMs: -1}})
+	assert.Equal(t, "invalid deadline_ms -1 of rpc policy a/m", err.Error())
+
+	ps, err := newPolicies([]PolicyConfig{
+		{Id: "*", Retry: &RetryConfig{}},
+		{Id: "a"},
+		{Id: "a", Method: "m"},
+		{Method: "m", CircuitBreaker: &CircuitBreakerConfig{}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "a", ps.match("a", "m").config.Id)
+	assert.Equal(t, "m", ps.match("a", "m").config.Method)
+	assert.Equal(t, "", ps.match("a", "x").config.Method)
+	assert.Equal(t, "", ps.match("b", "m").config.Id)
+	assert.Equal(t, "*", ps.match("b", "x").config.Id)
+	assert.Nil(t, policies(nil).match("a", "m"))
+
+	retry := ps.match("b", "x").retry
+	assert.Equal(t, defaultMaxAttempts, retry.MaxAttempts)
+	assert.Equal(t, defaultInitialBackoffMs, retry.InitialBackoffMs)
+	assert.Equal(t, defaultMaxBackoffMs, retry.MaxBackoffMs)
+	assert.Equal(t, float64(defaultBackoffMultiplier), retry.BackoffMultiplier)
+	assert.Equal(t, []string{ErrClassUnavailable}, retry.Retryable)
+	assert.Equal(t, 1, ps.match("a", "x").retry.MaxAttempts)
+	assert.Equal(t, defaultConsecutiveErrors, ps.match("b", "m").breaker.ConsecutiveErrors)
+	assert.Equal(t, defaultOpenMs, ps.match("b", "m").breaker.OpenMs)
+}
+
+func TestPolicy_Retry(t *testing.T) {
+	p, clock := newTestPolicy(t, PolicyConfig{Id: "a", Retry: &RetryConfig{MaxAttempts: 4, MaxBackoffMs: 300}})
+	req := &rpc.RPCRequest{Id: "a", Method: "m", Timeout: 1000, Header: rpc.RPCHeader{"verb": []string{"POST"}}}
+
+	t.Run("retry until success", func(t *testing.T) {
+		attempts := 0
+		resp, err := p.invoke(req, func(r *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+			// the channels remove some headers
+			assert.Equal(t, "POST", r.Header.Get("verb"))
+			delete(r.Header, "verb")
+			attempts++
+			if attempts < 4 {
+				return nil, common.Error(common.UnavailebleCode, "connection refused")
+			}
+			return &rpc.RPCResponse{Data: []byte("ok")}, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "ok", string(resp.Data))
+		assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}, clock.sleeps)
+		stats := p.stats()
+		assert.Equal(t, uint64(1), stats.Calls)
+		assert.Equal(t, uint64(4), stats.Attempts)
+		assert.Equal(t, uint64(3), stats.Retries)
+		assert.Equal(t, uint64(0), stats.Failures)
+	})
+
+	t.Run("give up after max attempts", func(t *testing.T) {
+		_, err := p.invoke(req, func(r *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+			return nil, common.Error(common.UnavailebleCode, "connection refused")
+		})
+		assert.Equal(t, "connection refused", errMsg(err))
+		stats := p.stats()
+		assert.Equal(t, uint64(8), stats.Attempts)
+		assert.Equal(t, uint64(1), stats.Failures)
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		attempts := 0
+		_, err := p.invoke(req, func(r *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+			attempts++
+			return nil, common.Error(common.TimeoutCode, "request timeout")
+		})
+		assert.Equal(t, "request timeout", errMsg(err))
+		assert.Equal(t, 1, attempts)
+		_, err = p.invoke(req, func(r *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+			attempts++
+			return nil, errors.New("unknown")
+		})
+		assert.Equal(t, "unknown", errMsg(err))
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("error status not retried by default", func(t *testing.T) {
+		p, _ := newTestPolicy(t, PolicyConfig{Id: "a", Retry: &RetryConfig{}})
+		attempts := 0
+		_, err := p.invoke(req, func(r *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+			attempts++
+			return nil, common.StatusErrorf(500, "http response code 500, body: failed")
+		})
+		assert.Equal(t, "http response code 500, body: failed", errMsg(err))
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+func TestPolicy_DeadlineBudget(t *testing.T) {
+	p, clock := newTestPolicy(t, PolicyConfig{
+		DeadlineMs: 250,
+		Retry:      &RetryConfig{MaxAttempts: 5, Retryable: []string{ErrClassTimeout}},
+	})
+	req := &rpc.RPCRequest{Id: "a", Method: "m", Timeout: 1000}
+	var timeouts []int32
+	_, err := p.invoke(req, func(r *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+		timeouts = append(timeouts, r.Timeout)
+		clock.now = clock.now.Add(100 * time.Millisecond)
+		return nil, common.Error(common.TimeoutCode, "request timeout")
+	})
+	assert.Equal(t, "request timeout", errMsg(err))
+	// the second attempt has 50ms left, and there is no time for the backoff before the third one
+	assert.Equal(t, []int32{250, 50}, timeouts)
+	stats := p.stats()
+	assert.Equal(t, uint64(2), stats.Attempts)
+	assert.Equal(t, uint64(1), stats.Retries)
+	assert.Equal(t, uint64(1), stats.BudgetExhausted)
+	assert.Equal(t, uint64(1), stats.Failures)
+
+	// the deadline of the caller is shorter than the budget
+	ctx, cancel := context.WithDeadline(context.Background(), clock.now.Add(-time.Millisecond))
+	defer cancel()
+	req.Ctx = ctx
+	_, err = p.invoke(req, func(r *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+		return &rpc.RPCResponse{}, nil
+	})
+	assert.Equal(t, "deadline budget of a/m is exhausted", errMsg(err))
+	assert.Equal(t, uint64(2), p.stats().BudgetExhausted)
+}
+
+func TestPolicy_CircuitBreaker(t *testing.T) {
+	p, clock := newTestPolicy(t, PolicyConfig{
+		Id:             "a",
+		CircuitBreaker: &CircuitBreakerConfig{ConsecutiveErrors: 2, OpenMs: 1000},
+	})
+	var err error
+	fail := func(r *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+		return nil, common.Error(common.UnavailebleCode, "connection refused")
+	}
+	succeed := func(r *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+		return &rpc.RPCResponse{}, nil
+	}
+	req := &rpc.RPCRequest{Id: "a", Method: "m", Timeout: 1000}
+
+	// a success breaks the consecutive errors
+	p.invoke(req, fail)
+	p.invoke(req, succeed)
+	p.invoke(req, fail)
+	_, err = p.invoke(req, succeed)
+	assert.Nil(t, err)
+
+	p.invoke(req, fail)
+	p.invoke(req, fail)
+	assert.Equal(t, uint64(1), p.stats().BreakerOpened)
+	_, err = p.invoke(req, succeed)
+	assert.Equal(t, "circuit breaker of a/m is open", errMsg(err))
+	assert.Equal(t, uint64(1), p.stats().Rejected)
+	// the breakers of the other methods are still closed
+	_, err = p.invoke(&rpc.RPCRequest{Id: "a", Method: "n", Timeout: 1000}, succeed)
+	assert.Nil(t, err)
+
+	// the probe fails, and the breaker is opened again
+	clock.now = clock.now.Add(time.Second)
+	_, err = p.invoke(req, fail)
+	assert.Equal(t, "connection refused", errMsg(err))
+	assert.Equal(t, uint64(2), p.stats().BreakerOpened)
+	_, err = p.invoke(req, succeed)
+	assert.Equal(t, "circuit breaker of a/m is open", errMsg(err))
+
+	// the probe succeeds, and the breaker is closed
+	clock.now = clock.now.Add(time.Second)
+	_, err = p.invoke(req, succeed)
+	assert.Nil(t, err)
+	_, err = p.invoke(req, succeed)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), p.stats().Rejected)
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	b := &breaker{config: &CircuitBreakerConfig{ConsecutiveErrors: 1, OpenMs: 1000}}
+	now := time.Unix(1000, 0)
+	assert.True(t, b.allow(now))
+	assert.True(t, b.record(false, now))
+	assert.False(t, b.allow(now))
+	now = now.Add(time.Second)
+	// only one probe is let through at a time
+	assert.True(t, b.allow(now))
+	assert.False(t, b.allow(now))
+	assert.False(t, b.record(true, now))
+	assert.True(t, b.allow(now))
+	assert.True(t, b.allow(now))
+}
+
+func TestErrorClass(t *testing.T) {
+	assert.Equal(t, ErrClassTimeout, errorClass(common.Error(common.TimeoutCode, "timeout")))
+	assert.Equal(t, ErrClassTimeout, errorClass(context.DeadlineExceeded))
+	assert.Equal(t, ErrClassUnavailable, errorClass(common.Error(common.UnavailebleCode, "unavailable")))
+	assert.Equal(t, ErrClassStatus, errorClass(common.StatusErrorf(500, "http response code 500")))
+	assert.Equal(t, ErrClassInternal, errorClass(common.Error(common.InternalCode, "internal")))
+	assert.Equal(t, ErrClassInvalidArgument, errorClass(common.Error(common.InvalidArgsCode, "invalid")))
+	assert.Equal(t, ErrClassUnknown, errorClass(errors.New("unknown")))
+}
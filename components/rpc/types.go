@@ -92,6 +92,78 @@ type Invoker interface {
 	Invoke(ctx context.Context, req *RPCRequest) (*RPCResponse, error)
 }
 
+// ChunkReader reads the data of a stream chunk by chunk
+type ChunkReader interface {
+	// Recv returns the next chunk, or io.EOF after the last one
+	Recv() ([]byte, error)
+}
+
+// ChunkReadCloser is a ChunkReader which must be closed to release the stream
+type ChunkReadCloser interface {
+	ChunkReader
+	Close() error
+}
+
+// RPCStreamRequest is a request whose data is streamed to the upstream, instead of being buffered in the runtime.
+// The Data of the embedded RPCRequest is not used.
+type RPCStreamRequest struct {
+	RPCRequest
+	Body ChunkReader
+}
+
+// RPCStreamResponse is a response whose data is streamed back from the upstream
+type RPCStreamResponse struct {
+	Header      RPCHeader
+	ContentType string
+	// Body must be closed when it's read to the end or not needed any more
+	Body ChunkReadCloser
+}
+
+// StreamInvoker is an Invoker which supports the client-, server- and bidi-streaming calls
+type StreamInvoker interface {
+	Invoker
+	InvokeStream(ctx context.Context, req *RPCStreamRequest) (*RPCStreamResponse, error)
+}
+
+// InspectableInvoker is an Invoker which sends requests over several channels with resiliency policies,
+// and reports the stats of each channel and each policy to the actuator
+type InspectableInvoker interface {
+	Invoker
+	ChannelStats() []ChannelStats
+	PolicyStats() []PolicyStats
+}
+
+// ChannelStats is the stats of a channel since the invoker is initialized
+type ChannelStats struct {
+	Protocol string `json:"protocol"`
+	Listener string `json:"listener"`
+	Weight   int    `json:"weight"`
+	// Outstanding is the number of requests waiting for responses
+	Outstanding int64  `json:"outstanding"`
+	Requests    uint64 `json:"requests"`
+	Failures    uint64 `json:"failures"`
+	Timeouts    uint64 `json:"timeouts"`
+	// Ejected is true if the channel is ejected for timing out repeatedly, and Ejections is how many times it has been ejected
+	Ejected   bool   `json:"ejected"`
+	Ejections uint64 `json:"ejections"`
+}
+
+// PolicyStats is the stats of a resiliency policy since the invoker is initialized
+type PolicyStats struct {
+	Id     string `json:"id"`
+	Method string `json:"method"`
+	// Calls is the number of requests, and Failures is the number of requests failed after all the attempts
+	Calls    uint64 `json:"calls"`
+	Attempts uint64 `json:"attempts"`
+	Retries  uint64 `json:"retries"`
+	Failures uint64 `json:"failures"`
+	// BudgetExhausted is the number of requests given up because there is no time left in the deadline budget
+	BudgetExhausted uint64 `json:"budget_exhausted"`
+	// Rejected is the number of attempts rejected by the open circuit breakers
+	Rejected      uint64 `json:"rejected"`
+	BreakerOpened uint64 `json:"breaker_opened"`
+}
+
 // Callback is interface for before invoke or after invoke
 type Callback interface {
 	// AddBeforeInvoke is add BeforeInvoke func
@@ -101,6 +173,9 @@ type Callback interface {
 
 	// BeforeInvoke is used to invoke beforeInvoke callbacks
 	BeforeInvoke(*RPCRequest) (*RPCRequest, error)
+	// BeforeInvokeStream is used to invoke beforeInvoke callbacks on a stream request, whose data is never buffered.
+	// It fails if any of the callbacks depends on the data, e.g. the hmac signing
+	BeforeInvokeStream(*RPCRequest) (*RPCRequest, error)
 	// AfterInvoke is used to invoke afterInvoke callbacks
 	AfterInvoke(*RPCResponse) (*RPCResponse, error)
 }
@@ -115,3 +190,10 @@ type CallbackFunc struct {
 type Channel interface {
 	Do(*RPCRequest) (*RPCResponse, error)
 }
+
+// StreamChannel is a Channel which streams the request and the response data.
+// The Timeout of the request limits the wait for the response header, and the stream lasts until the Ctx is done
+// or the response Body is closed.
+type StreamChannel interface {
+	DoStream(*RPCStreamRequest) (*RPCStreamResponse, error)
+}
@@ -39,6 +39,10 @@ const (
 	startTime         = "startTime"
 	reqTimeout        = "reqTimeout"
 	keyTimeout        = "keyTimeout"
+	workerIdSource    = "workerIdSource"
+	leaseTTL          = "leaseTTL"
+	leaseKeyPrefix    = "leaseKeyPrefix"
+	maxWorkerId       = "maxWorkerId"
 
 	defaultMysqlTableName = "layotto_sequencer_snowflake"
 	defaultKeyTableName   = "layotto_sequencer_snowflake_key"
@@ -48,10 +52,24 @@ const (
 	defaultStartTime      = "2022-01-01"
 	defaultReqTimeout     = 500
 	defaultKeyTimeout     = 24
+	defaultLeaseTTL       = 30
+	defaultLeaseKeyPrefix = "layotto_snowflake_worker_"
+	defaultMaxWorkerId    = 1023
+)
+
+// the sources of worker ids
+const (
+	// WorkerIdSourceMysql assigns worker ids with the auto increment id of a mysql table
+	WorkerIdSourceMysql = "mysql"
+	// WorkerIdSourceLock leases worker ids through the lock store injected by `component_ref.lock_store`
+	WorkerIdSourceLock = "lock"
 )
 
 type SnowflakeMetadata struct {
 	MysqlMetadata SnowflakeMysqlMetadata
+	LeaseMetadata SnowflakeLeaseMetadata
+	// WorkerIdSource is where worker ids come from, mysql by default
+	WorkerIdSource string
 
 	WorkerBits     int64
 	TimeBits       int64
@@ -75,6 +93,46 @@ type SnowflakeMysqlMetadata struct {
 	Db           *sql.DB
 }
 
+// SnowflakeLeaseMetadata is the metadata of leasing worker ids,
+// which is used unless the worker ids come from mysql.
+type SnowflakeLeaseMetadata struct {
+	// TTL of the lease in seconds
+	TTL int32
+	// KeyPrefix is the prefix of the lock keys, followed by worker ids
+	KeyPrefix string
+	// MaxWorkerId is the max worker id to lease
+	MaxWorkerId int64
+}
+
+func ParseSnowflakeLeaseMetadata(properties map[string]string, workerBits int64) (SnowflakeLeaseMetadata, error) {
+	lm := SnowflakeLeaseMetadata{}
+
+	ttl, err := Parsetime(properties[leaseTTL], defaultLeaseTTL)
+	if err != nil {
+		return lm, err
+	}
+	if ttl < 3 {
+		return lm, errors.New("leaseTTL must be at least 3 seconds")
+	}
+	lm.TTL = int32(ttl)
+
+	lm.KeyPrefix = defaultLeaseKeyPrefix
+	if val, ok := properties[leaseKeyPrefix]; ok && val != "" {
+		lm.KeyPrefix = val
+	}
+
+	if lm.MaxWorkerId, err = Parsebits(properties[maxWorkerId], defaultMaxWorkerId); err != nil {
+		return lm, err
+	}
+	if lm.MaxWorkerId < 0 {
+		return lm, errors.New("maxWorkerId can't be negative")
+	}
+	if limit := int64(1)<<workerBits - 1; lm.MaxWorkerId > limit {
+		lm.MaxWorkerId = limit
+	}
+	return lm, nil
+}
+
 func ParseSnowflakeMysqlMetadata(properties map[string]string) (SnowflakeMysqlMetadata, error) {
 	mm := SnowflakeMysqlMetadata{}
 
@@ -146,12 +204,23 @@ func ParseSnowflakeMetadata(properties map[string]string) (SnowflakeMetadata, er
 	metadata := SnowflakeMetadata{}
 	var err error
 
-	metadata.MysqlMetadata, err = ParseSnowflakeMysqlMetadata(properties)
+	metadata.WorkerBits, err = Parsebits(properties[workerBits], defaultWorkerBits)
 	if err != nil {
 		return metadata, err
 	}
 
-	metadata.WorkerBits, err = Parsebits(properties[workerBits], defaultWorkerBits)
+	metadata.WorkerIdSource = WorkerIdSourceMysql
+	if val, ok := properties[workerIdSource]; ok && val != "" {
+		metadata.WorkerIdSource = val
+	}
+	switch metadata.WorkerIdSource {
+	case WorkerIdSourceMysql:
+		metadata.MysqlMetadata, err = ParseSnowflakeMysqlMetadata(properties)
+	case WorkerIdSourceLock:
+		metadata.LeaseMetadata, err = ParseSnowflakeLeaseMetadata(properties, metadata.WorkerBits)
+	default:
+		err = fmt.Errorf("unknown workerIdSource %s", metadata.WorkerIdSource)
+	}
 	if err != nil {
 		return metadata, err
 	}
@@ -17,13 +17,24 @@ import (
 	"database/sql"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"mosn.io/pkg/log"
 
+	"mosn.io/layotto/components/lock"
 	"mosn.io/layotto/components/sequencer"
 )
 
+// the interval to check whether the clock has caught up after it moves backwards
+const clockCheckInterval = 10 * time.Millisecond
+
+var (
+	ErrClockRollback      = errors.New("clock moved backwards, refusing to generate ids until it catches up")
+	ErrWorkerIdLeaseLost  = errors.New("the lease of worker id is lost, please try again later")
+	errLockStoreNotInject = errors.New("workerIdSource is lock, but no lock store is injected by component_ref.lock_store")
+)
+
 type SnowFlakeSequencer struct {
 	metadata   SnowflakeMetadata
 	workerId   int64
@@ -34,15 +45,45 @@ type SnowFlakeSequencer struct {
 	logger     log.ErrorLogger
 	ctx        context.Context
 	cancel     context.CancelFunc
+
+	// lockStore and lease are used unless the worker ids come from mysql
+	lockStore lock.LockStore
+	lease     *workerLease
+	// leased is false after the lease is lost, and id generation is blocked until a worker id is leased again
+	leased bool
+	// validUntil is the last timestamp of ids issued under the lease, which ends one renewal interval before the lease expires.
+	// So the next holder of the worker id never generates the same ids, as long as the clock skew between them is less than the renewal interval.
+	validUntil int64
+	// issuedTimestamp is the latest timestamp of the ids generated under leases, and a new lease waits until the clock passes it
+	issuedTimestamp int64
+	// records the last timestamp of the keys like the key table in mysql, when leasing worker ids
+	records map[string]keyRecord
+	// producerCtx is canceled to stop all the producers when the lease is lost
+	producerCtx    context.Context
+	producerCancel context.CancelFunc
+	// lastTimestamp is the latest timestamp seen, to detect clock rollback
+	lastTimestamp int64
+}
+
+type keyRecord struct {
+	workerId  int64
+	timestamp int64
 }
 
 func NewSnowFlakeSequencer(logger log.ErrorLogger) *SnowFlakeSequencer {
 	return &SnowFlakeSequencer{
-		logger: logger,
-		smap:   make(map[string]chan int64),
+		logger:  logger,
+		smap:    make(map[string]chan int64),
+		records: make(map[string]keyRecord),
 	}
 }
 
+// SetLockStore injects the lock store used to lease worker ids
+func (s *SnowFlakeSequencer) SetLockStore(ls lock.LockStore) error {
+	s.lockStore = ls
+	return nil
+}
+
 func (s *SnowFlakeSequencer) Init(config sequencer.Configuration) error {
 	var err error
 	s.metadata, err = ParseSnowflakeMetadata(config.Properties)
@@ -54,37 +95,257 @@ func (s *SnowFlakeSequencer) Init(config sequencer.Configuration) error {
 
 	s.biggerThan = config.BiggerThan
 	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.producerCtx, s.producerCancel = context.WithCancel(s.ctx)
+
+	if s.metadata.WorkerIdSource == WorkerIdSourceMysql {
+		if s.workerId, err = NewMysqlClient(&s.metadata.MysqlMetadata); err != nil {
+			return err
+		}
+		return err
+	}
+	return s.initLease()
+}
 
-	if s.workerId, err = NewMysqlClient(&s.metadata.MysqlMetadata); err != nil {
+// initLease leases a worker id through the injected lock store and keeps the lease in the background
+func (s *SnowFlakeSequencer) initLease() error {
+	if s.lockStore == nil {
+		return errLockStoreNotInject
+	}
+	s.lease = newWorkerLease(s.lockStore, s.metadata.LeaseMetadata)
+	acquired := time.Now()
+	workerId, err := s.lease.acquire(s.ctx)
+	if err != nil {
 		return err
 	}
-	return err
+	s.waitForClock(s.ctx, s.leaseStartTimestamp())
+	s.setLease(workerId, acquired)
+	go s.keepLease()
+	return nil
+}
+
+// keepLease renews the lease of the worker id until the sequencer is closed.
+// The lease is regarded as lost if it can't be renewed before the next renewal would be too late,
+// and then a worker id is leased again.
+// The lease ends one renewal interval before it expires, so that the next holder starts after it.
+func (s *SnowFlakeSequencer) keepLease() {
+	ttl := s.lease.ttl()
+	interval := ttl / leaseRenewFactor
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	renewed := time.Now()
+	for {
+		select {
+		case <-s.ctx.Done():
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			if err := s.lease.release(ctx); err != nil {
+				s.logger.Errorf("[snowflake] release worker id %d error: %v", s.lease.workerId, err)
+			}
+			cancel()
+			return
+		case <-ticker.C:
+		}
+		if s.isLeased() {
+			now := time.Now()
+			ok, err := s.lease.renew(s.ctx)
+			if ok {
+				renewed = now
+				s.renewLease(renewed)
+				continue
+			}
+			if err != nil {
+				s.logger.Errorf("[snowflake] renew lease of worker id %d error: %v", s.lease.workerId, err)
+				if time.Since(renewed)+interval < ttl {
+					continue
+				}
+			}
+			s.logger.Errorf("[snowflake] lease of worker id %d is lost, stop generating ids", s.lease.workerId)
+			s.loseLease()
+		}
+		// lease a worker id again
+		acquired := time.Now()
+		workerId, err := s.lease.acquire(s.ctx)
+		if err != nil {
+			s.logger.Errorf("[snowflake] lease worker id error: %v", err)
+			continue
+		}
+		// ids are issued again after all the ids generated before
+		if !s.waitForClock(s.ctx, s.leaseStartTimestamp()) {
+			continue
+		}
+		renewed = acquired
+		s.setLease(workerId, renewed)
+	}
+}
+
+func (s *SnowFlakeSequencer) isLeased() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.leased
+}
+
+// setLease resumes id generation with the worker id leased at the time
+func (s *SnowFlakeSequencer) setLease(workerId int64, acquired time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.workerId = workerId
+	s.validUntil = s.leaseEnd(acquired)
+	s.leased = true
+}
+
+// renewLease extends the lease renewed at the time
+func (s *SnowFlakeSequencer) renewLease(renewed time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.validUntil = s.leaseEnd(renewed)
+}
+
+// leaseEnd returns the last timestamp of ids under the lease renewed at the time
+func (s *SnowFlakeSequencer) leaseEnd(renewed time.Time) int64 {
+	ttl := s.lease.ttl()
+	return renewed.Add(ttl-ttl/leaseRenewFactor).Unix() - s.metadata.StartTime
+}
+
+// loseLease blocks id generation and stops all the producers, whose ids may be generated by the next holder of the worker id
+func (s *SnowFlakeSequencer) loseLease() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leased = false
+	s.producerCancel()
+	s.producerCtx, s.producerCancel = context.WithCancel(s.ctx)
+	s.smap = make(map[string]chan int64)
+}
+
+// leaseStartTimestamp returns the first timestamp of ids under a new lease,
+// which is after the lease is acquired and after all the ids generated before.
+func (s *SnowFlakeSequencer) leaseStartTimestamp() int64 {
+	timestamp := time.Now().Unix() - s.metadata.StartTime
+	if issued := atomic.LoadInt64(&s.issuedTimestamp); issued > timestamp {
+		timestamp = issued
+	}
+	return timestamp + 1
+}
+
+// markIssued updates the latest timestamp of the ids generated under leases
+func (s *SnowFlakeSequencer) markIssued(timestamp int64) {
+	for {
+		issued := atomic.LoadInt64(&s.issuedTimestamp)
+		if timestamp <= issued || atomic.CompareAndSwapInt64(&s.issuedTimestamp, issued, timestamp) {
+			return
+		}
+	}
+}
+
+// waitForClock blocks until the clock reaches the timestamp, and returns false if ctx is done before that
+func (s *SnowFlakeSequencer) waitForClock(ctx context.Context, timestamp int64) bool {
+	wait := time.Until(time.Unix(timestamp+s.metadata.StartTime, 0))
+	if wait <= 0 {
+		return true
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// validId reports whether the id is generated before the lease ends
+func (s *SnowFlakeSequencer) validId(id int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return id>>s.metadata.TimestampShift <= s.validUntil
+}
+
+// currentTimestamp returns the current timestamp of ids, must be locked.
+// If the clock moves backwards, it blocks until the clock catches up, or returns ErrClockRollback after ReqTimeout.
+func (s *SnowFlakeSequencer) currentTimestamp() (int64, error) {
+	deadline := time.Now().Add(s.metadata.ReqTimeout)
+	for {
+		timestamp := time.Now().Unix() - s.metadata.StartTime
+		if timestamp >= s.lastTimestamp {
+			s.lastTimestamp = timestamp
+			return timestamp, nil
+		}
+		if time.Now().After(deadline) {
+			s.logger.Errorf("[snowflake] clock moved backwards by %d seconds", s.lastTimestamp-timestamp)
+			return 0, ErrClockRollback
+		}
+		time.Sleep(clockCheckInterval)
+	}
+}
+
+// nextTimestamp returns the timestamp of ids after the last one, which catches up with the clock.
+// The clock is checked every time, so that a rollback is detected by the running producers too.
+func (s *SnowFlakeSequencer) nextTimestamp(last int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	timestamp, err := s.currentTimestamp()
+	if err != nil {
+		return 0, err
+	}
+	if timestamp <= last {
+		return last + 1, nil
+	}
+	return timestamp, nil
+}
+
+// lastRecord returns the worker id and the timestamp used last time by the key
+func (s *SnowFlakeSequencer) lastRecord(key string) (int64, int64, error) {
+	if s.lease != nil {
+		record, ok := s.records[key]
+		if !ok {
+			return 0, 0, sql.ErrNoRows
+		}
+		return record.workerId, record.timestamp, nil
+	}
+	var oldWorkerId int64
+	var oldTimeStamp int64
+	err := s.metadata.MysqlMetadata.Db.QueryRow("SELECT WORKER_ID, TIMESTAMP FROM "+s.metadata.MysqlMetadata.KeyTableName+" WHERE SEQUENCER_KEY = ?", key).Scan(&oldWorkerId, &oldTimeStamp)
+	return oldWorkerId, oldTimeStamp, err
+}
+
+// record saves the worker id and the timestamp used last time by the key, must be locked
+func (s *SnowFlakeSequencer) record(key string, workerId, timestamp int64) error {
+	if s.lease != nil {
+		s.records[key] = keyRecord{workerId: workerId, timestamp: timestamp}
+		return nil
+	}
+	return MysqlRecord(s.metadata.MysqlMetadata.Db, s.metadata.MysqlMetadata.KeyTableName, key, workerId, timestamp)
 }
 
 func (s *SnowFlakeSequencer) GetNextId(req *sequencer.GetNextIdRequest) (*sequencer.GetNextIdResponse, error) {
 	s.mu.Lock()
+	if s.lease != nil && !s.leased {
+		s.mu.Unlock()
+		return nil, ErrWorkerIdLeaseLost
+	}
 	ch, ok := s.smap[req.Key]
 	//If the key appears for the first time, start a new goroutine for it. If the key doesn't appear for a long time, close the goroutine
 	if !ok {
-		ch = make(chan int64, 1000)
-		s.smap[req.Key] = ch
-
-		var oldWorkerId int64
-		var oldTimeStamp int64
-
-		timestamp := time.Now().Unix() - s.metadata.StartTime
+		timestamp, err := s.currentTimestamp()
+		if err != nil {
+			s.mu.Unlock()
+			return nil, err
+		}
 
-		err := s.metadata.MysqlMetadata.Db.QueryRow("SELECT WORKER_ID, TIMESTAMP FROM "+s.metadata.MysqlMetadata.KeyTableName+" WHERE SEQUENCER_KEY = ?", req.Key).Scan(&oldWorkerId, &oldTimeStamp)
+		oldWorkerId, oldTimeStamp, err := s.lastRecord(req.Key)
 		if err == nil {
-			if oldWorkerId == s.workerId {
+			if oldWorkerId == s.workerId && oldTimeStamp >= timestamp {
 				timestamp = oldTimeStamp + 1
 			}
 		} else if err != sql.ErrNoRows {
+			s.mu.Unlock()
 			return nil, err
 		}
+
+		ch = make(chan int64, 1000)
+		s.smap[req.Key] = ch
 		startId := timestamp<<s.metadata.TimestampShift | s.workerId<<s.metadata.WorkidShift
 
-		go s.producer(startId, timestamp, ch, req.Key)
+		go s.producer(s.producerCtx, startId, timestamp, s.workerId, ch, req.Key)
 	}
 	s.mu.Unlock()
 
@@ -97,6 +358,9 @@ func (s *SnowFlakeSequencer) GetNextId(req *sequencer.GetNextIdRequest) (*sequen
 		if !ok {
 			return nil, errors.New("please try again or adjust the start time")
 		}
+		if s.lease != nil && !s.validId(id) {
+			return nil, ErrWorkerIdLeaseLost
+		}
 		return &sequencer.GetNextIdResponse{
 			NextId: id,
 		}, nil
@@ -109,7 +373,7 @@ func (s *SnowFlakeSequencer) GetSegment(req *sequencer.GetSegmentRequest) (suppo
 	return false, nil, nil
 }
 
-func (s *SnowFlakeSequencer) producer(id, currentTimeStamp int64, ch chan int64, key string) {
+func (s *SnowFlakeSequencer) producer(ctx context.Context, id, currentTimeStamp, workerId int64, ch chan int64, key string) {
 	defer func() {
 		if x := recover(); x != nil {
 			log.DefaultLogger.Errorf("panic when producing id with snowflake algorithm: %v", x)
@@ -125,40 +389,67 @@ func (s *SnowFlakeSequencer) producer(id, currentTimeStamp int64, ch chan int64,
 	maxTimeStamp = 1 << s.metadata.TimeBits
 	maxSeqId = 1<<s.metadata.SeqBits - 1
 	for {
+		// ids never run ahead of the clock under leases, so that a new lease only waits until the clock passes the ids generated
+		if s.lease != nil && !s.waitForClock(ctx, currentTimeStamp) {
+			close(ch)
+			return
+		}
 		timeout.Reset(s.metadata.KeyTimeout)
 		select {
-		case <-s.ctx.Done():
+		case <-ctx.Done():
 			close(ch)
 			return
 		//if timeout, remove key from map and record key, workerId, timestamp to mysql
 		case <-timeout.C:
-			s.mu.Lock()
-			delete(s.smap, key)
-			close(ch)
-
-			err := MysqlRecord(s.metadata.MysqlMetadata.Db, s.metadata.MysqlMetadata.KeyTableName, key, s.workerId, currentTimeStamp)
-			if err != nil {
-				s.logger.Errorf("%v", err)
-			}
-			s.mu.Unlock()
+			s.removeKey(key, ch, workerId, currentTimeStamp)
 			return
 		case ch <- id:
+			if s.lease != nil {
+				s.markIssued(currentTimeStamp)
+			}
 			if currentTimeStamp == maxTimeStamp {
 				close(ch)
 				return
 			}
 			if id&maxSeqId != maxSeqId {
 				id++
-			} else {
-				currentTimeStamp++
-				id = currentTimeStamp<<s.metadata.TimestampShift | s.workerId<<s.metadata.WorkidShift
+				continue
+			}
+			next, err := s.nextTimestamp(currentTimeStamp)
+			if err != nil {
+				// the key starts over, and its next request checks the clock again
+				s.removeKey(key, ch, workerId, currentTimeStamp)
+				return
+			}
+			currentTimeStamp = next
+			if currentTimeStamp > maxTimeStamp {
+				close(ch)
+				return
 			}
+			id = currentTimeStamp<<s.metadata.TimestampShift | workerId<<s.metadata.WorkidShift
 		}
 	}
 }
 
+// removeKey stops producing ids for the key, and records the worker id and the timestamp used last time
+func (s *SnowFlakeSequencer) removeKey(key string, ch chan int64, workerId, timestamp int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// the map is renewed if the worker id changes
+	if s.smap[key] == ch {
+		delete(s.smap, key)
+	}
+	close(ch)
+
+	if err := s.record(key, workerId, timestamp); err != nil {
+		s.logger.Errorf("%v", err)
+	}
+}
+
 func (s *SnowFlakeSequencer) Close() error {
 	s.cancel()
-	s.metadata.MysqlMetadata.Db.Close()
+	if s.metadata.MysqlMetadata.Db != nil {
+		s.metadata.MysqlMetadata.Db.Close()
+	}
 	return nil
 }
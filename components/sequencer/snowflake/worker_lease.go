@@ -0,0 +1,125 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package snowflake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+
+	"mosn.io/layotto/components/lock"
+)
+
+// the lease is renewed every 1/leaseRenewFactor of the ttl
+const leaseRenewFactor = 3
+
+var ErrNoWorkerIdAvailable = errors.New("no worker id available to lease")
+
+// workerLease leases a worker id through a lock store,
+// so that no two sequencers use the same worker id at the same time.
+// The lock of worker id n is "<KeyPrefix><n>".
+type workerLease struct {
+	store    lock.LockStore
+	metadata SnowflakeLeaseMetadata
+	owner    string
+	workerId int64
+}
+
+func newWorkerLease(store lock.LockStore, metadata SnowflakeLeaseMetadata) *workerLease {
+	return &workerLease{
+		store:    store,
+		metadata: metadata,
+		owner:    uuid.New().String(),
+		workerId: -1,
+	}
+}
+
+// acquire tries the worker ids from a random one, and returns the first one leased successfully.
+// The worker id leased last time is preferred, so that the ids keep their worker id after the lease is lost for a while.
+func (w *workerLease) acquire(ctx context.Context) (int64, error) {
+	count := w.metadata.MaxWorkerId + 1
+	if w.workerId >= 0 {
+		ok, err := w.tryLock(ctx, w.workerId)
+		if err != nil || ok {
+			return w.workerId, err
+		}
+	}
+	start := rand.Int63n(count)
+	for i := int64(0); i < count; i++ {
+		workerId := (start + i) % count
+		ok, err := w.tryLock(ctx, workerId)
+		if err != nil {
+			return -1, err
+		}
+		if ok {
+			w.workerId = workerId
+			return workerId, nil
+		}
+	}
+	return -1, ErrNoWorkerIdAvailable
+}
+
+func (w *workerLease) tryLock(ctx context.Context, workerId int64) (bool, error) {
+	resp, err := w.store.TryLock(ctx, &lock.TryLockRequest{
+		ResourceId: w.key(workerId),
+		LockOwner:  w.owner,
+		Expire:     w.metadata.TTL,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.Success, nil
+}
+
+// renew returns false if the lease is lost, i.e. it has expired or been taken by others
+func (w *workerLease) renew(ctx context.Context) (bool, error) {
+	resp, err := w.store.LockKeepAlive(ctx, &lock.LockKeepAliveRequest{
+		ResourceId: w.key(w.workerId),
+		LockOwner:  w.owner,
+		Expire:     w.metadata.TTL,
+	})
+	if err != nil {
+		return false, err
+	}
+	switch resp.Status {
+	case lock.SUCCESS:
+		return true, nil
+	case lock.LOCK_UNEXIST, lock.LOCK_BELONG_TO_OTHERS:
+		return false, nil
+	default:
+		return false, fmt.Errorf("renew lease of worker id %d failed with status %v", w.workerId, resp.Status)
+	}
+}
+
+func (w *workerLease) release(ctx context.Context) error {
+	if w.workerId < 0 {
+		return nil
+	}
+	_, err := w.store.Unlock(ctx, &lock.UnlockRequest{
+		ResourceId: w.key(w.workerId),
+		LockOwner:  w.owner,
+	})
+	return err
+}
+
+func (w *workerLease) ttl() time.Duration {
+	return time.Duration(w.metadata.TTL) * time.Second
+}
+
+func (w *workerLease) key(workerId int64) string {
+	return fmt.Sprintf("%s%d", w.metadata.KeyPrefix, workerId)
+}
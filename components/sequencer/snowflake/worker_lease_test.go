@@ -0,0 +1,197 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package snowflake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/lock"
+	lock_inmemory "mosn.io/layotto/components/lock/in-memory"
+	"mosn.io/layotto/components/sequencer"
+)
+
+func newLeaseSequencer(t *testing.T, store lock.LockStore) (*SnowFlakeSequencer, error) {
+	s := NewSnowFlakeSequencer(log.DefaultLogger)
+	assert.NoError(t, s.SetLockStore(store))
+	cfg := sequencer.Configuration{
+		Properties: map[string]string{
+			"workerIdSource": "lock",
+			"leaseTTL":       "3",
+			"maxWorkerId":    "1",
+			"reqTimeout":     "1500",
+		},
+	}
+	return s, s.Init(cfg)
+}
+
+func TestSnowflakeSequence_LeaseWorkerId(t *testing.T) {
+	store := lock_inmemory.NewInMemoryLock()
+	s1, err := newLeaseSequencer(t, store)
+	assert.NoError(t, err)
+	defer s1.Close()
+	s2, err := newLeaseSequencer(t, store)
+	assert.NoError(t, err)
+	assert.NotEqual(t, s1.workerId, s2.workerId)
+
+	// all the worker ids are leased
+	_, err = newLeaseSequencer(t, store)
+	assert.Equal(t, ErrNoWorkerIdAvailable, err)
+
+	var preUid int64
+	for i := 0; i < 1000; i++ {
+		resp, err := s1.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+		assert.NoError(t, err)
+		assert.True(t, resp.NextId > preUid)
+		preUid = resp.NextId
+	}
+
+	// the worker id is released after closed
+	workerId := s2.workerId
+	s2.Close()
+	time.Sleep(100 * time.Millisecond)
+	s3, err := newLeaseSequencer(t, store)
+	assert.NoError(t, err)
+	defer s3.Close()
+	assert.Equal(t, workerId, s3.workerId)
+}
+
+func TestSnowflakeSequence_LeaseLost(t *testing.T) {
+	store := lock_inmemory.NewInMemoryLock()
+	s, err := newLeaseSequencer(t, store)
+	assert.NoError(t, err)
+	defer s.Close()
+	_, err = s.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.NoError(t, err)
+
+	// others take the worker id
+	workerId := s.workerId
+	_, err = store.Unlock(context.Background(), &lock.UnlockRequest{
+		ResourceId: s.lease.key(workerId),
+		LockOwner:  s.lease.owner,
+	})
+	assert.NoError(t, err)
+	resp, err := store.TryLock(context.Background(), &lock.TryLockRequest{
+		ResourceId: s.lease.key(workerId),
+		LockOwner:  "others",
+		Expire:     10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	// the lease is lost, and the sequencer leases the other worker id after the ids generated before
+	time.Sleep(2500 * time.Millisecond)
+	s.mu.Lock()
+	assert.True(t, s.leased)
+	assert.NotEqual(t, workerId, s.workerId)
+	s.mu.Unlock()
+	_, err = s.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.NoError(t, err)
+}
+
+func TestSnowflakeSequence_LeaseLostAndRegained(t *testing.T) {
+	s, err := newLeaseSequencer(t, lock_inmemory.NewInMemoryLock())
+	assert.NoError(t, err)
+	defer s.Close()
+	resp, err := s.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.NoError(t, err)
+	s.mu.Lock()
+	ch := s.smap[key]
+	s.mu.Unlock()
+
+	// the producers are stopped after the lease is lost
+	s.loseLease()
+	_, err = s.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.Equal(t, ErrWorkerIdLeaseLost, err)
+	for range ch {
+	}
+
+	// the same worker id is leased again, and ids are issued after the ids generated before
+	assert.True(t, s.waitForClock(context.Background(), s.leaseStartTimestamp()))
+	s.setLease(s.workerId, time.Now())
+	next, err := s.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.NoError(t, err)
+	assert.True(t, next.NextId>>s.metadata.TimestampShift > resp.NextId>>s.metadata.TimestampShift)
+}
+
+func TestSnowflakeSequence_LeaseEnds(t *testing.T) {
+	s, err := newLeaseSequencer(t, lock_inmemory.NewInMemoryLock())
+	assert.NoError(t, err)
+	defer s.Close()
+
+	// ids after the lease ends are never issued, even if the lease is not known to be lost yet
+	s.mu.Lock()
+	s.validUntil = time.Now().Unix() - s.metadata.StartTime - 1
+	s.mu.Unlock()
+	_, err = s.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.Equal(t, ErrWorkerIdLeaseLost, err)
+}
+
+func TestSnowflakeSequence_ClockRollback(t *testing.T) {
+	s, err := newLeaseSequencer(t, lock_inmemory.NewInMemoryLock())
+	assert.NoError(t, err)
+	defer s.Close()
+
+	now := time.Now().Unix() - s.metadata.StartTime
+	s.mu.Lock()
+	s.lastTimestamp = now + 60
+	s.mu.Unlock()
+	_, err = s.GetNextId(&sequencer.GetNextIdRequest{Key: "rollback"})
+	assert.Equal(t, ErrClockRollback, err)
+
+	// blocks until the clock catches up
+	s.mu.Lock()
+	s.lastTimestamp = now + 1
+	s.mu.Unlock()
+	resp, err := s.GetNextId(&sequencer.GetNextIdRequest{Key: "rollback"})
+	assert.NoError(t, err)
+	assert.True(t, resp.NextId>>s.metadata.TimestampShift >= now+1)
+}
+
+func TestSnowflakeSequence_ClockRollbackWhileProducing(t *testing.T) {
+	s := NewSnowFlakeSequencer(log.DefaultLogger)
+	assert.NoError(t, s.SetLockStore(lock_inmemory.NewInMemoryLock()))
+	// two ids every second
+	err := s.Init(sequencer.Configuration{
+		Properties: map[string]string{
+			"workerIdSource": "lock",
+			"leaseTTL":       "3",
+			"maxWorkerId":    "1",
+			"reqTimeout":     "500",
+			"timeBits":       "40",
+			"seqBits":        "1",
+		},
+	})
+	assert.NoError(t, err)
+	defer s.Close()
+	_, err = s.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.NoError(t, err)
+
+	// the running producer checks the clock when it moves to the next second
+	s.mu.Lock()
+	s.lastTimestamp = time.Now().Unix() - s.metadata.StartTime + 60
+	s.mu.Unlock()
+	// and stops producing ids after it fails to catch up
+	assert.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_, ok := s.smap[key]
+		return !ok
+	}, 4*time.Second, 50*time.Millisecond)
+	_, err = s.GetNextId(&sequencer.GetNextIdRequest{Key: key})
+	assert.Equal(t, ErrClockRollback, err)
+}
@@ -21,4 +21,41 @@ type Config struct {
 	Type       string            `json:"type"`
 	BiggerThan map[string]int64  `json:"biggerThan"`
 	Metadata   map[string]string `json:"metadata"`
+	// Segments configures the id segments cached by Layotto runtime for each key,
+	// which are used when the auto-increment option is weak.
+	Segments map[string]SegmentConfig `json:"segments"`
+	// Formats configures how to format the ids of each key into business ids, e.g. "ORD-20261016-000123"
+	Formats map[string]IdFormat `json:"formats"`
+}
+
+// SegmentConfig is the segment cache config of a key.
+type SegmentConfig struct {
+	// Size is the number of ids fetched from the component at a time
+	Size int `json:"size"`
+	// Threshold is the number of ids left in the in-use segment when the backup segment starts to be fetched
+	Threshold int `json:"threshold"`
+	// Adaptive makes the segment size grow or shrink based on the consumption rate between refills
+	Adaptive bool `json:"adaptive"`
+	// MinSize and MaxSize bound the segment size in adaptive mode
+	MinSize int `json:"minSize"`
+	MaxSize int `json:"maxSize"`
+	// RefillInterval is the expected interval between refills in seconds in adaptive mode.
+	// The segment size doubles if it's used up faster, and halves if it lasts more than twice as long.
+	RefillInterval int `json:"refillInterval"`
+}
+
+// IdFormat is the business id format of a key.
+// The formatted id is made up of the prefix, the date and the zero padded id, joined with the separator.
+type IdFormat struct {
+	Prefix    string `json:"prefix"`
+	Separator string `json:"separator"`
+	// DateLayout is the go time layout of the date part, e.g. "20060102".There is no date part if it's empty
+	DateLayout string `json:"dateLayout"`
+	// Reset restarts the ids from the beginning every period, which can be "daily", "monthly" or "yearly".
+	// The ids are never reset if it's empty
+	Reset string `json:"reset"`
+	// Padding is the min width of the id part, padded with zeros
+	Padding int `json:"padding"`
+	// TimeZone is the IANA time zone name used to get the date, e.g. "Asia/Shanghai".The local time zone is used if it's empty
+	TimeZone string `json:"timeZone"`
 }
@@ -0,0 +1,217 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/lock"
+	"mosn.io/layotto/pkg/actuator"
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+	runtime_lock "mosn.io/layotto/pkg/runtime/lock"
+)
+
+const (
+	lock_key    = "lock"
+	info_key    = "info"
+	list_key    = "list"
+	release_key = "release"
+)
+
+var (
+	invalidActionError   = errors.New("lock action invalid")
+	storeNameEmptyError  = errors.New("store_name is empty")
+	resourceIdEmptyError = errors.New("resource_id is empty")
+	operatorEmptyError   = errors.New("operator is empty")
+)
+
+// init lock Endpoint.
+func init() {
+	actuator.GetDefault().AddEndpoint(lock_key, NewEndpoint())
+}
+
+// Endpoint lets operators inspect locks and release a stuck lock by force.
+// The lock stores are the ones initialized by the runtime,
+// and the resource ids in requests are prefixed in the same way as the gRPC API does.
+type Endpoint struct {
+}
+
+func NewEndpoint() *Endpoint {
+	return &Endpoint{}
+}
+
+// Handle serves the actions below, and the parameters are read from the json request body:
+//
+//	/actuator/lock/info     {"store_name": "redis", "resource_id": "order_1"}
+//	/actuator/lock/list     {"store_name": "redis", "prefix": "order_", "limit": 10}
+//	/actuator/lock/release  {"store_name": "redis", "resource_id": "order_1", "operator": "alice", "reason": "the owner is stuck"}
+//
+// Every release writes an audit log entry with the operator, the reason and the holders before the release.
+func (e *Endpoint) Handle(ctx context.Context, params http.ParamsScanner) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	// 1. validate params
+	if params == nil || !params.HasNext() {
+		return result, invalidActionError
+	}
+	action := params.Next()
+	if action != info_key && action != list_key && action != release_key {
+		return result, invalidActionError
+	}
+	data, err := http.GetRequestData(ctx)
+	if err != nil {
+		return result, err
+	}
+	storeName := getString(data, "store_name")
+	if storeName == "" {
+		return result, storeNameEmptyError
+	}
+	// 2. find store component
+	store, appId, ok := runtime_lock.GetLockStore(storeName)
+	if !ok {
+		return result, fmt.Errorf("lock store %s not found", storeName)
+	}
+	inspector, ok := store.(lock.InspectableLockStore)
+	if !ok {
+		return result, fmt.Errorf("lock store %s doesn't support lock info query", storeName)
+	}
+	result["store_name"] = storeName
+	// 3. delegate to the component
+	switch action {
+	case list_key:
+		return e.list(ctx, inspector, storeName, appId, data, result)
+	default:
+		return e.inspectOrRelease(ctx, action, inspector, storeName, appId, data, result)
+	}
+}
+
+func (e *Endpoint) list(ctx context.Context, store lock.InspectableLockStore, storeName string, appId string,
+	data map[string]interface{}, result map[string]interface{}) (map[string]interface{}, error) {
+	if !lock.FeatureListLock.IsPresent(store.Features()) {
+		return result, fmt.Errorf("lock store %s doesn't support listing locks", storeName)
+	}
+	prefix, err := runtime_lock.GetModifiedLockKey(getString(data, "prefix"), storeName, appId)
+	if err != nil {
+		return result, err
+	}
+	limit, _ := data["limit"].(float64)
+	resp, err := store.ListLocks(ctx, &lock.ListLocksRequest{Prefix: prefix, Limit: int32(limit)})
+	if err != nil {
+		log.DefaultLogger.Errorf("[actuator][lock] list locks of store %s error: %v", storeName, err)
+		return result, err
+	}
+	locks := make([]map[string]interface{}, 0, len(resp.Locks))
+	for _, info := range resp.Locks {
+		resourceId, ok := runtime_lock.GetOriginalLockKey(info.ResourceId, storeName, appId)
+		if !ok {
+			continue
+		}
+		locks = append(locks, map[string]interface{}{
+			"resource_id": resourceId,
+			"mode":        modeName(info.Mode),
+			"holders":     holders2Json(info.Holders),
+		})
+	}
+	result["locks"] = locks
+	return result, nil
+}
+
+func (e *Endpoint) inspectOrRelease(ctx context.Context, action string, store lock.InspectableLockStore, storeName string, appId string,
+	data map[string]interface{}, result map[string]interface{}) (map[string]interface{}, error) {
+	resourceId := getString(data, "resource_id")
+	if resourceId == "" {
+		return result, resourceIdEmptyError
+	}
+	operator := getString(data, "operator")
+	if action == release_key && operator == "" {
+		return result, operatorEmptyError
+	}
+	key, err := runtime_lock.GetModifiedLockKey(resourceId, storeName, appId)
+	if err != nil {
+		return result, err
+	}
+	result["resource_id"] = resourceId
+	// get the holders first, so that they can be written into the audit log
+	info, err := store.GetLockInfo(ctx, &lock.GetLockInfoRequest{ResourceId: key})
+	if err != nil {
+		log.DefaultLogger.Errorf("[actuator][lock] get lock info of %s in store %s error: %v", resourceId, storeName, err)
+		return result, err
+	}
+	holders := holders2Json(info.Holders)
+	result["mode"] = modeName(info.Mode)
+	result["holders"] = holders
+	if action == info_key {
+		return result, nil
+	}
+	resp, err := store.ForceUnlock(ctx, &lock.ForceUnlockRequest{ResourceId: key})
+	if err != nil {
+		log.DefaultLogger.Errorf("[actuator][lock][audit] operator %s failed to release lock %s in store %s, reason: %s, holders: %v, error: %v",
+			operator, resourceId, storeName, getString(data, "reason"), holders, err)
+		return result, err
+	}
+	status := statusName(resp.Status)
+	log.DefaultLogger.Infof("[actuator][lock][audit] operator %s released lock %s in store %s, reason: %s, holders: %v, status: %s",
+		operator, resourceId, storeName, getString(data, "reason"), holders, status)
+	result["status"] = status
+	return result, nil
+}
+
+func holders2Json(holders []*lock.LockHolder) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(holders))
+	for _, h := range holders {
+		holder := map[string]interface{}{
+			"lock_owner":    h.LockOwner,
+			"ttl_ms":        h.TTL.Milliseconds(),
+			"fencing_token": h.FencingToken,
+		}
+		// acquired_at is omitted if the store doesn't record it
+		if !h.AcquiredAt.IsZero() {
+			holder["acquired_at"] = h.AcquiredAt.Format(time.RFC3339Nano)
+		}
+		result = append(result, holder)
+	}
+	return result
+}
+
+func modeName(mode lock.LockMode) string {
+	if mode == lock.SHARED {
+		return "SHARED"
+	}
+	return "EXCLUSIVE"
+}
+
+func statusName(status lock.LockStatus) string {
+	switch status {
+	case lock.SUCCESS:
+		return "SUCCESS"
+	case lock.LOCK_UNEXIST:
+		return "LOCK_UNEXIST"
+	case lock.LOCK_BELONG_TO_OTHERS:
+		return "LOCK_BELONG_TO_OTHERS"
+	default:
+		return "INTERNAL_ERROR"
+	}
+}
+
+func getString(data map[string]interface{}, key string) string {
+	s, _ := data[key].(string)
+	return s
+}
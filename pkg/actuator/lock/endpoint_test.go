@@ -0,0 +1,113 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/lock"
+	lock_inmemory "mosn.io/layotto/components/lock/in-memory"
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+	runtime_lock "mosn.io/layotto/pkg/runtime/lock"
+)
+
+func newRequest(body string) context.Context {
+	return context.WithValue(context.Background(), http.ContextKeyRequestData{}, []byte(body))
+}
+
+func TestEndpoint_Handle(t *testing.T) {
+	store := lock_inmemory.NewInMemoryLock()
+	ep := NewEndpoint()
+	runtime_lock.SetLockStores("app1", map[string]lock.LockStore{"memory": store})
+	resp, err := store.TryLock(context.Background(), &lock.TryLockRequest{
+		ResourceId: "lock|||app1||order_1",
+		LockOwner:  "owner1",
+		Expire:     10,
+	})
+	assert.Nil(t, err)
+	assert.True(t, resp.Success)
+
+	t.Run("invalid action", func(t *testing.T) {
+		_, err := ep.Handle(newRequest(`{"store_name": "memory"}`), http.NewPathResolver("/unknown"))
+		assert.Equal(t, invalidActionError, err)
+		_, err = ep.Handle(newRequest(`{"store_name": "memory"}`), nil)
+		assert.Equal(t, invalidActionError, err)
+	})
+
+	t.Run("store not found", func(t *testing.T) {
+		_, err := ep.Handle(newRequest(`{"store_name": "redis", "resource_id": "order_1"}`), http.NewPathResolver("/info"))
+		assert.Equal(t, "lock store redis not found", err.Error())
+	})
+
+	t.Run("info", func(t *testing.T) {
+		result, err := ep.Handle(newRequest(`{"store_name": "memory", "resource_id": "order_1"}`), http.NewPathResolver("/info"))
+		assert.Nil(t, err)
+		assert.Equal(t, "EXCLUSIVE", result["mode"])
+		holders := result["holders"].([]map[string]interface{})
+		assert.Len(t, holders, 1)
+		assert.Equal(t, "owner1", holders[0]["lock_owner"])
+		assert.True(t, holders[0]["ttl_ms"].(int64) > 9000)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		result, err := ep.Handle(newRequest(`{"store_name": "memory", "prefix": "order_", "limit": 10}`), http.NewPathResolver("/list"))
+		assert.Nil(t, err)
+		locks := result["locks"].([]map[string]interface{})
+		assert.Len(t, locks, 1)
+		assert.Equal(t, "order_1", locks[0]["resource_id"])
+	})
+
+	t.Run("release without operator", func(t *testing.T) {
+		_, err := ep.Handle(newRequest(`{"store_name": "memory", "resource_id": "order_1"}`), http.NewPathResolver("/release"))
+		assert.Equal(t, operatorEmptyError, err)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		result, err := ep.Handle(newRequest(`{"store_name": "memory", "resource_id": "order_1", "operator": "alice", "reason": "stuck"}`),
+			http.NewPathResolver("/release"))
+		assert.Nil(t, err)
+		assert.Equal(t, "SUCCESS", result["status"])
+		assert.Len(t, result["holders"], 1)
+
+		resp, err := store.TryLock(context.Background(), &lock.TryLockRequest{
+			ResourceId: "lock|||app1||order_1",
+			LockOwner:  "owner2",
+			Expire:     10,
+		})
+		assert.Nil(t, err)
+		assert.True(t, resp.Success)
+	})
+}
+
+func TestHolders2Json(t *testing.T) {
+	acquiredAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := holders2Json([]*lock.LockHolder{
+		{LockOwner: "owner1", TTL: time.Second, AcquiredAt: acquiredAt, FencingToken: 1},
+		{LockOwner: "owner2", TTL: time.Second},
+	})
+	assert.Len(t, result, 2)
+	assert.Equal(t, "2023-01-01T00:00:00Z", result[0]["acquired_at"])
+	assert.Equal(t, int64(1000), result[0]["ttl_ms"])
+	// the store doesn't record the time
+	_, ok := result[1]["acquired_at"]
+	assert.False(t, ok)
+	assert.Equal(t, "owner2", result[1]["lock_owner"])
+}
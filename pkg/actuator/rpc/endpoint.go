@@ -0,0 +1,60 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"context"
+
+	"mosn.io/layotto/components/rpc"
+	"mosn.io/layotto/pkg/actuator"
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+	runtime_rpc "mosn.io/layotto/pkg/runtime/rpc"
+)
+
+const rpc_key = "rpc"
+
+// init rpc Endpoint.
+func init() {
+	actuator.GetDefault().AddEndpoint(rpc_key, NewEndpoint())
+}
+
+// Endpoint exposes the stats of the channels and the resiliency policies of each rpc invoker,
+// including the outstanding requests, the failures, the timeouts and the ejections of each channel,
+// and the retries, the exhausted deadline budgets and the circuit breaking of each policy.
+// The invokers are the ones initialized by the runtime.
+type Endpoint struct {
+}
+
+func NewEndpoint() *Endpoint {
+	return &Endpoint{}
+}
+
+// Handle returns the stats of the invokers which implement rpc.InspectableInvoker, keyed by the invoker name.
+func (e *Endpoint) Handle(ctx context.Context, params http.ParamsScanner) (map[string]interface{}, error) {
+	invokers := make(map[string]interface{})
+	for name, invoker := range runtime_rpc.GetInvokers() {
+		if inspector, ok := invoker.(rpc.InspectableInvoker); ok {
+			invokers[name] = map[string]interface{}{
+				"channels": inspector.ChannelStats(),
+				"policies": inspector.PolicyStats(),
+			}
+		}
+	}
+	result := make(map[string]interface{})
+	result["invokers"] = invokers
+	return result, nil
+}
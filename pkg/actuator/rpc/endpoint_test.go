@@ -0,0 +1,78 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/rpc"
+	runtime_rpc "mosn.io/layotto/pkg/runtime/rpc"
+)
+
+// plainInvoker doesn't report channel stats
+type plainInvoker struct {
+}
+
+func (p *plainInvoker) Init(config rpc.RpcConfig) error {
+	return nil
+}
+
+func (p *plainInvoker) Invoke(ctx context.Context, req *rpc.RPCRequest) (*rpc.RPCResponse, error) {
+	return &rpc.RPCResponse{}, nil
+}
+
+type fakeInvoker struct {
+	plainInvoker
+	channels []rpc.ChannelStats
+	policies []rpc.PolicyStats
+}
+
+func (f *fakeInvoker) ChannelStats() []rpc.ChannelStats {
+	return f.channels
+}
+
+func (f *fakeInvoker) PolicyStats() []rpc.PolicyStats {
+	return f.policies
+}
+
+func TestEndpoint_Handle(t *testing.T) {
+	runtime_rpc.SetInvokers(nil)
+	ep := NewEndpoint()
+	result, err := ep.Handle(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Empty(t, result["invokers"])
+
+	channels := []rpc.ChannelStats{
+		{Protocol: "http", Listener: "egress_a", Weight: 1, Requests: 10, Timeouts: 2},
+		{Protocol: "http", Listener: "egress_b", Weight: 2, Requests: 20, Ejected: true, Ejections: 1},
+	}
+	policies := []rpc.PolicyStats{
+		{Id: "hello", Calls: 10, Attempts: 12, Retries: 2, Rejected: 1, BreakerOpened: 1},
+	}
+	runtime_rpc.SetInvokers(map[string]rpc.Invoker{
+		"mosn":  &fakeInvoker{channels: channels, policies: policies},
+		"plain": &plainInvoker{},
+	})
+	result, err = ep.Handle(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"mosn": map[string]interface{}{"channels": channels, "policies": policies},
+	}, result["invokers"])
+}
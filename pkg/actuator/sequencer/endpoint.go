@@ -0,0 +1,45 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sequencer
+
+import (
+	"context"
+
+	"mosn.io/layotto/pkg/actuator"
+	"mosn.io/layotto/pkg/filter/stream/common/http"
+	runtime_sequencer "mosn.io/layotto/pkg/runtime/sequencer"
+)
+
+// init sequencer Endpoint.
+func init() {
+	actuator.GetDefault().AddEndpoint("sequencer", NewEndpoint())
+}
+
+// Endpoint exposes the state of the segment buffers cached by the runtime,
+// including the segment size, the threshold, the ids left and the refill statistics of each key.
+type Endpoint struct {
+}
+
+func NewEndpoint() *Endpoint {
+	return &Endpoint{}
+}
+
+func (e *Endpoint) Handle(ctx context.Context, params http.ParamsScanner) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	result["buffers"] = runtime_sequencer.GetBufferStates()
+	return result, nil
+}
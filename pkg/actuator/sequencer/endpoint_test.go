@@ -0,0 +1,52 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sequencer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/sequencer"
+	sequencer_inmemory "mosn.io/layotto/components/sequencer/in-memory"
+	runtime_sequencer "mosn.io/layotto/pkg/runtime/sequencer"
+)
+
+func TestEndpoint_Handle(t *testing.T) {
+	ep := NewEndpoint()
+	store := sequencer_inmemory.NewInMemorySequencer()
+	_, id, err := runtime_sequencer.GetNextIdFromCache(context.Background(), store, &sequencer.GetNextIdRequest{
+		Key: "actuator_test",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), id)
+
+	result, err := ep.Handle(context.Background(), nil)
+	assert.Nil(t, err)
+	var state *runtime_sequencer.BufferState
+	for _, s := range result["buffers"].([]*runtime_sequencer.BufferState) {
+		if s.Key == "actuator_test" {
+			state = s
+		}
+	}
+	assert.NotNil(t, state)
+	assert.Equal(t, 10000, state.Size)
+	assert.Equal(t, 1000, state.Threshold)
+	assert.Equal(t, int64(9999), state.Remaining)
+	assert.Equal(t, int64(1), state.Refills)
+}
@@ -199,22 +199,7 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 	}
 
 	// convert request to RPCRequest,which is the parameter for RPC components
-	req := &rpc.RPCRequest{
-		Ctx:         ctx,
-		Id:          in.Id,
-		Method:      msg.GetMethod(),
-		ContentType: msg.GetContentType(),
-		Data:        msg.GetData().GetValue(),
-	}
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		req.Header = rpc.RPCHeader(md)
-	} else {
-		req.Header = rpc.RPCHeader(map[string][]string{})
-	}
-	if ext := msg.GetHttpExtension(); ext != nil {
-		req.Header["verb"] = []string{ext.Verb.String()}
-		req.Header["query_string"] = []string{ext.GetQuerystring()}
-	}
+	req := newRPCRequest(ctx, in.Id, msg)
 
 	// route to the specific rpc.Invoker component.
 	// Only support mosn component now.
@@ -234,15 +219,7 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 		return nil, runtime_common.ToGrpcError(resp.Error)
 	}
 	if resp.Header != nil {
-		header := metadata.Pairs()
-		for k, values := range resp.Header {
-			// fix https://github.com/mosn/layotto/issues/285
-			if strings.EqualFold("content-length", k) {
-				continue
-			}
-			header.Set(k, values...)
-		}
-		grpc.SetHeader(ctx, header)
+		grpc.SetHeader(ctx, toResponseMetadata(resp.Header))
 	}
 
 	// convert resp
@@ -251,3 +228,37 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 		Data:        &anypb.Any{Value: resp.Data},
 	}, nil
 }
+
+// newRPCRequest converts the invoke message to RPCRequest, whose header is the grpc metadata with the http extension
+func newRPCRequest(ctx context.Context, id string, msg *runtimev1pb.CommonInvokeRequest) *rpc.RPCRequest {
+	req := &rpc.RPCRequest{
+		Ctx:         ctx,
+		Id:          id,
+		Method:      msg.GetMethod(),
+		ContentType: msg.GetContentType(),
+		Data:        msg.GetData().GetValue(),
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		req.Header = rpc.RPCHeader(md)
+	} else {
+		req.Header = rpc.RPCHeader(map[string][]string{})
+	}
+	if ext := msg.GetHttpExtension(); ext != nil {
+		req.Header["verb"] = []string{ext.Verb.String()}
+		req.Header["query_string"] = []string{ext.GetQuerystring()}
+	}
+	return req
+}
+
+// toResponseMetadata converts the header of the rpc response to the grpc metadata sent to the app
+func toResponseMetadata(header rpc.RPCHeader) metadata.MD {
+	md := metadata.Pairs()
+	for k, values := range header {
+		// fix https://github.com/mosn/layotto/issues/285
+		if strings.EqualFold("content-length", k) {
+			continue
+		}
+		md.Set(k, values...)
+	}
+	return md
+}
@@ -22,11 +22,15 @@ import (
 	"strings"
 	"sync"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"mosn.io/pkg/log"
 	"mosn.io/pkg/utils"
 
 	"mosn.io/layotto/components/configstores"
+	"mosn.io/layotto/pkg/messages"
+	runtime_configstores "mosn.io/layotto/pkg/runtime/configstores"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
 )
 
@@ -72,6 +76,10 @@ func (a *api) SaveConfiguration(ctx context.Context, req *runtimev1pb.SaveConfig
 		if strings.ReplaceAll(item.Label, " ", "") == "" {
 			req.Items[index].Label = store.GetDefaultLabel()
 		}
+		// reject the request if any content doesn't match the schema of its key
+		if err := runtime_configstores.ValidateContent(req.StoreName, item.Key, item.Content); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, messages.ErrConfigContentInvalid, item.Key, req.StoreName, err)
+		}
 		setReq.Items = append(setReq.Items, &configstores.ConfigurationItem{Group: item.Group, Label: item.Label, Key: item.Key, Content: item.Content, Tags: item.Tags, Metadata: item.Metadata})
 	}
 	err := store.Set(ctx, setReq)
@@ -94,6 +102,115 @@ func (a *api) DeleteConfiguration(ctx context.Context, req *runtimev1pb.DeleteCo
 	return &emptypb.Empty{}, err
 }
 
+// GetConfigurationHistory gets the revisions of a configuration from configuration store, the newest first.
+func (a *api) GetConfigurationHistory(ctx context.Context, req *runtimev1pb.GetConfigurationHistoryRequest) (*runtimev1pb.GetConfigurationHistoryResponse, error) {
+	// 1. find the store and check whether it supports history
+	store, ok := a.configStores[req.StoreName]
+	if !ok {
+		return nil, fmt.Errorf("configure store [%+v] don't support now", req.StoreName)
+	}
+	historyStore, ok := store.(configstores.HistoryStore)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, messages.ErrConfigHistoryNotSupported, req.StoreName)
+	}
+	// 2. validate and fill the default group and label
+	if req.Key == "" {
+		return nil, status.Errorf(codes.InvalidArgument, messages.ErrConfigKeyEmpty, req.StoreName)
+	}
+	if strings.ReplaceAll(req.Group, " ", "") == "" {
+		req.Group = store.GetDefaultGroup()
+	}
+	if strings.ReplaceAll(req.Label, " ", "") == "" {
+		req.Label = store.GetDefaultLabel()
+	}
+	// 3. delegate to the component
+	revisions, err := historyStore.GetHistory(ctx, &configstores.GetHistoryRequest{
+		AppId:    req.AppId,
+		Group:    req.Group,
+		Label:    req.Label,
+		Key:      req.Key,
+		Limit:    req.Limit,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetConfigurationHistory] error: %v", err)
+		return nil, err
+	}
+	// 4. convert the response
+	resp := &runtimev1pb.GetConfigurationHistoryResponse{}
+	for _, revision := range revisions {
+		var modifiedAt int64
+		if !revision.ModifiedAt.IsZero() {
+			modifiedAt = revision.ModifiedAt.UnixMilli()
+		}
+		resp.Revisions = append(resp.Revisions, &runtimev1pb.ConfigurationRevision{
+			Revision:   revision.Revision,
+			Content:    revision.Content,
+			ModifiedAt: modifiedAt,
+			Metadata:   revision.Metadata,
+		})
+	}
+	return resp, nil
+}
+
+// RollbackConfiguration restores a configuration to one of its revisions.
+func (a *api) RollbackConfiguration(ctx context.Context, req *runtimev1pb.RollbackConfigurationRequest) (*emptypb.Empty, error) {
+	// 1. find the store and check whether it supports history
+	store, ok := a.configStores[req.StoreName]
+	if !ok {
+		return nil, fmt.Errorf("configure store [%+v] don't support now", req.StoreName)
+	}
+	historyStore, ok := store.(configstores.HistoryStore)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, messages.ErrConfigHistoryNotSupported, req.StoreName)
+	}
+	// 2. validate and fill the default group and label
+	if req.Key == "" {
+		return nil, status.Errorf(codes.InvalidArgument, messages.ErrConfigKeyEmpty, req.StoreName)
+	}
+	if req.Revision == "" {
+		return nil, status.Errorf(codes.InvalidArgument, messages.ErrConfigRevisionEmpty, req.StoreName)
+	}
+	if strings.ReplaceAll(req.Group, " ", "") == "" {
+		req.Group = store.GetDefaultGroup()
+	}
+	if strings.ReplaceAll(req.Label, " ", "") == "" {
+		req.Label = store.GetDefaultLabel()
+	}
+	// 3. reject the revision whose content doesn't match the schema of its key, which may have changed since then
+	if runtime_configstores.HasSchema(req.StoreName, req.Key) {
+		revision, err := historyStore.GetRevision(ctx, &configstores.GetRevisionRequest{
+			AppId:    req.AppId,
+			Group:    req.Group,
+			Label:    req.Label,
+			Key:      req.Key,
+			Revision: req.Revision,
+			Metadata: req.Metadata,
+		})
+		if err != nil {
+			log.DefaultLogger.Errorf("[runtime] [grpc.RollbackConfiguration] error: %v", err)
+			return nil, err
+		}
+		if err := runtime_configstores.ValidateContent(req.StoreName, req.Key, revision.Content); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, messages.ErrConfigContentInvalid, req.Key, req.StoreName, err)
+		}
+	}
+	// 4. delegate to the component
+	err := historyStore.Rollback(ctx, &configstores.RollbackRequest{
+		AppId:    req.AppId,
+		Group:    req.Group,
+		Label:    req.Label,
+		Key:      req.Key,
+		Revision: req.Revision,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.RollbackConfiguration] error: %v", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // SubscribeConfiguration gets configuration from configuration store and subscribe the updates.
 func (a *api) SubscribeConfiguration(sub runtimev1pb.Runtime_SubscribeConfigurationServer) error {
 	wg := sync.WaitGroup{}
@@ -101,8 +218,13 @@ func (a *api) SubscribeConfiguration(sub runtimev1pb.Runtime_SubscribeConfigurat
 	var subErr error
 	respCh := make(chan *configstores.SubscribeResp)
 	recvExitCh := make(chan struct{})
-	subscribedStore := make([]configstores.Store, 0, 1)
-	// TODO currently this goroutine model is error-prone,and it should be refactored after new version of configuration API being accepted
+	subscriptions := make([]*runtime_configstores.Subscription, 0, 1)
+	// stopAll stops the subscriptions of this stream only, the others on the same stores are not affected
+	stopAll := func() {
+		for _, subscription := range subscriptions {
+			subscription.Stop()
+		}
+	}
 	// 1. start a reader goroutine
 	utils.GoWithRecover(func() {
 		defer wg.Done()
@@ -112,11 +234,7 @@ func (a *api) SubscribeConfiguration(sub runtimev1pb.Runtime_SubscribeConfigurat
 			// 1.2. if an error happens,stop all the subscribers
 			if err != nil {
 				log.DefaultLogger.Errorf("occur error in subscribe, err: %+v", err)
-				// stop all the subscribers
-				for _, store := range subscribedStore {
-					// TODO this method will stop subscribers created by other connections.Should be refactored
-					store.StopSubscribe()
-				}
+				stopAll()
 				subErr = err
 				// stop writer goroutine
 				close(recvExitCh)
@@ -127,10 +245,7 @@ func (a *api) SubscribeConfiguration(sub runtimev1pb.Runtime_SubscribeConfigurat
 			// 1.3.1. stop if StoreName is not supported
 			if !ok {
 				log.DefaultLogger.Errorf("configure store [%+v] don't support now", req.StoreName)
-				// stop all the subscribers
-				for _, store := range subscribedStore {
-					store.StopSubscribe()
-				}
+				stopAll()
 				subErr = fmt.Errorf("configure store [%+v] don't support now", req.StoreName)
 				// stop writer goroutine
 				close(recvExitCh)
@@ -143,9 +258,18 @@ func (a *api) SubscribeConfiguration(sub runtimev1pb.Runtime_SubscribeConfigurat
 			if strings.ReplaceAll(req.Label, " ", "") == "" {
 				req.Label = store.GetDefaultLabel()
 			}
-			// 1.3.3. delegate to the component
-			store.Subscribe(&configstores.SubscribeReq{AppId: req.AppId, Group: req.Group, Label: req.Label, Keys: req.Keys, Metadata: req.Metadata}, respCh)
-			subscribedStore = append(subscribedStore, store)
+			// 1.3.3. subscribe through the hub of the store, which replays the changes after since_revision
+			hub := runtime_configstores.GetHub(req.StoreName, store)
+			subscription, err := hub.Subscribe(&configstores.SubscribeReq{AppId: req.AppId, Group: req.Group, Label: req.Label, Keys: req.Keys, Metadata: req.Metadata}, req.SinceRevision, respCh)
+			if err != nil {
+				log.DefaultLogger.Errorf("[runtime] [grpc.SubscribeConfiguration] subscribe config store %s error: %v", req.StoreName, err)
+				stopAll()
+				subErr = err
+				// stop writer goroutine
+				close(recvExitCh)
+				return
+			}
+			subscriptions = append(subscriptions, subscription)
 		}
 	}, nil)
 	// 2. start a writer goroutine
@@ -159,11 +283,18 @@ func (a *api) SubscribeConfiguration(sub runtimev1pb.Runtime_SubscribeConfigurat
 					return
 				}
 				items := make([]*runtimev1pb.ConfigurationItem, 0, 10)
+				var rejected []*runtimev1pb.ConfigurationValidationResult
 				for _, item := range resp.Items {
+					// the invalid updates are reported instead of forwarded to the app
+					if err := runtime_configstores.ValidateContent(resp.StoreName, item.Key, item.Content); err != nil {
+						log.DefaultLogger.Errorf("[runtime] [grpc.SubscribeConfiguration] reject the update of key %s in config store %s: %v", item.Key, resp.StoreName, err)
+						rejected = append(rejected, &runtimev1pb.ConfigurationValidationResult{Group: item.Group, Label: item.Label, Key: item.Key, Error: err.Error()})
+						continue
+					}
 					items = append(items, &runtimev1pb.ConfigurationItem{Group: item.Group, Label: item.Label, Key: item.Key, Content: item.Content, Tags: item.Tags, Metadata: item.Metadata})
 				}
 				// write to response stream
-				sub.Send(&runtimev1pb.SubscribeConfigurationResponse{StoreName: resp.StoreName, AppId: resp.AppId, Items: items})
+				sub.Send(&runtimev1pb.SubscribeConfigurationResponse{StoreName: resp.StoreName, AppId: resp.AppId, Items: items, RejectedItems: rejected, Revision: resp.Revision})
 			//	read exit signal
 			case <-recvExitCh:
 				return
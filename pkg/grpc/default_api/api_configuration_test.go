@@ -18,16 +18,23 @@ package default_api
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"errors"
 
 	"mosn.io/layotto/components/configstores"
+	configstore_inmemory "mosn.io/layotto/components/configstores/in-memory"
 	"mosn.io/layotto/pkg/mock"
+	runtime_configstores "mosn.io/layotto/pkg/runtime/configstores"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
 )
 
@@ -157,3 +164,243 @@ func (m *MockGrpcServer) Send(res *runtimev1pb.SubscribeConfigurationResponse) e
 func (m *MockGrpcServer) Recv() (*runtimev1pb.SubscribeConfigurationRequest, error) {
 	return m.req, m.err
 }
+
+type fakeHistoryStore struct {
+	*mock.MockStore
+	revisions []*configstores.ConfigurationRevision
+	rollback  *configstores.RollbackRequest
+}
+
+func (f *fakeHistoryStore) GetHistory(ctx context.Context, req *configstores.GetHistoryRequest) ([]*configstores.ConfigurationRevision, error) {
+	return f.revisions, nil
+}
+
+func (f *fakeHistoryStore) GetRevision(ctx context.Context, req *configstores.GetRevisionRequest) (*configstores.ConfigurationRevision, error) {
+	for _, revision := range f.revisions {
+		if revision.Revision == req.Revision {
+			return revision, nil
+		}
+	}
+	return nil, errors.New("revision not found")
+}
+
+func (f *fakeHistoryStore) Rollback(ctx context.Context, req *configstores.RollbackRequest) error {
+	f.rollback = req
+	return nil
+}
+
+func TestGetConfigurationHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockConfigStore := mock.NewMockStore(ctrl)
+	historyStore := &fakeHistoryStore{
+		MockStore: mock.NewMockStore(ctrl),
+		revisions: []*configstores.ConfigurationRevision{
+			{Revision: "2", Content: "v2", ModifiedAt: time.UnixMilli(1672531200000)},
+			{Revision: "1", Content: "v1"},
+		},
+	}
+	a := NewAPI("", nil, map[string]configstores.Store{"mock": mockConfigStore, "history": historyStore}, nil, nil, nil, nil, nil, nil, nil, nil)
+	var apiForTest = a.(*api)
+
+	t.Run("not supported", func(t *testing.T) {
+		_, err := apiForTest.GetConfigurationHistory(context.Background(), &runtimev1pb.GetConfigurationHistoryRequest{StoreName: "mock", Key: "sofa"})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("key empty", func(t *testing.T) {
+		_, err := apiForTest.GetConfigurationHistory(context.Background(), &runtimev1pb.GetConfigurationHistoryRequest{StoreName: "history"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		res, err := apiForTest.GetConfigurationHistory(context.Background(), &runtimev1pb.GetConfigurationHistoryRequest{StoreName: "history", Key: "sofa"})
+		assert.Nil(t, err)
+		assert.Len(t, res.Revisions, 2)
+		assert.Equal(t, "2", res.Revisions[0].Revision)
+		assert.Equal(t, int64(1672531200000), res.Revisions[0].ModifiedAt)
+		assert.Equal(t, int64(0), res.Revisions[1].ModifiedAt)
+	})
+}
+
+func TestRollbackConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockConfigStore := mock.NewMockStore(ctrl)
+	historyStore := &fakeHistoryStore{MockStore: mock.NewMockStore(ctrl)}
+	a := NewAPI("", nil, map[string]configstores.Store{"mock": mockConfigStore, "history": historyStore}, nil, nil, nil, nil, nil, nil, nil, nil)
+	var apiForTest = a.(*api)
+
+	t.Run("not supported", func(t *testing.T) {
+		_, err := apiForTest.RollbackConfiguration(context.Background(), &runtimev1pb.RollbackConfigurationRequest{StoreName: "mock", Key: "sofa", Revision: "1"})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("revision empty", func(t *testing.T) {
+		_, err := apiForTest.RollbackConfiguration(context.Background(), &runtimev1pb.RollbackConfigurationRequest{StoreName: "history", Key: "sofa"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		_, err := apiForTest.RollbackConfiguration(context.Background(), &runtimev1pb.RollbackConfigurationRequest{StoreName: "history", AppId: "mosn", Key: "sofa", Revision: "1"})
+		assert.Nil(t, err)
+		assert.Equal(t, "default", historyStore.rollback.Group)
+		assert.Equal(t, "1", historyStore.rollback.Revision)
+	})
+
+	t.Run("content invalid", func(t *testing.T) {
+		historyStore.rollback = nil
+		historyStore.revisions = []*configstores.ConfigurationRevision{
+			{Revision: "2", Content: `{"port": 3306}`},
+			{Revision: "1", Content: `{"port": "3306"}`},
+		}
+		assert.Nil(t, runtime_configstores.SaveSchemaConfiguration("history", map[string]json.RawMessage{
+			"db": json.RawMessage(`{"type": "object", "properties": {"port": {"type": "integer"}}}`),
+		}))
+		defer runtime_configstores.SaveSchemaConfiguration("history", nil)
+
+		_, err := apiForTest.RollbackConfiguration(context.Background(), &runtimev1pb.RollbackConfigurationRequest{StoreName: "history", AppId: "mosn", Key: "db", Revision: "1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, historyStore.rollback)
+
+		_, err = apiForTest.RollbackConfiguration(context.Background(), &runtimev1pb.RollbackConfigurationRequest{StoreName: "history", AppId: "mosn", Key: "db", Revision: "3"})
+		assert.NotNil(t, err)
+		assert.Nil(t, historyStore.rollback)
+
+		_, err = apiForTest.RollbackConfiguration(context.Background(), &runtimev1pb.RollbackConfigurationRequest{StoreName: "history", AppId: "mosn", Key: "db", Revision: "2"})
+		assert.Nil(t, err)
+		assert.Equal(t, "2", historyStore.rollback.Revision)
+	})
+}
+
+type streamServer struct {
+	grpc.ServerStream
+	req        *runtimev1pb.SubscribeConfigurationRequest
+	received   bool
+	subscribed chan struct{}
+	stop       chan struct{}
+	sent       chan *runtimev1pb.SubscribeConfigurationResponse
+}
+
+func (s *streamServer) Send(res *runtimev1pb.SubscribeConfigurationResponse) error {
+	s.sent <- res
+	return nil
+}
+
+// Recv returns the request for the first time, then blocks until the stream is stopped
+func (s *streamServer) Recv() (*runtimev1pb.SubscribeConfigurationRequest, error) {
+	if !s.received {
+		s.received = true
+		return s.req, nil
+	}
+	close(s.subscribed)
+	<-s.stop
+	return nil, io.EOF
+}
+
+func TestConfigurationSchema(t *testing.T) {
+	err := runtime_configstores.SaveSchemaConfiguration("memory", map[string]json.RawMessage{
+		"db": json.RawMessage(`{"type": "object", "required": ["port"]}`),
+	})
+	assert.Nil(t, err)
+	defer runtime_configstores.SaveSchemaConfiguration("memory", nil)
+	store := configstore_inmemory.NewInMemoryConfigStore()
+	assert.Nil(t, store.Init(&configstores.StoreConfig{StoreName: "memory"}))
+	a := NewAPI("", nil, map[string]configstores.Store{"memory": store}, nil, nil, nil, nil, nil, nil, nil, nil)
+	var apiForTest = a.(*api)
+
+	t.Run("save invalid content", func(t *testing.T) {
+		_, err := apiForTest.SaveConfiguration(context.Background(), &runtimev1pb.SaveConfigurationRequest{
+			StoreName: "memory",
+			AppId:     "mosn",
+			Items:     []*runtimev1pb.ConfigurationItem{{Key: "db", Content: `{"host": "localhost"}`}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = apiForTest.SaveConfiguration(context.Background(), &runtimev1pb.SaveConfigurationRequest{
+			StoreName: "memory",
+			AppId:     "mosn",
+			Items:     []*runtimev1pb.ConfigurationItem{{Key: "db", Content: `{"port": 3306}`}},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("reject invalid updates", func(t *testing.T) {
+		server := &streamServer{
+			req:        &runtimev1pb.SubscribeConfigurationRequest{StoreName: "memory", AppId: "mosn", Keys: []string{"db", "name"}},
+			subscribed: make(chan struct{}),
+			stop:       make(chan struct{}),
+			sent:       make(chan *runtimev1pb.SubscribeConfigurationResponse, 1),
+		}
+		done := make(chan error)
+		go func() {
+			done <- apiForTest.SubscribeConfiguration(server)
+		}()
+		<-server.subscribed
+		// the store is updated by others
+		err := store.Set(context.Background(), &configstores.SetRequest{AppId: "mosn", Items: []*configstores.ConfigurationItem{
+			{Group: "default", Label: "default", Key: "db", Content: `{"host": "localhost"}`},
+			{Group: "default", Label: "default", Key: "name", Content: "layotto"},
+		}})
+		assert.Nil(t, err)
+		resp := <-server.sent
+		assert.Len(t, resp.Items, 1)
+		assert.Equal(t, "name", resp.Items[0].Key)
+		assert.Len(t, resp.RejectedItems, 1)
+		assert.Equal(t, "db", resp.RejectedItems[0].Key)
+		assert.NotEmpty(t, resp.RejectedItems[0].Error)
+
+		close(server.stop)
+		assert.Equal(t, io.EOF, <-done)
+	})
+}
+
+func TestSubscribeConfigurationResume(t *testing.T) {
+	store := configstore_inmemory.NewInMemoryConfigStore()
+	assert.Nil(t, store.Init(&configstores.StoreConfig{StoreName: "resumable"}))
+	a := NewAPI("", nil, map[string]configstores.Store{"resumable": store}, nil, nil, nil, nil, nil, nil, nil, nil)
+	var apiForTest = a.(*api)
+	subscribe := func(since int64) (*streamServer, chan error) {
+		server := &streamServer{
+			req:        &runtimev1pb.SubscribeConfigurationRequest{StoreName: "resumable", AppId: "mosn", Keys: []string{"name"}, SinceRevision: since},
+			subscribed: make(chan struct{}),
+			stop:       make(chan struct{}),
+			sent:       make(chan *runtimev1pb.SubscribeConfigurationResponse, 1),
+		}
+		done := make(chan error)
+		go func() {
+			done <- apiForTest.SubscribeConfiguration(server)
+		}()
+		<-server.subscribed
+		return server, done
+	}
+	set := func(content string) {
+		err := store.Set(context.Background(), &configstores.SetRequest{AppId: "mosn", Items: []*configstores.ConfigurationItem{
+			{Group: "default", Label: "default", Key: "name", Content: content},
+		}})
+		assert.Nil(t, err)
+	}
+	server1, done1 := subscribe(0)
+	server2, done2 := subscribe(0)
+	set("v1")
+	resp := <-server1.sent
+	revision := resp.Revision
+	assert.NotZero(t, revision)
+	assert.Equal(t, revision, (<-server2.sent).Revision)
+
+	// the subscription of the other stream is not stopped
+	close(server1.stop)
+	assert.Equal(t, io.EOF, <-done1)
+	set("v2")
+	resp = <-server2.sent
+	assert.Equal(t, "v2", resp.Items[0].Content)
+	assert.Equal(t, revision+1, resp.Revision)
+
+	// the change missed is replayed
+	server3, done3 := subscribe(revision)
+	resp = <-server3.sent
+	assert.Equal(t, "v2", resp.Items[0].Content)
+	assert.Equal(t, revision+1, resp.Revision)
+
+	close(server2.stop)
+	close(server3.stop)
+	assert.Equal(t, io.EOF, <-done2)
+	assert.Equal(t, io.EOF, <-done3)
+}
@@ -29,5 +29,8 @@ var (
 		file.ErrExist:      codes.AlreadyExists,
 		file.ErrExpired:    codes.DataLoss,
 		file.ErrPermission: codes.PermissionDenied,
+		file.ErrOffset:     codes.FailedPrecondition,
+		file.ErrRange:      codes.OutOfRange,
+		file.ErrPartSize:   codes.InvalidArgument,
 	}
 )
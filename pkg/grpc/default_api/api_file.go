@@ -19,6 +19,7 @@ package default_api
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -26,6 +27,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/pkg/messages"
 
 	"mosn.io/pkg/log"
 
@@ -33,17 +35,47 @@ import (
 )
 
 func (a *api) GetFile(req *runtimev1pb.GetFileRequest, stream runtimev1pb.Runtime_GetFileServer) error {
-	if a.fileOps[req.StoreName] == nil {
+	store := a.fileOps[req.StoreName]
+	if store == nil {
 		return status.Errorf(codes.InvalidArgument, "not supported store type: %+v", req.StoreName)
 	}
 	if req.Metadata == nil {
 		req.Metadata = make(map[string]string)
 	}
-	st := &file.GetFileStu{FileName: req.Name, Metadata: req.Metadata}
-	data, err := a.fileOps[req.StoreName].Get(stream.Context(), st)
+	// the whole file is read by Get from the stores without ranged reads, whose ETag and size are unknown
+	fileResp := &file.GetRangeResp{}
+	var err error
+	if reader, ok := store.(file.RangeReader); ok {
+		st := &file.GetRangeRequest{
+			FileName:    req.Name,
+			Metadata:    req.Metadata,
+			Offset:      req.Offset,
+			Length:      req.Length,
+			IfNoneMatch: req.IfNoneMatch,
+		}
+		if req.IfModifiedSince > 0 {
+			st.IfModifiedSince = time.Unix(req.IfModifiedSince, 0)
+		}
+		fileResp, err = reader.GetRange(stream.Context(), st)
+	} else if req.Offset != 0 || req.Length != 0 || req.IfNoneMatch != "" || req.IfModifiedSince != 0 {
+		return status.Errorf(codes.Unimplemented, messages.ErrRangeReadNotSupported, req.StoreName)
+	} else {
+		fileResp.DataStream, err = store.Get(stream.Context(), &file.GetFileStu{FileName: req.Name, Metadata: req.Metadata})
+	}
 	if err != nil {
-		return status.Errorf(codes.Internal, "get file fail,err: %+v", err)
+		errCode := codes.Internal
+		if code, ok := FileErrMap2GrpcErr[err]; ok {
+			errCode = code
+		}
+		return status.Errorf(errCode, "get file fail,err: %+v", err)
 	}
+	if fileResp.NotModified {
+		if err = stream.Send(&runtimev1pb.GetFileResponse{Etag: fileResp.ETag, Size: fileResp.Size, NotModified: true}); err != nil {
+			return status.Errorf(codes.Internal, "send file data fail,err: %+v", err)
+		}
+		return nil
+	}
+	data := fileResp.DataStream
 
 	buffsPtr := bytesPool.Get().(*[]byte)
 	buf := *buffsPtr
@@ -56,14 +88,21 @@ func (a *api) GetFile(req *runtimev1pb.GetFileRequest, stream runtimev1pb.Runtim
 		bytesPool.Put(buffsPtr)
 	}()
 
+	// the etag and the size are sent in the first message, even if the file is empty
+	first := true
 	for {
 		length, err := data.Read(buf)
 		if err != nil && err != io.EOF {
 			log.DefaultLogger.Warnf("get file fail, err: %+v", err)
 			return status.Errorf(codes.Internal, "get file fail,err: %+v", err)
 		}
-		if err == nil || (err == io.EOF && length != 0) {
+		if err == nil || length != 0 || first {
 			resp := &runtimev1pb.GetFileResponse{Data: buf[:length]}
+			if first {
+				resp.Etag = fileResp.ETag
+				resp.Size = fileResp.Size
+				first = false
+			}
 			if err = stream.Send(resp); err != nil {
 				return status.Errorf(codes.Internal, "send file data fail,err: %+v", err)
 			}
@@ -208,3 +247,208 @@ func (a *api) GetFileMeta(ctx context.Context, in *runtimev1pb.GetFileMetaReques
 	}
 	return &runtimev1pb.GetFileMetaResponse{Size: resp.Size, LastModified: resp.LastModified, Response: meta}, nil
 }
+
+func (a *api) getResumableUploader(storeName string) (file.ResumableUploader, error) {
+	store := a.fileOps[storeName]
+	if store == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", storeName)
+	}
+	uploader, ok := store.(file.ResumableUploader)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, messages.ErrResumableUploadNotSupported, storeName)
+	}
+	return uploader, nil
+}
+
+func fileErr2GrpcErr(err error) error {
+	errCode := codes.Internal
+	if code, ok := FileErrMap2GrpcErr[err]; ok {
+		errCode = code
+	}
+	return status.Errorf(errCode, "error occurred: %v", err.Error())
+}
+
+// InitiateFileUpload initiates a resumable upload
+func (a *api) InitiateFileUpload(ctx context.Context, in *runtimev1pb.InitiateFileUploadRequest) (*runtimev1pb.InitiateFileUploadResponse, error) {
+	uploader, err := a.getResumableUploader(in.StoreName)
+	if err != nil {
+		return nil, err
+	}
+	if in.Metadata == nil {
+		in.Metadata = make(map[string]string)
+	}
+	resp, err := uploader.InitiateUpload(ctx, &file.InitiateUploadRequest{FileName: in.Name, Metadata: in.Metadata})
+	if err != nil {
+		return nil, fileErr2GrpcErr(err)
+	}
+	return &runtimev1pb.InitiateFileUploadResponse{UploadId: resp.UploadId}, nil
+}
+
+type uploadPartStreamReader struct {
+	data   []byte
+	server runtimev1pb.Runtime_UploadFilePartServer
+}
+
+func (r *uploadPartStreamReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		req, err := r.server.Recv()
+		if err != nil {
+			if err != io.EOF {
+				log.DefaultLogger.Errorf("recv data from grpc stream fail, err:%+v", err)
+			}
+			return 0, err
+		}
+		r.data = req.Data
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// UploadFilePart uploads a part of file from the stream.
+// The part is not committed if the stream is broken.
+func (a *api) UploadFilePart(stream runtimev1pb.Runtime_UploadFilePartServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return status.Errorf(codes.InvalidArgument, "request can't be empty")
+		}
+		return status.Errorf(codes.Internal, "receive file data fail: err: %+v", err)
+	}
+	uploader, err := a.getResumableUploader(req.StoreName)
+	if err != nil {
+		return err
+	}
+	if req.Metadata == nil {
+		req.Metadata = make(map[string]string)
+	}
+	resp, err := uploader.UploadPart(stream.Context(), &file.UploadPartRequest{
+		FileName:   req.Name,
+		UploadId:   req.UploadId,
+		Offset:     req.Offset,
+		Size:       req.Size,
+		DataStream: &uploadPartStreamReader{data: req.Data, server: stream},
+		Metadata:   req.Metadata,
+	})
+	if err != nil {
+		return fileErr2GrpcErr(err)
+	}
+	return stream.SendAndClose(&runtimev1pb.FileUploadStatusResponse{CommittedSize: resp.CommittedSize})
+}
+
+// GetFileUploadStatus gets the committed size of a resumable upload
+func (a *api) GetFileUploadStatus(ctx context.Context, in *runtimev1pb.FileUploadRequest) (*runtimev1pb.FileUploadStatusResponse, error) {
+	uploader, err := a.getResumableUploader(in.StoreName)
+	if err != nil {
+		return nil, err
+	}
+	if in.Metadata == nil {
+		in.Metadata = make(map[string]string)
+	}
+	resp, err := uploader.GetUploadStatus(ctx, &file.UploadRequest{FileName: in.Name, UploadId: in.UploadId, Metadata: in.Metadata})
+	if err != nil {
+		return nil, fileErr2GrpcErr(err)
+	}
+	return &runtimev1pb.FileUploadStatusResponse{CommittedSize: resp.CommittedSize}, nil
+}
+
+// CompleteFileUpload completes a resumable upload
+func (a *api) CompleteFileUpload(ctx context.Context, in *runtimev1pb.FileUploadRequest) (*emptypb.Empty, error) {
+	uploader, err := a.getResumableUploader(in.StoreName)
+	if err != nil {
+		return nil, err
+	}
+	if in.Metadata == nil {
+		in.Metadata = make(map[string]string)
+	}
+	if err = uploader.CompleteUpload(ctx, &file.UploadRequest{FileName: in.Name, UploadId: in.UploadId, Metadata: in.Metadata}); err != nil {
+		return nil, fileErr2GrpcErr(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AbortFileUpload aborts a resumable upload
+func (a *api) AbortFileUpload(ctx context.Context, in *runtimev1pb.FileUploadRequest) (*emptypb.Empty, error) {
+	uploader, err := a.getResumableUploader(in.StoreName)
+	if err != nil {
+		return nil, err
+	}
+	if in.Metadata == nil {
+		in.Metadata = make(map[string]string)
+	}
+	if err = uploader.AbortUpload(ctx, &file.UploadRequest{FileName: in.Name, UploadId: in.UploadId, Metadata: in.Metadata}); err != nil {
+		return nil, fileErr2GrpcErr(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CopyFile copies the file in the same store by the store itself,
+// or streams the file from the source store to the target store without buffering the whole file.
+func (a *api) CopyFile(ctx context.Context, in *runtimev1pb.CopyFileRequest) (*emptypb.Empty, error) {
+	if in.Metadata == nil {
+		in.Metadata = make(map[string]string)
+	}
+	source, target, err := a.getCopyStores(in.SourceStoreName, in.TargetStoreName)
+	if err != nil {
+		return nil, err
+	}
+	if source == target {
+		err = source.Copy(ctx, &file.CopyRequest{SourceName: in.SourceName, TargetName: in.TargetName, Metadata: in.Metadata})
+	} else {
+		err = copyBetweenStores(ctx, source, in.SourceName, target, in.TargetName, in.Metadata)
+	}
+	if err != nil {
+		return nil, fileErr2GrpcErr(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RenameFile renames the file in the same store by the store itself,
+// or copies the file to the target store and deletes the source file.
+func (a *api) RenameFile(ctx context.Context, in *runtimev1pb.RenameFileRequest) (*emptypb.Empty, error) {
+	if in.Metadata == nil {
+		in.Metadata = make(map[string]string)
+	}
+	source, target, err := a.getCopyStores(in.SourceStoreName, in.TargetStoreName)
+	if err != nil {
+		return nil, err
+	}
+	if source == target {
+		err = source.Rename(ctx, &file.RenameRequest{SourceName: in.SourceName, TargetName: in.TargetName, Metadata: in.Metadata})
+	} else {
+		err = copyBetweenStores(ctx, source, in.SourceName, target, in.TargetName, in.Metadata)
+		if err == nil {
+			err = source.Del(ctx, &file.DelRequest{FileName: in.SourceName, Metadata: in.Metadata})
+		}
+	}
+	if err != nil {
+		return nil, fileErr2GrpcErr(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getCopyStores returns the source store and the target store, which is the source store if targetStoreName is empty
+func (a *api) getCopyStores(sourceStoreName string, targetStoreName string) (file.File, file.File, error) {
+	source := a.fileOps[sourceStoreName]
+	if source == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", sourceStoreName)
+	}
+	if targetStoreName == "" || targetStoreName == sourceStoreName {
+		return source, source, nil
+	}
+	target := a.fileOps[targetStoreName]
+	if target == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "not support store type: %+v", targetStoreName)
+	}
+	return source, target, nil
+}
+
+// copyBetweenStores puts the data stream read from the source store to the target store directly
+func copyBetweenStores(ctx context.Context, source file.File, sourceName string, target file.File, targetName string, metadata map[string]string) error {
+	data, err := source.Get(ctx, &file.GetFileStu{FileName: sourceName, Metadata: metadata})
+	if err != nil {
+		return err
+	}
+	defer data.Close()
+	return target.Put(ctx, &file.PutFileStu{DataStream: data, FileName: targetName, Metadata: metadata})
+}
@@ -20,15 +20,20 @@ import (
 	"context"
 	"errors"
 	"io"
+	"io/ioutil"
 	"net"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/file/local"
 	"mosn.io/layotto/pkg/mock"
 	"mosn.io/layotto/pkg/mock/runtime"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
@@ -54,6 +59,39 @@ func TestGetFile(t *testing.T) {
 	go SendData(w)
 	err = apiForTest.GetFile(&runtimev1pb.GetFileRequest{StoreName: "mock"}, mockStream)
 	assert.Nil(t, err)
+
+	// the store doesn't support ranged reads
+	err = apiForTest.GetFile(&runtimev1pb.GetFileRequest{StoreName: "mock", Offset: 1}, mockStream)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestGetFileRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStream := runtime.NewMockRuntime_GetFileServer(ctrl)
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
+	a := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"local": &local.LocalStore{}}, nil, nil, nil, nil)
+	var apiForTest = a.(*api)
+	name := filepath.Join(t.TempDir(), "a.txt")
+	assert.Nil(t, ioutil.WriteFile(name, []byte("hello world"), 0644))
+
+	var etag string
+	mockStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *runtimev1pb.GetFileResponse) error {
+		assert.Equal(t, "world", string(resp.Data))
+		assert.Equal(t, int64(11), resp.Size)
+		assert.False(t, resp.NotModified)
+		etag = resp.Etag
+		return nil
+	}).Times(1)
+	err := apiForTest.GetFile(&runtimev1pb.GetFileRequest{StoreName: "local", Name: name, Offset: 6}, mockStream)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, etag)
+
+	mockStream.EXPECT().Send(&runtimev1pb.GetFileResponse{Etag: etag, Size: 11, NotModified: true}).Times(1)
+	err = apiForTest.GetFile(&runtimev1pb.GetFileRequest{StoreName: "local", Name: name, IfNoneMatch: etag}, mockStream)
+	assert.Nil(t, err)
+
+	err = apiForTest.GetFile(&runtimev1pb.GetFileRequest{StoreName: "local", Name: name, Offset: 12}, mockStream)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
 }
 
 func TestPutFile(t *testing.T) {
@@ -156,3 +194,144 @@ func TestGetFileMeta(t *testing.T) {
 	assert.Equal(t, resp.LastModified, "123")
 	assert.Equal(t, int(resp.Size), 10)
 }
+
+type mockUploadFilePartServer struct {
+	grpc.ServerStream
+	reqs []*runtimev1pb.UploadFilePartRequest
+	err  error
+	resp *runtimev1pb.FileUploadStatusResponse
+}
+
+// Recv returns the requests in order, then the error or io.EOF
+func (s *mockUploadFilePartServer) Recv() (*runtimev1pb.UploadFilePartRequest, error) {
+	if len(s.reqs) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *mockUploadFilePartServer) SendAndClose(resp *runtimev1pb.FileUploadStatusResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func (s *mockUploadFilePartServer) Context() context.Context {
+	return context.Background()
+}
+
+func TestResumableFileUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	a := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"mock": mock.NewMockFile(ctrl), "local": local.NewLocalStore()}, nil, nil, nil, nil)
+	var apiForTest = a.(*api)
+	name := filepath.Join(t.TempDir(), "a.txt")
+
+	_, err := apiForTest.InitiateFileUpload(context.Background(), &runtimev1pb.InitiateFileUploadRequest{StoreName: "mock1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = apiForTest.InitiateFileUpload(context.Background(), &runtimev1pb.InitiateFileUploadRequest{StoreName: "mock"})
+	assert.Equal(t, status.Errorf(codes.Unimplemented, "file store mock doesn't support resumable upload"), err)
+
+	resp, err := apiForTest.InitiateFileUpload(context.Background(), &runtimev1pb.InitiateFileUploadRequest{StoreName: "local", Name: name})
+	assert.Nil(t, err)
+	uploadId := resp.UploadId
+
+	t.Run("broken part is not committed", func(t *testing.T) {
+		server := &mockUploadFilePartServer{
+			reqs: []*runtimev1pb.UploadFilePartRequest{
+				{StoreName: "local", Name: name, UploadId: uploadId, Data: []byte("hello")},
+			},
+			err: errors.New("broken"),
+		}
+		err := apiForTest.UploadFilePart(server)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		resp, err := apiForTest.GetFileUploadStatus(context.Background(), &runtimev1pb.FileUploadRequest{StoreName: "local", Name: name, UploadId: uploadId})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), resp.CommittedSize)
+	})
+
+	t.Run("upload parts", func(t *testing.T) {
+		server := &mockUploadFilePartServer{
+			reqs: []*runtimev1pb.UploadFilePartRequest{
+				{StoreName: "local", Name: name, UploadId: uploadId, Size: 5, Data: []byte("hel")},
+				{Data: []byte("lo")},
+			},
+		}
+		assert.Nil(t, apiForTest.UploadFilePart(server))
+		assert.Equal(t, int64(5), server.resp.CommittedSize)
+
+		server = &mockUploadFilePartServer{
+			reqs: []*runtimev1pb.UploadFilePartRequest{
+				{StoreName: "local", Name: name, UploadId: uploadId, Offset: 3, Data: []byte(" world")},
+			},
+		}
+		assert.Equal(t, codes.FailedPrecondition, status.Code(apiForTest.UploadFilePart(server)))
+
+		server = &mockUploadFilePartServer{
+			reqs: []*runtimev1pb.UploadFilePartRequest{
+				{StoreName: "local", Name: name, UploadId: uploadId, Offset: 5, Data: []byte(" world")},
+			},
+		}
+		assert.Nil(t, apiForTest.UploadFilePart(server))
+		assert.Equal(t, int64(11), server.resp.CommittedSize)
+	})
+
+	_, err = apiForTest.CompleteFileUpload(context.Background(), &runtimev1pb.FileUploadRequest{StoreName: "local", Name: name, UploadId: uploadId})
+	assert.Nil(t, err)
+	data, err := ioutil.ReadFile(name)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", string(data))
+
+	_, err = apiForTest.GetFileUploadStatus(context.Background(), &runtimev1pb.FileUploadRequest{StoreName: "local", Name: name, UploadId: uploadId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = apiForTest.AbortFileUpload(context.Background(), &runtimev1pb.FileUploadRequest{StoreName: "local", Name: name, UploadId: uploadId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCopyAndRenameFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	source := mock.NewMockFile(ctrl)
+	target := mock.NewMockFile(ctrl)
+	a := NewAPI("", nil, nil, nil, nil, nil, map[string]file.File{"source": source, "target": target}, nil, nil, nil, nil)
+	var apiForTest = a.(*api)
+	ctx := context.Background()
+	metadata := make(map[string]string)
+
+	_, err := apiForTest.CopyFile(ctx, &runtimev1pb.CopyFileRequest{SourceStoreName: "mock"})
+	assert.Equal(t, err, status.Errorf(codes.InvalidArgument, "not support store type: mock"))
+	_, err = apiForTest.RenameFile(ctx, &runtimev1pb.RenameFileRequest{SourceStoreName: "source", TargetStoreName: "mock"})
+	assert.Equal(t, err, status.Errorf(codes.InvalidArgument, "not support store type: mock"))
+
+	// in the same store
+	source.EXPECT().Copy(ctx, &file.CopyRequest{SourceName: "a", TargetName: "b", Metadata: metadata}).Return(nil).Times(1)
+	_, err = apiForTest.CopyFile(ctx, &runtimev1pb.CopyFileRequest{SourceStoreName: "source", SourceName: "a", TargetName: "b"})
+	assert.Nil(t, err)
+	source.EXPECT().Rename(ctx, &file.RenameRequest{SourceName: "a", TargetName: "b", Metadata: metadata}).Return(file.ErrNotExist).Times(1)
+	_, err = apiForTest.RenameFile(ctx, &runtimev1pb.RenameFileRequest{SourceStoreName: "source", SourceName: "a", TargetStoreName: "source", TargetName: "b"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// across the stores
+	put := func(ctx context.Context, st *file.PutFileStu) error {
+		data, err := ioutil.ReadAll(st.DataStream)
+		assert.Nil(t, err)
+		assert.Equal(t, "testFile", string(data))
+		assert.Equal(t, "b", st.FileName)
+		return nil
+	}
+	source.EXPECT().Get(ctx, &file.GetFileStu{FileName: "a", Metadata: metadata}).DoAndReturn(func(context.Context, *file.GetFileStu) (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader("testFile")), nil
+	}).Times(2)
+	target.EXPECT().Put(ctx, gomock.Any()).DoAndReturn(put).Times(2)
+	_, err = apiForTest.CopyFile(ctx, &runtimev1pb.CopyFileRequest{SourceStoreName: "source", SourceName: "a", TargetStoreName: "target", TargetName: "b"})
+	assert.Nil(t, err)
+	source.EXPECT().Del(ctx, &file.DelRequest{FileName: "a", Metadata: metadata}).Return(nil).Times(1)
+	_, err = apiForTest.RenameFile(ctx, &runtimev1pb.RenameFileRequest{SourceStoreName: "source", SourceName: "a", TargetStoreName: "target", TargetName: "b"})
+	assert.Nil(t, err)
+
+	source.EXPECT().Get(ctx, &file.GetFileStu{FileName: "c", Metadata: metadata}).Return(nil, file.ErrNotExist).Times(1)
+	_, err = apiForTest.CopyFile(ctx, &runtimev1pb.CopyFileRequest{SourceStoreName: "source", SourceName: "c", TargetStoreName: "target", TargetName: "b"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package default_api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"mosn.io/pkg/log"
+
+	runtime_common "mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+	mosninvoker "mosn.io/layotto/components/rpc/invoker/mosn"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// invokeStreamReader reads the request data from the messages of the app's stream.
+// The next message is received only when the channel asks for more data,
+// so the back-pressure of the upstream connection propagates to the app's stream.
+type invokeStreamReader struct {
+	first []byte
+	recv  func() (*runtimev1pb.InvokeServiceStreamRequest, error)
+}
+
+func (r *invokeStreamReader) Recv() ([]byte, error) {
+	if len(r.first) > 0 {
+		data := r.first
+		r.first = nil
+		return data, nil
+	}
+	req, err := r.recv()
+	if err != nil {
+		if err != io.EOF {
+			log.DefaultLogger.Errorf("recv data from grpc stream fail, err:%+v", err)
+		}
+		return nil, err
+	}
+	return req.Data, nil
+}
+
+// InvokeServiceClientStream invokes with the request data streamed from the app, and returns the whole response
+func (a *api) InvokeServiceClientStream(stream runtimev1pb.Runtime_InvokeServiceClientStreamServer) error {
+	resp, err := a.invokeStream(stream.Context(), stream.Recv)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := stream.SetHeader(toResponseMetadata(resp.Header)); err != nil {
+		return err
+	}
+	var data bytes.Buffer
+	for {
+		chunk, err := resp.Body.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return runtime_common.ToGrpcError(err)
+		}
+		data.Write(chunk)
+	}
+	return stream.SendAndClose(&runtimev1pb.InvokeResponse{
+		ContentType: resp.ContentType,
+		Data:        &anypb.Any{Value: data.Bytes()},
+	})
+}
+
+// InvokeServiceServerStream invokes with the whole request, and streams the response data to the app
+func (a *api) InvokeServiceServerStream(in *runtimev1pb.InvokeServiceRequest, stream runtimev1pb.Runtime_InvokeServiceServerStreamServer) error {
+	msg := in.GetMessage()
+	// the request is sent as a single message
+	sent := false
+	resp, err := a.invokeStream(stream.Context(), func() (*runtimev1pb.InvokeServiceStreamRequest, error) {
+		if sent {
+			return nil, io.EOF
+		}
+		sent = true
+		return &runtimev1pb.InvokeServiceStreamRequest{
+			Id:            in.GetId(),
+			Method:        msg.GetMethod(),
+			ContentType:   msg.GetContentType(),
+			HttpExtension: msg.GetHttpExtension(),
+			Data:          msg.GetData().GetValue(),
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sendInvokeStream(stream, resp)
+}
+
+// InvokeServiceBidiStream streams the request data from the app and the response data to the app at the same time
+func (a *api) InvokeServiceBidiStream(stream runtimev1pb.Runtime_InvokeServiceBidiStreamServer) error {
+	resp, err := a.invokeStream(stream.Context(), stream.Recv)
+	if err != nil {
+		return err
+	}
+	return sendInvokeStream(stream, resp)
+}
+
+// invokeStream starts the stream with the header in the first message, whose data is the first chunk of the request
+func (a *api) invokeStream(ctx context.Context, recv func() (*runtimev1pb.InvokeServiceStreamRequest, error)) (*rpc.RPCStreamResponse, error) {
+	// 1. the header is read from the first message
+	first, err := recv()
+	if err != nil {
+		if err == io.EOF {
+			return nil, status.Error(codes.InvalidArgument, "missing the first message of the invocation")
+		}
+		return nil, err
+	}
+	msg := &runtimev1pb.CommonInvokeRequest{
+		Method:        first.Method,
+		ContentType:   first.ContentType,
+		HttpExtension: first.HttpExtension,
+	}
+	req := &rpc.RPCStreamRequest{
+		RPCRequest: *newRPCRequest(ctx, first.Id, msg),
+		Body:       &invokeStreamReader{first: first.Data, recv: recv},
+	}
+
+	// 2. route to the mosn invoker, which must support streaming
+	invoker, ok := a.rpcs[mosninvoker.Name]
+	if !ok {
+		return nil, errors.New("invoker not init")
+	}
+	streamInvoker, ok := invoker.(rpc.StreamInvoker)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "invoker %s doesn't support streaming", mosninvoker.Name)
+	}
+	resp, err := streamInvoker.InvokeStream(ctx, req)
+	if err != nil {
+		return nil, runtime_common.ToGrpcError(err)
+	}
+	return resp, nil
+}
+
+// invokeStreamServer is the sending side of the server- and bidi-streaming invocations
+type invokeStreamServer interface {
+	SetHeader(metadata.MD) error
+	Send(*runtimev1pb.InvokeServiceStreamResponse) error
+}
+
+// sendInvokeStream sends every chunk of the response data as a message.
+// The content type is sent in the first message, even if there is no data.
+func sendInvokeStream(stream invokeStreamServer, resp *rpc.RPCStreamResponse) error {
+	defer resp.Body.Close()
+	if err := stream.SetHeader(toResponseMetadata(resp.Header)); err != nil {
+		return err
+	}
+	first := true
+	for {
+		chunk, err := resp.Body.Recv()
+		if err != nil && err != io.EOF {
+			return runtime_common.ToGrpcError(err)
+		}
+		if err == nil || first {
+			out := &runtimev1pb.InvokeServiceStreamResponse{Data: chunk}
+			if first {
+				out.ContentType = resp.ContentType
+				first = false
+			}
+			if err := stream.Send(out); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package default_api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	runtime_common "mosn.io/layotto/components/pkg/common"
+	"mosn.io/layotto/components/rpc"
+	mosninvoker "mosn.io/layotto/components/rpc/invoker/mosn"
+	mock_invoker "mosn.io/layotto/pkg/mock/components/invoker"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// upperStreamInvoker streams back the request data in upper case
+type upperStreamInvoker struct {
+	rpc.Invoker
+	req  *rpc.RPCStreamRequest
+	body *upperChunks
+	err  error
+}
+
+func (i *upperStreamInvoker) InvokeStream(ctx context.Context, req *rpc.RPCStreamRequest) (*rpc.RPCStreamResponse, error) {
+	if i.err != nil {
+		return nil, i.err
+	}
+	i.req = req
+	i.body = &upperChunks{body: req.Body}
+	return &rpc.RPCStreamResponse{
+		Header:      rpc.RPCHeader{"x-echo": []string{"1"}, "Content-Length": []string{"10"}},
+		ContentType: "text/plain",
+		Body:        i.body,
+	}, nil
+}
+
+type upperChunks struct {
+	body   rpc.ChunkReader
+	closed bool
+}
+
+func (c *upperChunks) Recv() ([]byte, error) {
+	chunk, err := c.body.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ToUpper(chunk), nil
+}
+
+func (c *upperChunks) Close() error {
+	c.closed = true
+	return nil
+}
+
+type mockInvokeStreamServer struct {
+	grpc.ServerStream
+	reqs   []*runtimev1pb.InvokeServiceStreamRequest
+	header metadata.MD
+	sent   []*runtimev1pb.InvokeServiceStreamResponse
+	resp   *runtimev1pb.InvokeResponse
+}
+
+func (s *mockInvokeStreamServer) Recv() (*runtimev1pb.InvokeServiceStreamRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *mockInvokeStreamServer) Send(resp *runtimev1pb.InvokeServiceStreamResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func (s *mockInvokeStreamServer) SendAndClose(resp *runtimev1pb.InvokeResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func (s *mockInvokeStreamServer) SetHeader(md metadata.MD) error {
+	s.header = md
+	return nil
+}
+
+func (s *mockInvokeStreamServer) Context() context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-token", "abc"))
+}
+
+func newStreamRequests() []*runtimev1pb.InvokeServiceStreamRequest {
+	return []*runtimev1pb.InvokeServiceStreamRequest{
+		{
+			Id:            "id1",
+			Method:        "/hello",
+			ContentType:   "text/plain",
+			HttpExtension: &runtimev1pb.HTTPExtension{Verb: runtimev1pb.HTTPExtension_PUT, Querystring: "a=1"},
+			Data:          []byte("hello "),
+		},
+		{Data: []byte("world")},
+	}
+}
+
+func newStreamTestAPI(invoker rpc.Invoker) *api {
+	a := NewAPI("", nil, nil, map[string]rpc.Invoker{mosninvoker.Name: invoker}, nil, nil, nil, nil, nil, nil, nil)
+	return a.(*api)
+}
+
+func TestInvokeServiceStream(t *testing.T) {
+	t.Run("client stream", func(t *testing.T) {
+		invoker := &upperStreamInvoker{}
+		stream := &mockInvokeStreamServer{reqs: newStreamRequests()}
+		err := newStreamTestAPI(invoker).InvokeServiceClientStream(stream)
+		assert.Nil(t, err)
+		assert.Equal(t, "id1", invoker.req.Id)
+		assert.Equal(t, "/hello", invoker.req.Method)
+		assert.Equal(t, "text/plain", invoker.req.ContentType)
+		assert.Equal(t, "PUT", invoker.req.Header.Get("verb"))
+		assert.Equal(t, "a=1", invoker.req.Header.Get("query_string"))
+		assert.Equal(t, "abc", invoker.req.Header.Get("x-token"))
+		assert.Equal(t, "text/plain", stream.resp.ContentType)
+		assert.Equal(t, "HELLO WORLD", string(stream.resp.Data.Value))
+		assert.Equal(t, []string{"1"}, stream.header.Get("x-echo"))
+		assert.Empty(t, stream.header.Get("content-length"))
+		assert.True(t, invoker.body.closed)
+	})
+
+	t.Run("server stream", func(t *testing.T) {
+		invoker := &upperStreamInvoker{}
+		stream := &mockInvokeStreamServer{}
+		in := &runtimev1pb.InvokeServiceRequest{
+			Id: "id1",
+			Message: &runtimev1pb.CommonInvokeRequest{
+				Method: "/hello",
+				Data:   &anypb.Any{Value: []byte("hello")},
+			},
+		}
+		err := newStreamTestAPI(invoker).InvokeServiceServerStream(in, stream)
+		assert.Nil(t, err)
+		assert.Equal(t, "/hello", invoker.req.Method)
+		assert.Len(t, stream.sent, 1)
+		assert.Equal(t, "text/plain", stream.sent[0].ContentType)
+		assert.Equal(t, "HELLO", string(stream.sent[0].Data))
+		assert.True(t, invoker.body.closed)
+	})
+
+	t.Run("bidi stream", func(t *testing.T) {
+		invoker := &upperStreamInvoker{}
+		stream := &mockInvokeStreamServer{reqs: newStreamRequests()}
+		err := newStreamTestAPI(invoker).InvokeServiceBidiStream(stream)
+		assert.Nil(t, err)
+		assert.Len(t, stream.sent, 2)
+		assert.Equal(t, "text/plain", stream.sent[0].ContentType)
+		assert.Equal(t, "HELLO ", string(stream.sent[0].Data))
+		assert.Equal(t, "", stream.sent[1].ContentType)
+		assert.Equal(t, "WORLD", string(stream.sent[1].Data))
+	})
+
+	t.Run("empty stream", func(t *testing.T) {
+		err := newStreamTestAPI(&upperStreamInvoker{}).InvokeServiceBidiStream(&mockInvokeStreamServer{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invoker error", func(t *testing.T) {
+		invoker := &upperStreamInvoker{err: runtime_common.Error(runtime_common.TimeoutCode, "request timeout")}
+		err := newStreamTestAPI(invoker).InvokeServiceBidiStream(&mockInvokeStreamServer{reqs: newStreamRequests()})
+		assert.Equal(t, status.Error(codes.DeadlineExceeded, "request timeout"), err)
+	})
+
+	t.Run("streaming not supported", func(t *testing.T) {
+		invoker := mock_invoker.NewMockInvoker(gomock.NewController(t))
+		err := newStreamTestAPI(invoker).InvokeServiceBidiStream(&mockInvokeStreamServer{reqs: newStreamRequests()})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
@@ -18,6 +18,7 @@ package default_api
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -53,6 +54,9 @@ func (a *api) TryLock(ctx context.Context, req *runtimev1pb.TryLockRequest) (*ru
 	if !ok {
 		return &runtimev1pb.TryLockResponse{}, status.Errorf(codes.InvalidArgument, messages.ErrLockStoreNotFound, req.StoreName)
 	}
+	if err := checkLockFeatures(store, req.StoreName, req.Mode, req.Reentrant); err != nil {
+		return &runtimev1pb.TryLockResponse{}, err
+	}
 	// 3. convert request
 	compReq := TryLockRequest2ComponentRequest(req)
 	// modify key
@@ -73,6 +77,57 @@ func (a *api) TryLock(ctx context.Context, req *runtimev1pb.TryLockRequest) (*ru
 	return resp, nil
 }
 
+func (a *api) Lock(ctx context.Context, req *runtimev1pb.LockRequest) (*runtimev1pb.LockResponse, error) {
+	// 1. validate
+	if len(a.lockStores) == 0 {
+		err := status.Error(codes.FailedPrecondition, messages.ErrLockStoresNotConfigured)
+		log.DefaultLogger.Errorf("[runtime] [grpc.Lock] error: %v", err)
+		return &runtimev1pb.LockResponse{}, err
+	}
+	if req.ResourceId == "" {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrResourceIdEmpty, req.StoreName)
+		return &runtimev1pb.LockResponse{}, err
+	}
+	if req.LockOwner == "" {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrLockOwnerEmpty, req.StoreName)
+		return &runtimev1pb.LockResponse{}, err
+	}
+	if req.Expire <= 0 {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrExpireNotPositive, req.StoreName)
+		return &runtimev1pb.LockResponse{}, err
+	}
+	if req.WaitTimeout <= 0 {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrWaitTimeoutNotPositive, req.StoreName)
+		return &runtimev1pb.LockResponse{}, err
+	}
+	// 2. find store component
+	store, ok := a.lockStores[req.StoreName]
+	if !ok {
+		return &runtimev1pb.LockResponse{}, status.Errorf(codes.InvalidArgument, messages.ErrLockStoreNotFound, req.StoreName)
+	}
+	if err := checkLockFeatures(store, req.StoreName, req.Mode, req.Reentrant); err != nil {
+		return &runtimev1pb.LockResponse{}, err
+	}
+	// 3. convert request
+	compReq := LockRequest2ComponentRequest(req)
+	// modify key
+	var err error
+	compReq.ResourceId, err = runtime_lock.GetModifiedLockKey(compReq.ResourceId, req.StoreName, a.appId)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.Lock] error: %v", err)
+		return &runtimev1pb.LockResponse{}, err
+	}
+	// 4. delegate to the component, or poll it if it can't wait natively
+	compResp, err := runtime_lock.Lock(ctx, store, compReq)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.Lock] error: %v", err)
+		return &runtimev1pb.LockResponse{}, err
+	}
+	// 5. convert response
+	resp := LockResponse2GrpcResponse(compResp)
+	return resp, nil
+}
+
 func (a *api) Unlock(ctx context.Context, req *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockResponse, error) {
 	// 1. validate
 	if len(a.lockStores) == 0 {
@@ -113,8 +168,272 @@ func (a *api) Unlock(ctx context.Context, req *runtimev1pb.UnlockRequest) (*runt
 	return resp, nil
 }
 
-func (a *api) LockKeepAlive(ctx context.Context, request *runtimev1pb.LockKeepAliveRequest) (*runtimev1pb.LockKeepAliveResponse, error) {
-	return nil, nil
+func (a *api) LockKeepAlive(ctx context.Context, req *runtimev1pb.LockKeepAliveRequest) (*runtimev1pb.LockKeepAliveResponse, error) {
+	// 1. validate
+	if len(a.lockStores) == 0 {
+		err := status.Error(codes.FailedPrecondition, messages.ErrLockStoresNotConfigured)
+		log.DefaultLogger.Errorf("[runtime] [grpc.LockKeepAlive] error: %v", err)
+		return newInternalErrorLockKeepAliveResponse(), err
+	}
+	if req.ResourceId == "" {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrResourceIdEmpty, req.StoreName)
+		return newInternalErrorLockKeepAliveResponse(), err
+	}
+	if req.LockOwner == "" {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrLockOwnerEmpty, req.StoreName)
+		return newInternalErrorLockKeepAliveResponse(), err
+	}
+	if req.Expire <= 0 {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrExpireNotPositive, req.StoreName)
+		return newInternalErrorLockKeepAliveResponse(), err
+	}
+	// 2. find store component
+	store, ok := a.lockStores[req.StoreName]
+	if !ok {
+		return newInternalErrorLockKeepAliveResponse(), status.Errorf(codes.InvalidArgument, messages.ErrLockStoreNotFound, req.StoreName)
+	}
+	// 3. convert request
+	compReq := LockKeepAliveGrpc2ComponentRequest(req)
+	// modify key
+	var err error
+	compReq.ResourceId, err = runtime_lock.GetModifiedLockKey(compReq.ResourceId, req.StoreName, a.appId)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.LockKeepAlive] error: %v", err)
+		return newInternalErrorLockKeepAliveResponse(), err
+	}
+	// 4. delegate to the component
+	compResp, err := store.LockKeepAlive(ctx, compReq)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.LockKeepAlive] error: %v", err)
+		return newInternalErrorLockKeepAliveResponse(), err
+	}
+	// 5. convert response
+	resp := LockKeepAliveComp2GrpcResponse(compResp)
+	return resp, nil
+}
+
+func (a *api) GetLockHolderCount(ctx context.Context, req *runtimev1pb.GetLockHolderCountRequest) (*runtimev1pb.GetLockHolderCountResponse, error) {
+	// 1. validate
+	if len(a.lockStores) == 0 {
+		err := status.Error(codes.FailedPrecondition, messages.ErrLockStoresNotConfigured)
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetLockHolderCount] error: %v", err)
+		return &runtimev1pb.GetLockHolderCountResponse{}, err
+	}
+	if req.ResourceId == "" {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrResourceIdEmpty, req.StoreName)
+		return &runtimev1pb.GetLockHolderCountResponse{}, err
+	}
+	// 2. find store component
+	store, ok := a.lockStores[req.StoreName]
+	if !ok {
+		return &runtimev1pb.GetLockHolderCountResponse{}, status.Errorf(codes.InvalidArgument, messages.ErrLockStoreNotFound, req.StoreName)
+	}
+	counter, ok := store.(lock.HolderCountLockStore)
+	if !ok {
+		return &runtimev1pb.GetLockHolderCountResponse{}, status.Errorf(codes.Unimplemented, messages.ErrHolderCountNotSupported, req.StoreName)
+	}
+	// 3. convert request
+	compReq := GetLockHolderCountGrpc2ComponentRequest(req)
+	// modify key
+	var err error
+	compReq.ResourceId, err = runtime_lock.GetModifiedLockKey(compReq.ResourceId, req.StoreName, a.appId)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetLockHolderCount] error: %v", err)
+		return &runtimev1pb.GetLockHolderCountResponse{}, err
+	}
+	// 4. delegate to the component
+	compResp, err := counter.GetHolderCount(ctx, compReq)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetLockHolderCount] error: %v", err)
+		return &runtimev1pb.GetLockHolderCountResponse{}, err
+	}
+	// 5. convert response
+	resp := GetLockHolderCountComp2GrpcResponse(compResp)
+	return resp, nil
+}
+
+// LockWithLease acquires a lock and keeps its lease until the stream is closed
+func (a *api) LockWithLease(req *runtimev1pb.LockWithLeaseRequest, stream runtimev1pb.Runtime_LockWithLeaseServer) error {
+	// 1. validate
+	if len(a.lockStores) == 0 {
+		err := status.Error(codes.FailedPrecondition, messages.ErrLockStoresNotConfigured)
+		log.DefaultLogger.Errorf("[runtime] [grpc.LockWithLease] error: %v", err)
+		return err
+	}
+	if req.ResourceId == "" {
+		return status.Errorf(codes.InvalidArgument, messages.ErrResourceIdEmpty, req.StoreName)
+	}
+	if req.LockOwner == "" {
+		return status.Errorf(codes.InvalidArgument, messages.ErrLockOwnerEmpty, req.StoreName)
+	}
+	if req.Expire <= 0 {
+		return status.Errorf(codes.InvalidArgument, messages.ErrExpireNotPositive, req.StoreName)
+	}
+	if req.WaitTimeout < 0 {
+		return status.Errorf(codes.InvalidArgument, messages.ErrWaitTimeoutNegative, req.StoreName)
+	}
+	// 2. find store component
+	store, ok := a.lockStores[req.StoreName]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, messages.ErrLockStoreNotFound, req.StoreName)
+	}
+	// 3. convert request
+	compReq := LockWithLeaseRequest2ComponentRequest(req)
+	// modify key
+	var err error
+	compReq.ResourceId, err = runtime_lock.GetModifiedLockKey(compReq.ResourceId, req.StoreName, a.appId)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.LockWithLease] error: %v", err)
+		return err
+	}
+	// 4. acquire the lock
+	ctx := stream.Context()
+	var compResp *lock.LockResponse
+	if compReq.WaitTimeout > 0 {
+		compResp, err = runtime_lock.Lock(ctx, store, compReq)
+	} else {
+		var tryLockResp *lock.TryLockResponse
+		tryLockResp, err = store.TryLock(ctx, &lock.TryLockRequest{
+			ResourceId: compReq.ResourceId,
+			LockOwner:  compReq.LockOwner,
+			Expire:     compReq.Expire,
+		})
+		if tryLockResp != nil {
+			compResp = &lock.LockResponse{Success: tryLockResp.Success, FencingToken: tryLockResp.FencingToken}
+		}
+	}
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.LockWithLease] error: %v", err)
+		return err
+	}
+	if !compResp.Success {
+		return stream.Send(&runtimev1pb.LockWithLeaseResponse{Status: runtimev1pb.LockWithLeaseResponse_FAILED})
+	}
+	// the lock has to be released at once if the app is gone
+	if err = stream.Send(&runtimev1pb.LockWithLeaseResponse{
+		Status:       runtimev1pb.LockWithLeaseResponse_ACQUIRED,
+		FencingToken: compResp.FencingToken,
+	}); err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.LockWithLease] error: %v", err)
+		store.Unlock(context.Background(), &lock.UnlockRequest{
+			ResourceId: compReq.ResourceId,
+			LockOwner:  compReq.LockOwner,
+		})
+		return err
+	}
+	// 5. keep the lease until the stream is closed
+	keepAliveReq := &lock.LockKeepAliveRequest{
+		ResourceId: compReq.ResourceId,
+		LockOwner:  compReq.LockOwner,
+		Expire:     compReq.Expire,
+	}
+	if err = runtime_lock.KeepLease(ctx, store, keepAliveReq); err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.LockWithLease] error: %v", err)
+		return stream.Send(&runtimev1pb.LockWithLeaseResponse{Status: runtimev1pb.LockWithLeaseResponse_LOST})
+	}
+	return nil
+}
+
+func (a *api) GetLockInfo(ctx context.Context, req *runtimev1pb.GetLockInfoRequest) (*runtimev1pb.GetLockInfoResponse, error) {
+	// 1. validate
+	if len(a.lockStores) == 0 {
+		err := status.Error(codes.FailedPrecondition, messages.ErrLockStoresNotConfigured)
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetLockInfo] error: %v", err)
+		return &runtimev1pb.GetLockInfoResponse{}, err
+	}
+	if req.ResourceId == "" {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrResourceIdEmpty, req.StoreName)
+		return &runtimev1pb.GetLockInfoResponse{}, err
+	}
+	// 2. find store component
+	store, ok := a.lockStores[req.StoreName]
+	if !ok {
+		return &runtimev1pb.GetLockInfoResponse{}, status.Errorf(codes.InvalidArgument, messages.ErrLockStoreNotFound, req.StoreName)
+	}
+	inspector, ok := store.(lock.InspectableLockStore)
+	if !ok {
+		return &runtimev1pb.GetLockInfoResponse{}, status.Errorf(codes.Unimplemented, messages.ErrLockInfoNotSupported, req.StoreName)
+	}
+	// 3. convert request
+	compReq := &lock.GetLockInfoRequest{}
+	// modify key
+	var err error
+	compReq.ResourceId, err = runtime_lock.GetModifiedLockKey(req.ResourceId, req.StoreName, a.appId)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetLockInfo] error: %v", err)
+		return &runtimev1pb.GetLockInfoResponse{}, err
+	}
+	// 4. delegate to the component
+	compResp, err := inspector.GetLockInfo(ctx, compReq)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetLockInfo] error: %v", err)
+		return &runtimev1pb.GetLockInfoResponse{}, err
+	}
+	// 5. convert response
+	return &runtimev1pb.GetLockInfoResponse{
+		Mode:    runtimev1pb.LockMode(compResp.Mode),
+		Holders: LockHolders2GrpcResponse(compResp.Holders),
+	}, nil
+}
+
+func (a *api) ListLocks(ctx context.Context, req *runtimev1pb.ListLocksRequest) (*runtimev1pb.ListLocksResponse, error) {
+	// 1. validate
+	if len(a.lockStores) == 0 {
+		err := status.Error(codes.FailedPrecondition, messages.ErrLockStoresNotConfigured)
+		log.DefaultLogger.Errorf("[runtime] [grpc.ListLocks] error: %v", err)
+		return &runtimev1pb.ListLocksResponse{}, err
+	}
+	// 2. find store component
+	store, ok := a.lockStores[req.StoreName]
+	if !ok {
+		return &runtimev1pb.ListLocksResponse{}, status.Errorf(codes.InvalidArgument, messages.ErrLockStoreNotFound, req.StoreName)
+	}
+	inspector, ok := store.(lock.InspectableLockStore)
+	if !ok || !lock.FeatureListLock.IsPresent(store.Features()) {
+		return &runtimev1pb.ListLocksResponse{}, status.Errorf(codes.Unimplemented, messages.ErrListLockNotSupported, req.StoreName)
+	}
+	// 3. convert request
+	compReq := &lock.ListLocksRequest{
+		Limit: req.Limit,
+	}
+	// modify key
+	var err error
+	compReq.Prefix, err = runtime_lock.GetModifiedLockKey(req.Prefix, req.StoreName, a.appId)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.ListLocks] error: %v", err)
+		return &runtimev1pb.ListLocksResponse{}, err
+	}
+	// 4. delegate to the component
+	compResp, err := inspector.ListLocks(ctx, compReq)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.ListLocks] error: %v", err)
+		return &runtimev1pb.ListLocksResponse{}, err
+	}
+	// 5. convert response, and restore the keys
+	resp := &runtimev1pb.ListLocksResponse{}
+	for _, info := range compResp.Locks {
+		resourceId, ok := runtime_lock.GetOriginalLockKey(info.ResourceId, req.StoreName, a.appId)
+		if !ok {
+			continue
+		}
+		resp.Locks = append(resp.Locks, &runtimev1pb.LockInfo{
+			ResourceId: resourceId,
+			Mode:       runtimev1pb.LockMode(info.Mode),
+			Holders:    LockHolders2GrpcResponse(info.Holders),
+		})
+	}
+	return resp, nil
+}
+
+// checkLockFeatures rejects the lock mode and reentrancy which the store doesn't support
+func checkLockFeatures(store lock.LockStore, storeName string, mode runtimev1pb.LockMode, reentrant bool) error {
+	if mode == runtimev1pb.LockMode_SHARED && !lock.FeatureSharedLock.IsPresent(store.Features()) {
+		return status.Errorf(codes.InvalidArgument, messages.ErrSharedLockNotSupported, storeName)
+	}
+	if reentrant && !lock.FeatureReentrantLock.IsPresent(store.Features()) {
+		return status.Errorf(codes.InvalidArgument, messages.ErrReentrantNotSupported, storeName)
+	}
+	return nil
 }
 
 func newInternalErrorUnlockResponse() *runtimev1pb.UnlockResponse {
@@ -123,6 +442,12 @@ func newInternalErrorUnlockResponse() *runtimev1pb.UnlockResponse {
 	}
 }
 
+func newInternalErrorLockKeepAliveResponse() *runtimev1pb.LockKeepAliveResponse {
+	return &runtimev1pb.LockKeepAliveResponse{
+		Status: runtimev1pb.LockKeepAliveResponse_INTERNAL_ERROR,
+	}
+}
+
 func TryLockRequest2ComponentRequest(req *runtimev1pb.TryLockRequest) *lock.TryLockRequest {
 	result := &lock.TryLockRequest{}
 	if req == nil {
@@ -131,6 +456,8 @@ func TryLockRequest2ComponentRequest(req *runtimev1pb.TryLockRequest) *lock.TryL
 	result.ResourceId = req.ResourceId
 	result.LockOwner = req.LockOwner
 	result.Expire = req.Expire
+	result.Mode = lock.LockMode(req.Mode)
+	result.Reentrant = req.Reentrant
 	return result
 }
 
@@ -140,6 +467,31 @@ func TryLockResponse2GrpcResponse(compResponse *lock.TryLockResponse) *runtimev1
 		return result
 	}
 	result.Success = compResponse.Success
+	result.FencingToken = compResponse.FencingToken
+	return result
+}
+
+func LockRequest2ComponentRequest(req *runtimev1pb.LockRequest) *lock.LockRequest {
+	result := &lock.LockRequest{}
+	if req == nil {
+		return result
+	}
+	result.ResourceId = req.ResourceId
+	result.LockOwner = req.LockOwner
+	result.Expire = req.Expire
+	result.WaitTimeout = time.Duration(req.WaitTimeout) * time.Millisecond
+	result.Mode = lock.LockMode(req.Mode)
+	result.Reentrant = req.Reentrant
+	return result
+}
+
+func LockResponse2GrpcResponse(compResponse *lock.LockResponse) *runtimev1pb.LockResponse {
+	result := &runtimev1pb.LockResponse{}
+	if compResponse == nil {
+		return result
+	}
+	result.Success = compResponse.Success
+	result.FencingToken = compResponse.FencingToken
 	return result
 }
 
@@ -161,3 +513,70 @@ func UnlockComp2GrpcResponse(compResp *lock.UnlockResponse) *runtimev1pb.UnlockR
 	result.Status = runtimev1pb.UnlockResponse_Status(compResp.Status)
 	return result
 }
+
+func LockKeepAliveGrpc2ComponentRequest(req *runtimev1pb.LockKeepAliveRequest) *lock.LockKeepAliveRequest {
+	result := &lock.LockKeepAliveRequest{}
+	if req == nil {
+		return result
+	}
+	result.ResourceId = req.ResourceId
+	result.LockOwner = req.LockOwner
+	result.Expire = req.Expire
+	return result
+}
+
+func LockKeepAliveComp2GrpcResponse(compResp *lock.LockKeepAliveResponse) *runtimev1pb.LockKeepAliveResponse {
+	result := &runtimev1pb.LockKeepAliveResponse{}
+	if compResp == nil {
+		return result
+	}
+	result.Status = runtimev1pb.LockKeepAliveResponse_Status(compResp.Status)
+	result.FencingToken = compResp.FencingToken
+	return result
+}
+
+func GetLockHolderCountGrpc2ComponentRequest(req *runtimev1pb.GetLockHolderCountRequest) *lock.GetHolderCountRequest {
+	result := &lock.GetHolderCountRequest{}
+	if req == nil {
+		return result
+	}
+	result.ResourceId = req.ResourceId
+	result.LockOwner = req.LockOwner
+	return result
+}
+
+func GetLockHolderCountComp2GrpcResponse(compResp *lock.GetHolderCountResponse) *runtimev1pb.GetLockHolderCountResponse {
+	result := &runtimev1pb.GetLockHolderCountResponse{}
+	if compResp == nil {
+		return result
+	}
+	result.Mode = runtimev1pb.LockMode(compResp.Mode)
+	result.HolderCount = compResp.HolderCount
+	result.ReentrantCount = compResp.ReentrantCount
+	return result
+}
+
+func LockWithLeaseRequest2ComponentRequest(req *runtimev1pb.LockWithLeaseRequest) *lock.LockRequest {
+	result := &lock.LockRequest{}
+	if req == nil {
+		return result
+	}
+	result.ResourceId = req.ResourceId
+	result.LockOwner = req.LockOwner
+	result.Expire = req.Expire
+	result.WaitTimeout = time.Duration(req.WaitTimeout) * time.Millisecond
+	return result
+}
+
+func LockHolders2GrpcResponse(holders []*lock.LockHolder) []*runtimev1pb.LockHolder {
+	result := make([]*runtimev1pb.LockHolder, 0, len(holders))
+	for _, h := range holders {
+		result = append(result, &runtimev1pb.LockHolder{
+			LockOwner:    h.LockOwner,
+			Ttl:          h.TTL.Milliseconds(),
+			AcquiredAt:   h.AcquiredAt.UnixMilli(),
+			FencingToken: h.FencingToken,
+		})
+	}
+	return result
+}
@@ -19,11 +19,14 @@ package default_api
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 
 	"mosn.io/layotto/components/lock"
+	lock_inmemory "mosn.io/layotto/components/lock/in-memory"
 	mock_lock "mosn.io/layotto/pkg/mock/components/lock"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
 )
@@ -34,23 +37,56 @@ func TestTryLockRequest2ComponentRequest(t *testing.T) {
 		ResourceId: "resourceId",
 		LockOwner:  "owner1",
 		Expire:     1000,
+		Mode:       runtimev1pb.LockMode_SHARED,
+		Reentrant:  true,
 	})
 	assert.True(t, req.ResourceId == "resourceId")
 	assert.True(t, req.LockOwner == "owner1")
 	assert.True(t, req.Expire == 1000)
+	assert.Equal(t, lock.SHARED, req.Mode)
+	assert.True(t, req.Reentrant)
 	req = TryLockRequest2ComponentRequest(nil)
 	assert.NotNil(t, req)
 }
 
 func TestTryLockResponse2GrpcResponse(t *testing.T) {
 	resp := TryLockResponse2GrpcResponse(&lock.TryLockResponse{
-		Success: true,
+		Success:      true,
+		FencingToken: 7,
 	})
 	assert.True(t, resp.Success)
+	assert.Equal(t, int64(7), resp.FencingToken)
 	resp2 := TryLockResponse2GrpcResponse(nil)
 	assert.NotNil(t, resp2)
 }
 
+func TestLockRequest2ComponentRequest(t *testing.T) {
+	req := LockRequest2ComponentRequest(&runtimev1pb.LockRequest{
+		StoreName:   "redis",
+		ResourceId:  "resourceId",
+		LockOwner:   "owner1",
+		Expire:      1000,
+		WaitTimeout: 500,
+	})
+	assert.True(t, req.ResourceId == "resourceId")
+	assert.True(t, req.LockOwner == "owner1")
+	assert.True(t, req.Expire == 1000)
+	assert.Equal(t, 500*time.Millisecond, req.WaitTimeout)
+	req = LockRequest2ComponentRequest(nil)
+	assert.NotNil(t, req)
+}
+
+func TestLockResponse2GrpcResponse(t *testing.T) {
+	resp := LockResponse2GrpcResponse(&lock.LockResponse{
+		Success:      true,
+		FencingToken: 7,
+	})
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(7), resp.FencingToken)
+	resp2 := LockResponse2GrpcResponse(nil)
+	assert.NotNil(t, resp2)
+}
+
 func TestUnlockGrpc2ComponentRequest(t *testing.T) {
 	req := UnlockGrpc2ComponentRequest(&runtimev1pb.UnlockRequest{
 		StoreName:  "redis",
@@ -70,6 +106,27 @@ func TestUnlockComp2GrpcResponse(t *testing.T) {
 	assert.NotNil(t, resp2)
 }
 
+func TestLockKeepAliveGrpc2ComponentRequest(t *testing.T) {
+	req := LockKeepAliveGrpc2ComponentRequest(&runtimev1pb.LockKeepAliveRequest{
+		StoreName:  "redis",
+		ResourceId: "resourceId",
+		LockOwner:  "owner1",
+		Expire:     1000,
+	})
+	assert.True(t, req.ResourceId == "resourceId")
+	assert.True(t, req.LockOwner == "owner1")
+	assert.True(t, req.Expire == 1000)
+	req = LockKeepAliveGrpc2ComponentRequest(nil)
+	assert.NotNil(t, req)
+}
+
+func TestLockKeepAliveComp2GrpcResponse(t *testing.T) {
+	resp := LockKeepAliveComp2GrpcResponse(&lock.LockKeepAliveResponse{Status: lock.LOCK_BELONG_TO_OTHERS})
+	assert.True(t, resp.Status == runtimev1pb.LockKeepAliveResponse_LOCK_BELONG_TO_OTHERS)
+	resp2 := LockKeepAliveComp2GrpcResponse(nil)
+	assert.NotNil(t, resp2)
+}
+
 func TestTryLock(t *testing.T) {
 	t.Run("lock store not configured", func(t *testing.T) {
 		a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
@@ -138,7 +195,8 @@ func TestTryLock(t *testing.T) {
 			assert.Equal(t, "owner", req.LockOwner)
 			assert.Equal(t, int32(1), req.Expire)
 			return &lock.TryLockResponse{
-				Success: true,
+				Success:      true,
+				FencingToken: 3,
 			}, nil
 		})
 		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
@@ -152,8 +210,97 @@ func TestTryLock(t *testing.T) {
 		resp, err := apiForTest.TryLock(context.Background(), req)
 		assert.Nil(t, err)
 		assert.Equal(t, true, resp.Success)
+		assert.Equal(t, int64(3), resp.FencingToken)
+	})
+
+	t.Run("shared lock not supported", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		mockLockStore.EXPECT().Features().Return([]lock.Feature{lock.FeatureReentrantLock})
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.TryLockRequest{
+			StoreName:  "mock",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+			Expire:     1,
+			Mode:       runtimev1pb.LockMode_SHARED,
+		}
+		_, err := apiForTest.TryLock(context.Background(), req)
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = lock store mock doesn't support shared lock", err.Error())
+	})
+
+	t.Run("reentrant lock not supported", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		mockLockStore.EXPECT().Features().Return([]lock.Feature{lock.FeatureSharedLock}).Times(2)
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.TryLockRequest{
+			StoreName:  "mock",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+			Expire:     1,
+			Mode:       runtimev1pb.LockMode_SHARED,
+			Reentrant:  true,
+		}
+		_, err := apiForTest.TryLock(context.Background(), req)
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = lock store mock doesn't support reentrant lock", err.Error())
+	})
+
+}
+
+func TestLock(t *testing.T) {
+	t.Run("lock store not configured", func(t *testing.T) {
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockRequest{
+			StoreName: "abc",
+		}
+		_, err := apiForTest.Lock(context.Background(), req)
+		assert.Equal(t, "rpc error: code = FailedPrecondition desc = lock store is not configured", err.Error())
+	})
+
+	t.Run("wait timeout is not positive", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockRequest{
+			StoreName:  "abc",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+			Expire:     1,
+		}
+		_, err := apiForTest.Lock(context.Background(), req)
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = WaitTimeout is not positive in lock store abc", err.Error())
 	})
 
+	t.Run("poll the store which can't wait natively", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		gomock.InOrder(
+			mockLockStore.EXPECT().TryLock(gomock.Any(), gomock.Any()).Return(&lock.TryLockResponse{Success: false}, nil),
+			mockLockStore.EXPECT().TryLock(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+				assert.Equal(t, "lock|||resource", req.ResourceId)
+				assert.Equal(t, "owner", req.LockOwner)
+				assert.Equal(t, int32(1), req.Expire)
+				return &lock.TryLockResponse{
+					Success:      true,
+					FencingToken: 3,
+				}, nil
+			}),
+		)
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockRequest{
+			StoreName:   "mock",
+			ResourceId:  "resource",
+			LockOwner:   "owner",
+			Expire:      1,
+			WaitTimeout: 1000,
+		}
+		resp, err := apiForTest.Lock(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, true, resp.Success)
+		assert.Equal(t, int64(3), resp.FencingToken)
+	})
 }
 
 func TestUnlock(t *testing.T) {
@@ -224,3 +371,287 @@ func TestUnlock(t *testing.T) {
 		assert.Equal(t, runtimev1pb.UnlockResponse_SUCCESS, resp.Status)
 	})
 }
+
+func TestLockKeepAlive(t *testing.T) {
+	t.Run("lock store not configured", func(t *testing.T) {
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockKeepAliveRequest{
+			StoreName: "abc",
+		}
+		_, err := apiForTest.LockKeepAlive(context.Background(), req)
+		assert.Equal(t, "rpc error: code = FailedPrecondition desc = lock store is not configured", err.Error())
+	})
+
+	t.Run("lock expire is not positive", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockKeepAliveRequest{
+			StoreName:  "abc",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+		}
+		resp, err := apiForTest.LockKeepAlive(context.Background(), req)
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = Expire is not positive in lock store abc", err.Error())
+		assert.Equal(t, runtimev1pb.LockKeepAliveResponse_INTERNAL_ERROR, resp.Status)
+	})
+
+	t.Run("lock store not found", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockKeepAliveRequest{
+			StoreName:  "abc",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+			Expire:     1,
+		}
+		_, err := apiForTest.LockKeepAlive(context.Background(), req)
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = lock store abc not found", err.Error())
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		mockLockStore.EXPECT().LockKeepAlive(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *lock.LockKeepAliveRequest) (*lock.LockKeepAliveResponse, error) {
+			assert.Equal(t, "lock|||resource", req.ResourceId)
+			assert.Equal(t, "owner", req.LockOwner)
+			assert.Equal(t, int32(1), req.Expire)
+			return &lock.LockKeepAliveResponse{
+				ResourceId:   req.ResourceId,
+				Status:       lock.SUCCESS,
+				FencingToken: 3,
+			}, nil
+		})
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockKeepAliveRequest{
+			StoreName:  "mock",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+			Expire:     1,
+		}
+		resp, err := apiForTest.LockKeepAlive(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, runtimev1pb.LockKeepAliveResponse_SUCCESS, resp.Status)
+		assert.Equal(t, int64(3), resp.FencingToken)
+	})
+}
+
+func TestGetLockHolderCount(t *testing.T) {
+	t.Run("lock store not configured", func(t *testing.T) {
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.GetLockHolderCountRequest{
+			StoreName: "abc",
+		}
+		_, err := apiForTest.GetLockHolderCount(context.Background(), req)
+		assert.Equal(t, "rpc error: code = FailedPrecondition desc = lock store is not configured", err.Error())
+	})
+
+	t.Run("holder count not supported", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.GetLockHolderCountRequest{
+			StoreName:  "mock",
+			ResourceId: "resource",
+		}
+		_, err := apiForTest.GetLockHolderCount(context.Background(), req)
+		assert.Equal(t, "rpc error: code = Unimplemented desc = lock store mock doesn't support holder count query", err.Error())
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		store := lock_inmemory.NewInMemoryLock()
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"memory": store}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		for i := 0; i < 2; i++ {
+			resp, err := apiForTest.TryLock(context.Background(), &runtimev1pb.TryLockRequest{
+				StoreName:  "memory",
+				ResourceId: "resource",
+				LockOwner:  "owner",
+				Expire:     10,
+				Mode:       runtimev1pb.LockMode_SHARED,
+				Reentrant:  true,
+			})
+			assert.Nil(t, err)
+			assert.True(t, resp.Success)
+		}
+		resp, err := apiForTest.GetLockHolderCount(context.Background(), &runtimev1pb.GetLockHolderCountRequest{
+			StoreName:  "memory",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, runtimev1pb.LockMode_SHARED, resp.Mode)
+		assert.Equal(t, int32(1), resp.HolderCount)
+		assert.Equal(t, int32(2), resp.ReentrantCount)
+	})
+}
+
+type mockLockWithLeaseServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	resp chan *runtimev1pb.LockWithLeaseResponse
+}
+
+func (s *mockLockWithLeaseServer) Send(resp *runtimev1pb.LockWithLeaseResponse) error {
+	s.resp <- resp
+	return nil
+}
+
+func (s *mockLockWithLeaseServer) Context() context.Context {
+	return s.ctx
+}
+
+func TestLockWithLease(t *testing.T) {
+	t.Run("lock store not configured", func(t *testing.T) {
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockWithLeaseRequest{
+			StoreName: "abc",
+		}
+		err := apiForTest.LockWithLease(req, &mockLockWithLeaseServer{ctx: context.Background()})
+		assert.Equal(t, "rpc error: code = FailedPrecondition desc = lock store is not configured", err.Error())
+	})
+
+	t.Run("wait timeout is negative", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockWithLeaseRequest{
+			StoreName:   "mock",
+			ResourceId:  "resource",
+			LockOwner:   "owner",
+			Expire:      1,
+			WaitTimeout: -1,
+		}
+		err := apiForTest.LockWithLease(req, &mockLockWithLeaseServer{ctx: context.Background()})
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = WaitTimeout is negative in lock store mock", err.Error())
+	})
+
+	t.Run("renew until the stream is closed", func(t *testing.T) {
+		store := lock_inmemory.NewInMemoryLock()
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"memory": store}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.LockWithLeaseRequest{
+			StoreName:  "memory",
+			ResourceId: "resource",
+			LockOwner:  "owner1",
+			Expire:     1,
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &mockLockWithLeaseServer{ctx: ctx, resp: make(chan *runtimev1pb.LockWithLeaseResponse, 1)}
+		done := make(chan error)
+		go func() {
+			done <- apiForTest.LockWithLease(req, stream)
+		}()
+		resp := <-stream.resp
+		assert.Equal(t, runtimev1pb.LockWithLeaseResponse_ACQUIRED, resp.Status)
+		assert.True(t, resp.FencingToken > 0)
+
+		// others can't get the lock even after it's expire time
+		time.Sleep(1500 * time.Millisecond)
+		stream2 := &mockLockWithLeaseServer{ctx: context.Background(), resp: make(chan *runtimev1pb.LockWithLeaseResponse, 1)}
+		err := apiForTest.LockWithLease(&runtimev1pb.LockWithLeaseRequest{
+			StoreName:  "memory",
+			ResourceId: "resource",
+			LockOwner:  "owner2",
+			Expire:     1,
+		}, stream2)
+		assert.Nil(t, err)
+		assert.Equal(t, runtimev1pb.LockWithLeaseResponse_FAILED, (<-stream2.resp).Status)
+
+		// the lock is released after the stream is closed
+		cancel()
+		assert.Nil(t, <-done)
+		tryLockResp, err := apiForTest.TryLock(context.Background(), &runtimev1pb.TryLockRequest{
+			StoreName:  "memory",
+			ResourceId: "resource",
+			LockOwner:  "owner2",
+			Expire:     1,
+		})
+		assert.Nil(t, err)
+		assert.True(t, tryLockResp.Success)
+	})
+}
+
+func TestGetLockInfo(t *testing.T) {
+	t.Run("lock info not supported", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.GetLockInfoRequest{
+			StoreName:  "mock",
+			ResourceId: "resource",
+		}
+		_, err := apiForTest.GetLockInfo(context.Background(), req)
+		assert.Equal(t, "rpc error: code = Unimplemented desc = lock store mock doesn't support lock info query", err.Error())
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		store := lock_inmemory.NewInMemoryLock()
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"memory": store}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		tryLockResp, err := apiForTest.TryLock(context.Background(), &runtimev1pb.TryLockRequest{
+			StoreName:  "memory",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+			Expire:     10,
+		})
+		assert.Nil(t, err)
+		assert.True(t, tryLockResp.Success)
+		resp, err := apiForTest.GetLockInfo(context.Background(), &runtimev1pb.GetLockInfoRequest{
+			StoreName:  "memory",
+			ResourceId: "resource",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, runtimev1pb.LockMode_EXCLUSIVE, resp.Mode)
+		assert.Len(t, resp.Holders, 1)
+		assert.Equal(t, "owner", resp.Holders[0].LockOwner)
+		assert.True(t, resp.Holders[0].Ttl > 9000)
+		assert.True(t, resp.Holders[0].AcquiredAt > 0)
+		assert.Equal(t, tryLockResp.FencingToken, resp.Holders[0].FencingToken)
+	})
+}
+
+func TestListLocks(t *testing.T) {
+	t.Run("list lock not supported", func(t *testing.T) {
+		mockLockStore := mock_lock.NewMockLockStore(gomock.NewController(t))
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"mock": mockLockStore}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		_, err := apiForTest.ListLocks(context.Background(), &runtimev1pb.ListLocksRequest{StoreName: "mock"})
+		assert.Equal(t, "rpc error: code = Unimplemented desc = lock store mock doesn't support listing locks", err.Error())
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		store := lock_inmemory.NewInMemoryLock()
+		// a lock of another app
+		_, err := store.TryLock(context.Background(), &lock.TryLockRequest{
+			ResourceId: "lock|||app2||order1",
+			LockOwner:  "owner",
+			Expire:     10,
+		})
+		assert.Nil(t, err)
+		a := NewAPI("app1", nil, nil, nil, nil, nil, nil, map[string]lock.LockStore{"memory": store}, nil, nil, nil)
+		var apiForTest = a.(*api)
+		for _, resourceId := range []string{"order1", "order2", "user1"} {
+			_, err := apiForTest.TryLock(context.Background(), &runtimev1pb.TryLockRequest{
+				StoreName:  "memory",
+				ResourceId: resourceId,
+				LockOwner:  "owner",
+				Expire:     10,
+			})
+			assert.Nil(t, err)
+		}
+		resp, err := apiForTest.ListLocks(context.Background(), &runtimev1pb.ListLocksRequest{
+			StoreName: "memory",
+			Prefix:    "order",
+		})
+		assert.Nil(t, err)
+		assert.Len(t, resp.Locks, 2)
+		assert.Equal(t, "order1", resp.Locks[0].ResourceId)
+		assert.Equal(t, "order2", resp.Locks[1].ResourceId)
+		assert.Equal(t, "owner", resp.Locks[0].Holders[0].LockOwner)
+	})
+}
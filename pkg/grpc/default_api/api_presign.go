@@ -0,0 +1,45 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package default_api
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mosn.io/layotto/pkg/presign"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+// PresignURL returns a url of a file or an object, which is served by the presign server without any credential until it expires
+func (a *api) PresignURL(ctx context.Context, in *runtimev1pb.PresignURLRequest) (*runtimev1pb.PresignURLResponse, error) {
+	target := &presign.Target{
+		ComponentType: in.ComponentType,
+		StoreName:     in.StoreName,
+		Bucket:        in.Bucket,
+		Name:          in.Name,
+		Method:        in.Method,
+	}
+	res, err := presign.GetDefault().SignURL(target, in.ExpiresInSec)
+	if err == presign.ErrDisabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "error occurred: %v", err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error occurred: %v", err.Error())
+	}
+	return &runtimev1pb.PresignURLResponse{Url: res.URL, ExpiresAt: res.ExpiresAt}, nil
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package default_api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/oss"
+	"mosn.io/layotto/pkg/mock"
+	"mosn.io/layotto/pkg/presign"
+	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
+)
+
+func TestPresignURL(t *testing.T) {
+	a := &api{}
+	req := &runtimev1pb.PresignURLRequest{StoreName: "file_demo", Name: "a.txt", ExpiresInSec: 60}
+	_, err := a.PresignURL(context.Background(), req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	ctrl := gomock.NewController(t)
+	files := map[string]file.File{"file_demo": mock.NewMockFile(ctrl)}
+	err = presign.GetDefault().Init(&presign.Config{Endpoint: "http://127.0.0.1:34905/presign"},
+		map[string]string{presign.SignKey: "key"}, files, map[string]oss.Oss{})
+	assert.Nil(t, err)
+
+	resp, err := a.PresignURL(context.Background(), req)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(resp.Url, "http://127.0.0.1:34905/presign/file/file_demo/a.txt?"))
+	assert.NotZero(t, resp.ExpiresAt)
+
+	req.StoreName = "not_exist"
+	_, err = a.PresignURL(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
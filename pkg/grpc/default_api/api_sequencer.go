@@ -19,6 +19,7 @@ package default_api
 import (
 	"context"
 	"errors"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -52,6 +53,12 @@ func (a *api) GetNextId(ctx context.Context, req *runtimev1pb.GetNextIdRequest)
 		log.DefaultLogger.Errorf("[runtime] [grpc.GetNextId] error: %v", err)
 		return &runtimev1pb.GetNextIdResponse{}, err
 	}
+	// partition key if the ids are formatted and reset every period
+	now := time.Now()
+	formatter := runtime_sequencer.GetIdFormatter(compReq.Key)
+	if formatter != nil {
+		compReq.Key = formatter.PartitionKey(compReq.Key, now)
+	}
 	// 3. find store component
 	store, ok := a.sequencers[req.StoreName]
 	if !ok {
@@ -71,9 +78,102 @@ func (a *api) GetNextId(ctx context.Context, req *runtimev1pb.GetNextIdRequest)
 		log.DefaultLogger.Errorf("[runtime] [grpc.GetNextId] error: %v", err)
 		return &runtimev1pb.GetNextIdResponse{}, err
 	}
-	return &runtimev1pb.GetNextIdResponse{
+	resp := &runtimev1pb.GetNextIdResponse{
 		NextId: next,
-	}, nil
+	}
+	if formatter != nil {
+		resp.FormattedId = formatter.Format(next, now)
+	}
+	return resp, nil
+}
+
+// maxNextIdsCount is the max number of ids returned by GetNextIds
+const maxNextIdsCount = 10000
+
+func (a *api) GetNextIds(ctx context.Context, req *runtimev1pb.GetNextIdsRequest) (*runtimev1pb.GetNextIdsResponse, error) {
+	// 1. validate
+	if len(a.sequencers) == 0 {
+		err := status.Error(codes.FailedPrecondition, messages.ErrSequencerStoresNotConfigured)
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetNextIds] error: %v", err)
+		return &runtimev1pb.GetNextIdsResponse{}, err
+	}
+	if req.Key == "" {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrSequencerKeyEmpty, req.StoreName)
+		return &runtimev1pb.GetNextIdsResponse{}, err
+	}
+	if req.Count <= 0 || req.Count > maxNextIdsCount {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrSequencerCountInvalid, req.Count, maxNextIdsCount, req.StoreName)
+		return &runtimev1pb.GetNextIdsResponse{}, err
+	}
+	// 2. convert
+	compReq, err := GetNextIdsRequest2ComponentRequest(req)
+	if err != nil {
+		return &runtimev1pb.GetNextIdsResponse{}, err
+	}
+	// modify key
+	compReq.Key, err = runtime_sequencer.GetModifiedSeqKey(compReq.Key, req.StoreName, a.appId)
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetNextIds] error: %v", err)
+		return &runtimev1pb.GetNextIdsResponse{}, err
+	}
+	// partition key if the ids are formatted and reset every period
+	now := time.Now()
+	formatter := runtime_sequencer.GetIdFormatter(compReq.Key)
+	if formatter != nil {
+		compReq.Key = formatter.PartitionKey(compReq.Key, now)
+	}
+	// 3. find store component
+	store, ok := a.sequencers[req.StoreName]
+	if !ok {
+		return &runtimev1pb.GetNextIdsResponse{}, status.Errorf(codes.InvalidArgument, messages.ErrSequencerStoreNotFound, req.StoreName)
+	}
+	var ids []int64
+	// 4. invoke component
+	if compReq.Options.AutoIncrement == sequencer.WEAK {
+		// WEAK
+		ids, err = a.getNextIdsWithWeakAutoIncrement(ctx, store, compReq, int(req.Count))
+	} else {
+		// STRONG
+		ids, err = a.getNextIdsFromComponent(ctx, store, compReq, int(req.Count))
+	}
+	// 5. convert response
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [grpc.GetNextIds] error: %v", err)
+		return &runtimev1pb.GetNextIdsResponse{}, err
+	}
+	resp := &runtimev1pb.GetNextIdsResponse{
+		Ids: ids,
+	}
+	if formatter != nil {
+		resp.FormattedIds = make([]string, 0, len(ids))
+		for _, id := range ids {
+			resp.FormattedIds = append(resp.FormattedIds, formatter.Format(id, now))
+		}
+	}
+	return resp, nil
+}
+
+func (a *api) getNextIdsWithWeakAutoIncrement(ctx context.Context, store sequencer.Store, compReq *sequencer.GetNextIdRequest, count int) ([]int64, error) {
+	// 1. try to get from cache
+	support, ids, err := runtime_sequencer.GetNextIdsFromCache(ctx, store, compReq, count)
+
+	if !support {
+		// 2. get from component
+		return a.getNextIdsFromComponent(ctx, store, compReq, count)
+	}
+	return ids, err
+}
+
+func (a *api) getNextIdsFromComponent(ctx context.Context, store sequencer.Store, compReq *sequencer.GetNextIdRequest, count int) ([]int64, error) {
+	ids := make([]int64, 0, count)
+	for i := 0; i < count; i++ {
+		next, err := a.getNextIdFromComponent(ctx, store, compReq)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, next)
+	}
+	return ids, nil
 }
 
 func (a *api) getNextIdWithWeakAutoIncrement(ctx context.Context, store sequencer.Store, compReq *sequencer.GetNextIdRequest) (int64, error) {
@@ -117,3 +217,15 @@ func GetNextIdRequest2ComponentRequest(req *runtimev1pb.GetNextIdRequest) (*sequ
 	result.Metadata = req.Metadata
 	return result, nil
 }
+
+func GetNextIdsRequest2ComponentRequest(req *runtimev1pb.GetNextIdsRequest) (*sequencer.GetNextIdRequest, error) {
+	if req == nil {
+		return nil, errors.New("cannot convert it since request is nil")
+	}
+	return GetNextIdRequest2ComponentRequest(&runtimev1pb.GetNextIdRequest{
+		StoreName: req.StoreName,
+		Key:       req.Key,
+		Options:   req.Options,
+		Metadata:  req.Metadata,
+	})
+}
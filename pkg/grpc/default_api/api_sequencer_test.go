@@ -20,12 +20,14 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"mosn.io/layotto/components/sequencer"
 	mock_sequencer "mosn.io/layotto/pkg/mock/components/sequencer"
+	runtime_sequencer "mosn.io/layotto/pkg/runtime/sequencer"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
 )
 
@@ -104,3 +106,131 @@ func TestGetNextId(t *testing.T) {
 		assert.Equal(t, "net error", err.Error())
 	})
 }
+
+func TestGetNextIds(t *testing.T) {
+	t.Run("count out of range", func(t *testing.T) {
+		mockSequencerStore := mock_sequencer.NewMockStore(gomock.NewController(t))
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, map[string]sequencer.Store{"mock": mockSequencerStore}, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.GetNextIdsRequest{
+			StoreName: "mock",
+			Key:       "next key",
+		}
+		_, err := apiForTest.GetNextIds(context.Background(), req)
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = Count 0 is out of range [1, 10000] in sequencer store mock", err.Error())
+		req.Count = 10001
+		_, err = apiForTest.GetNextIds(context.Background(), req)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("auto increment is strong", func(t *testing.T) {
+		mockSequencerStore := mock_sequencer.NewMockStore(gomock.NewController(t))
+		next := int64(10)
+		mockSequencerStore.EXPECT().GetNextId(gomock.Any()).Times(3).
+			DoAndReturn(func(req *sequencer.GetNextIdRequest) (*sequencer.GetNextIdResponse, error) {
+				assert.Equal(t, "sequencer|||next key", req.Key)
+				assert.Equal(t, sequencer.STRONG, req.Options.AutoIncrement)
+				next++
+				return &sequencer.GetNextIdResponse{
+					NextId: next,
+				}, nil
+			})
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, map[string]sequencer.Store{"mock": mockSequencerStore}, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.GetNextIdsRequest{
+			StoreName: "mock",
+			Key:       "next key",
+			Count:     3,
+			Options: &runtimev1pb.SequencerOptions{
+				Increment: runtimev1pb.SequencerOptions_STRONG,
+			},
+		}
+		rsp, err := apiForTest.GetNextIds(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{11, 12, 13}, rsp.Ids)
+	})
+
+	t.Run("weak auto increment from cache", func(t *testing.T) {
+		mockSequencerStore := mock_sequencer.NewMockStore(gomock.NewController(t))
+		mockSequencerStore.EXPECT().GetSegment(gomock.Any()).AnyTimes().
+			DoAndReturn(func(req *sequencer.GetSegmentRequest) (bool, *sequencer.GetSegmentResponse, error) {
+				assert.Equal(t, "sequencer|||batch key", req.Key)
+				return true, &sequencer.GetSegmentResponse{From: 1, To: int64(req.Size)}, nil
+			})
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, map[string]sequencer.Store{"mock": mockSequencerStore}, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.GetNextIdsRequest{
+			StoreName: "mock",
+			Key:       "batch key",
+			Count:     5,
+		}
+		rsp, err := apiForTest.GetNextIds(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, rsp.Ids)
+	})
+
+	t.Run("net error", func(t *testing.T) {
+		mockSequencerStore := mock_sequencer.NewMockStore(gomock.NewController(t))
+		mockSequencerStore.EXPECT().GetNextId(gomock.Any()).Return(nil, fmt.Errorf("net error"))
+		a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, map[string]sequencer.Store{"mock": mockSequencerStore}, nil, nil)
+		var apiForTest = a.(*api)
+		req := &runtimev1pb.GetNextIdsRequest{
+			StoreName: "mock",
+			Key:       "next key",
+			Count:     2,
+			Options: &runtimev1pb.SequencerOptions{
+				Increment: runtimev1pb.SequencerOptions_STRONG,
+			},
+		}
+		_, err := apiForTest.GetNextIds(context.Background(), req)
+		assert.Equal(t, "net error", err.Error())
+	})
+}
+
+func TestGetNextId_Formatted(t *testing.T) {
+	err := runtime_sequencer.SaveFormatConfiguration("formatted", "", map[string]sequencer.IdFormat{
+		"order": {
+			Prefix:     "ORD",
+			Separator:  "-",
+			DateLayout: "20060102",
+			Reset:      "daily",
+			Padding:    6,
+		},
+	}, nil)
+	assert.Nil(t, err)
+	today := time.Now().Format("20060102")
+	mockSequencerStore := mock_sequencer.NewMockStore(gomock.NewController(t))
+	next := int64(122)
+	mockSequencerStore.EXPECT().GetNextId(gomock.Any()).Times(3).
+		DoAndReturn(func(req *sequencer.GetNextIdRequest) (*sequencer.GetNextIdResponse, error) {
+			// the key is partitioned by date
+			assert.Equal(t, "sequencer|||order||"+today, req.Key)
+			next++
+			return &sequencer.GetNextIdResponse{
+				NextId: next,
+			}, nil
+		})
+	a := NewAPI("", nil, nil, nil, nil, nil, nil, nil, map[string]sequencer.Store{"formatted": mockSequencerStore}, nil, nil)
+	var apiForTest = a.(*api)
+	options := &runtimev1pb.SequencerOptions{
+		Increment: runtimev1pb.SequencerOptions_STRONG,
+	}
+	rsp, err := apiForTest.GetNextId(context.Background(), &runtimev1pb.GetNextIdRequest{
+		StoreName: "formatted",
+		Key:       "order",
+		Options:   options,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(123), rsp.NextId)
+	assert.Equal(t, "ORD-"+today+"-000123", rsp.FormattedId)
+
+	rsps, err := apiForTest.GetNextIds(context.Background(), &runtimev1pb.GetNextIdsRequest{
+		StoreName: "formatted",
+		Key:       "order",
+		Count:     2,
+		Options:   options,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{124, 125}, rsps.Ids)
+	assert.Equal(t, []string{"ORD-" + today + "-000124", "ORD-" + today + "-000125"}, rsps.FormattedIds)
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package s3
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mosn.io/pkg/log"
+
+	l8s3 "mosn.io/layotto/components/oss"
+	"mosn.io/layotto/spec/proto/extension/v1/s3"
+)
+
+// errMaxItems stops the listing when max_items is reached
+var errMaxItems = errors.New("max items reached")
+
+// objectLister pages through the objects of a ListAllObjects request
+type objectLister struct {
+	store  l8s3.Oss
+	req    *s3.ListAllObjectsInput
+	stream s3.ObjectStorageService_ListAllObjectsServer
+	// remaining is the number of items left before max_items, and it's negative if there is no limit
+	remaining int64
+	// last is the last item sent
+	last string
+}
+
+// ListAllObjects pages through all the objects under the prefix, and sends every page to the stream.
+// The marker or the continuation token is chosen by what the provider returns.
+func (s *S3Server) ListAllObjects(req *s3.ListAllObjectsInput, stream s3.ObjectStorageService_ListAllObjectsServer) error {
+	defer func() {
+		if err := recover(); err != nil {
+			log.DefaultLogger.Errorf("ListAllObjects occur panic, stack info: %+v", string(debug.Stack()))
+		}
+	}()
+	if s.ossInstance[req.StoreName] == nil {
+		return status.Errorf(codes.InvalidArgument, NotSupportStoreName, req.StoreName)
+	}
+	if req.MaxItems < 0 || req.PageSize < 0 {
+		return status.Errorf(codes.InvalidArgument, "max_items and page_size must not be negative")
+	}
+	l := &objectLister{store: s.ossInstance[req.StoreName], req: req, stream: stream, remaining: -1}
+	if req.MaxItems > 0 {
+		l.remaining = req.MaxItems
+	}
+	// the common prefixes are walked level by level if it's recursive
+	ctx := stream.Context()
+	prefixes := []string{req.Prefix}
+	marker := req.StartAfter
+	for len(prefixes) > 0 {
+		found, err := l.listPrefix(ctx, prefixes[0], marker)
+		if err == errMaxItems {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		prefixes = prefixes[1:]
+		marker = ""
+		if req.Recursive && req.Delimiter != "" {
+			prefixes = append(prefixes, found...)
+		}
+	}
+	return nil
+}
+
+// listPrefix sends all the pages under the prefix, and returns the common prefixes found
+func (l *objectLister) listPrefix(ctx context.Context, prefix string, marker string) ([]string, error) {
+	var found []string
+	token := ""
+	for {
+		input := &l8s3.ListObjectsInput{
+			Bucket:              l.req.Bucket,
+			Delimiter:           l.req.Delimiter,
+			EncodingType:        l.req.EncodingType,
+			ExpectedBucketOwner: l.req.ExpectedBucketOwner,
+			Marker:              marker,
+			MaxKeys:             l.req.PageSize,
+			Prefix:              prefix,
+			RequestPayer:        l.req.RequestPayer,
+			ContinuationToken:   token,
+		}
+		resp, err := l.store.ListObjects(ctx, input)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error occurred: %v", err.Error())
+		}
+		found = append(found, resp.CommonPrefixes...)
+		if err = l.send(resp); err != nil {
+			return nil, err
+		}
+		if !resp.IsTruncated {
+			return found, nil
+		}
+		// prefer the continuation token if the provider returns one
+		if resp.NextContinuationToken != "" {
+			token = resp.NextContinuationToken
+			continue
+		}
+		next := resp.NextMarker
+		if next == "" {
+			next = l8s3.LastListed(resp)
+		}
+		if next == "" || next <= marker {
+			return nil, status.Errorf(codes.Internal, "listing of %s makes no progress after marker %s", prefix, marker)
+		}
+		marker = next
+	}
+}
+
+// send sends the objects and the common prefixes of a page in the order of keys, until max_items is reached
+func (l *objectLister) send(resp *l8s3.ListObjectsOutput) error {
+	var contents []*s3.Object
+	if err := transferData(resp.Contents, &contents); err != nil {
+		return status.Errorf(codes.Internal, "transfer response data fail for ListAllObjects,err: %+v", err)
+	}
+	page := &s3.ListAllObjectsOutput{}
+	i, j := 0, 0
+	for i < len(contents) || j < len(resp.CommonPrefixes) {
+		if l.remaining == 0 {
+			page.IsTruncated = true
+			if !l.req.Recursive {
+				page.NextMarker = l.last
+			}
+			break
+		}
+		if j == len(resp.CommonPrefixes) || (i < len(contents) && contents[i].Key < resp.CommonPrefixes[j]) {
+			page.Contents = append(page.Contents, contents[i])
+			l.last = contents[i].Key
+			i++
+		} else {
+			page.CommonPrefixes = append(page.CommonPrefixes, resp.CommonPrefixes[j])
+			l.last = resp.CommonPrefixes[j]
+			j++
+		}
+		l.remaining--
+	}
+	if len(page.Contents) > 0 || len(page.CommonPrefixes) > 0 || page.IsTruncated {
+		if err := l.stream.Send(page); err != nil {
+			return status.Errorf(codes.Internal, "send ListAllObjects response fail,err: %+v", err)
+		}
+	}
+	if page.IsTruncated {
+		return errMaxItems
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	l8s3 "mosn.io/layotto/components/oss"
+	"mosn.io/layotto/pkg/grpc"
+	mockoss "mosn.io/layotto/pkg/mock/components/oss"
+	mocks3 "mosn.io/layotto/pkg/mock/runtime/oss"
+	"mosn.io/layotto/spec/proto/extension/v1/s3"
+)
+
+func TestListAllObjects(t *testing.T) {
+	ac := &grpc.ApplicationContext{AppId: "test", Oss: map[string]l8s3.Oss{}}
+	ctrl := gomock.NewController(t)
+	mockossServer := mockoss.NewMockOss(ctrl)
+	ac.Oss[MOCKSERVER] = mockossServer
+	s3Server := &S3Server{appId: ac.AppId, ossInstance: ac.Oss}
+
+	ctx := context.TODO()
+	mockServer := mocks3.NewMockObjectStorageService_ListAllObjectsServer(ctrl)
+	req := &s3.ListAllObjectsInput{StoreName: "NoStore", Bucket: "layotto", Prefix: "a/", PageSize: 2}
+	err := s3Server.ListAllObjects(req, mockServer)
+	assert.Equal(t, status.Errorf(codes.InvalidArgument, NotSupportStoreName, "NoStore"), err)
+
+	// page with the last key as the marker, and then with the continuation token
+	req.StoreName = MOCKSERVER
+	mockServer.EXPECT().Context().Return(ctx)
+	gomock.InOrder(
+		mockossServer.EXPECT().ListObjects(ctx, &l8s3.ListObjectsInput{Bucket: "layotto", Prefix: "a/", MaxKeys: 2}).
+			Return(&l8s3.ListObjectsOutput{Contents: []*l8s3.Object{{Key: "a/1"}, {Key: "a/2"}}, IsTruncated: true}, nil),
+		mockossServer.EXPECT().ListObjects(ctx, &l8s3.ListObjectsInput{Bucket: "layotto", Prefix: "a/", MaxKeys: 2, Marker: "a/2"}).
+			Return(&l8s3.ListObjectsOutput{Contents: []*l8s3.Object{{Key: "a/3"}}, IsTruncated: true, NextContinuationToken: "token"}, nil),
+		mockossServer.EXPECT().ListObjects(ctx, &l8s3.ListObjectsInput{Bucket: "layotto", Prefix: "a/", MaxKeys: 2, Marker: "a/2", ContinuationToken: "token"}).
+			Return(&l8s3.ListObjectsOutput{Contents: []*l8s3.Object{{Key: "a/4", Size: 1}}}, nil),
+	)
+	gomock.InOrder(
+		mockServer.EXPECT().Send(&s3.ListAllObjectsOutput{Contents: []*s3.Object{{Key: "a/1"}, {Key: "a/2"}}}),
+		mockServer.EXPECT().Send(&s3.ListAllObjectsOutput{Contents: []*s3.Object{{Key: "a/3"}}}),
+		mockServer.EXPECT().Send(&s3.ListAllObjectsOutput{Contents: []*s3.Object{{Key: "a/4", Size: 1}}}),
+	)
+	err = s3Server.ListAllObjects(req, mockServer)
+	assert.Nil(t, err)
+}
+
+func TestListAllObjectsRecursive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockossServer := mockoss.NewMockOss(ctrl)
+	s3Server := &S3Server{appId: "test", ossInstance: map[string]l8s3.Oss{MOCKSERVER: mockossServer}}
+
+	ctx := context.TODO()
+	mockServer := mocks3.NewMockObjectStorageService_ListAllObjectsServer(ctrl)
+	mockServer.EXPECT().Context().Return(ctx)
+	req := &s3.ListAllObjectsInput{StoreName: MOCKSERVER, Bucket: "layotto", Delimiter: "/", Recursive: true, MaxItems: 3}
+	gomock.InOrder(
+		mockossServer.EXPECT().ListObjects(ctx, &l8s3.ListObjectsInput{Bucket: "layotto", Delimiter: "/"}).
+			Return(&l8s3.ListObjectsOutput{Contents: []*l8s3.Object{{Key: "a"}, {Key: "c"}}, CommonPrefixes: []string{"b/"}}, nil),
+		mockossServer.EXPECT().ListObjects(ctx, &l8s3.ListObjectsInput{Bucket: "layotto", Delimiter: "/", Prefix: "b/"}).
+			Return(&l8s3.ListObjectsOutput{Contents: []*l8s3.Object{{Key: "b/1"}, {Key: "b/2"}}}, nil),
+	)
+	gomock.InOrder(
+		// the common prefixes are sent in the order of keys
+		mockServer.EXPECT().Send(&s3.ListAllObjectsOutput{Contents: []*s3.Object{{Key: "a"}, {Key: "c"}}, CommonPrefixes: []string{"b/"}}),
+		mockServer.EXPECT().Send(&s3.ListAllObjectsOutput{IsTruncated: true}),
+	)
+	err := s3Server.ListAllObjects(req, mockServer)
+	assert.Nil(t, err)
+}
+
+func TestListAllObjectsMaxItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockossServer := mockoss.NewMockOss(ctrl)
+	s3Server := &S3Server{appId: "test", ossInstance: map[string]l8s3.Oss{MOCKSERVER: mockossServer}}
+
+	ctx := context.TODO()
+	mockServer := mocks3.NewMockObjectStorageService_ListAllObjectsServer(ctrl)
+	mockServer.EXPECT().Context().Return(ctx)
+	req := &s3.ListAllObjectsInput{StoreName: MOCKSERVER, Bucket: "layotto", MaxItems: 1, StartAfter: "0"}
+	mockossServer.EXPECT().ListObjects(ctx, &l8s3.ListObjectsInput{Bucket: "layotto", Marker: "0"}).
+		Return(&l8s3.ListObjectsOutput{Contents: []*l8s3.Object{{Key: "a"}, {Key: "b"}}}, nil)
+	mockServer.EXPECT().Send(&s3.ListAllObjectsOutput{Contents: []*s3.Object{{Key: "a"}}, IsTruncated: true, NextMarker: "a"})
+	err := s3Server.ListAllObjects(req, mockServer)
+	assert.Nil(t, err)
+
+	// the provider returns a truncated page without any key
+	mockServer.EXPECT().Context().Return(ctx)
+	req.MaxItems = 0
+	mockossServer.EXPECT().ListObjects(ctx, &l8s3.ListObjectsInput{Bucket: "layotto", Marker: "0"}).
+		Return(&l8s3.ListObjectsOutput{IsTruncated: true}, nil)
+	err = s3Server.ListAllObjects(req, mockServer)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	req.MaxItems = -1
+	err = s3Server.ListAllObjects(req, mockServer)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -45,10 +45,26 @@ const (
 	ErrLockOwnerEmpty          = "LockOwner is empty in lock store %s"
 	ErrExpireNotPositive       = "Expire is not positive in lock store %s"
 	ErrLockStoreNotFound       = "lock store %s not found"
+	ErrWaitTimeoutNotPositive  = "WaitTimeout is not positive in lock store %s"
+	ErrWaitTimeoutNegative     = "WaitTimeout is negative in lock store %s"
+	ErrSharedLockNotSupported  = "lock store %s doesn't support shared lock"
+	ErrReentrantNotSupported   = "lock store %s doesn't support reentrant lock"
+	ErrHolderCountNotSupported = "lock store %s doesn't support holder count query"
+	ErrLockInfoNotSupported    = "lock store %s doesn't support lock info query"
+	ErrListLockNotSupported    = "lock store %s doesn't support listing locks"
 	//	Sequencer
 	ErrSequencerStoresNotConfigured = "Sequencer store is not configured"
 	ErrSequencerKeyEmpty            = "Key is empty in sequencer store %s"
 	ErrSequencerStoreNotFound       = "Sequencer store %s not found"
+	ErrSequencerCountInvalid        = "Count %d is out of range [1, %d] in sequencer store %s"
+	//	Configuration
+	ErrConfigHistoryNotSupported = "config store %s doesn't support configuration history"
+	ErrConfigKeyEmpty            = "Key is empty in config store %s"
+	ErrConfigRevisionEmpty       = "Revision is empty in config store %s"
+	ErrConfigContentInvalid      = "content of key %s is invalid in config store %s: %v"
+	//	File
+	ErrResumableUploadNotSupported = "file store %s doesn't support resumable upload"
+	ErrRangeReadNotSupported       = "file store %s doesn't support ranged or conditional reads"
 
 	// Binding.
 	ErrInvokeOutputBinding = "error when invoke output binding %s: %s"
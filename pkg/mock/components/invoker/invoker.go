@@ -142,6 +142,21 @@ func (mr *MockCallbackMockRecorder) BeforeInvoke(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeInvoke", reflect.TypeOf((*MockCallback)(nil).BeforeInvoke), arg0)
 }
 
+// BeforeInvokeStream mocks base method.
+func (m *MockCallback) BeforeInvokeStream(arg0 *rpc.RPCRequest) (*rpc.RPCRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeforeInvokeStream", arg0)
+	ret0, _ := ret[0].(*rpc.RPCRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeforeInvokeStream indicates an expected call of BeforeInvokeStream.
+func (mr *MockCallbackMockRecorder) BeforeInvokeStream(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeInvokeStream", reflect.TypeOf((*MockCallback)(nil).BeforeInvokeStream), arg0)
+}
+
 // MockChannel is a mock of Channel interface.
 type MockChannel struct {
 	ctrl     *gomock.Controller
@@ -37,6 +37,20 @@ func (m *MockFile) EXPECT() *MockFileMockRecorder {
 	return m.recorder
 }
 
+// Copy mocks base method.
+func (m *MockFile) Copy(arg0 context.Context, arg1 *file.CopyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockFileMockRecorder) Copy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockFile)(nil).Copy), arg0, arg1)
+}
+
 // Del mocks base method.
 func (m *MockFile) Del(arg0 context.Context, arg1 *file.DelRequest) error {
 	m.ctrl.T.Helper()
@@ -109,6 +123,20 @@ func (mr *MockFileMockRecorder) Put(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockFile)(nil).Put), arg0, arg1)
 }
 
+// Rename mocks base method.
+func (m *MockFile) Rename(arg0 context.Context, arg1 *file.RenameRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockFileMockRecorder) Rename(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockFile)(nil).Rename), arg0, arg1)
+}
+
 // Stat mocks base method.
 func (m *MockFile) Stat(arg0 context.Context, arg1 *file.FileMetaRequest) (*file.FileMetaResp, error) {
 	m.ctrl.T.Helper()
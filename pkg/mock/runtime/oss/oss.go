@@ -299,6 +299,26 @@ func (mr *MockObjectStorageServiceClientMockRecorder) IsObjectExist(ctx, in inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsObjectExist", reflect.TypeOf((*MockObjectStorageServiceClient)(nil).IsObjectExist), varargs...)
 }
 
+// ListAllObjects mocks base method.
+func (m *MockObjectStorageServiceClient) ListAllObjects(ctx context.Context, in *s3.ListAllObjectsInput, opts ...grpc.CallOption) (s3.ObjectStorageService_ListAllObjectsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAllObjects", varargs...)
+	ret0, _ := ret[0].(s3.ObjectStorageService_ListAllObjectsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllObjects indicates an expected call of ListAllObjects.
+func (mr *MockObjectStorageServiceClientMockRecorder) ListAllObjects(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllObjects", reflect.TypeOf((*MockObjectStorageServiceClient)(nil).ListAllObjects), varargs...)
+}
+
 // ListMultipartUploads mocks base method.
 func (m *MockObjectStorageServiceClient) ListMultipartUploads(ctx context.Context, in *s3.ListMultipartUploadsInput, opts ...grpc.CallOption) (*s3.ListMultipartUploadsOutput, error) {
 	m.ctrl.T.Helper()
@@ -819,6 +839,129 @@ func (mr *MockObjectStorageService_GetObjectClientMockRecorder) Trailer() *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockObjectStorageService_GetObjectClient)(nil).Trailer))
 }
 
+// MockObjectStorageService_ListAllObjectsClient is a mock of ObjectStorageService_ListAllObjectsClient interface.
+type MockObjectStorageService_ListAllObjectsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStorageService_ListAllObjectsClientMockRecorder
+}
+
+// MockObjectStorageService_ListAllObjectsClientMockRecorder is the mock recorder for MockObjectStorageService_ListAllObjectsClient.
+type MockObjectStorageService_ListAllObjectsClientMockRecorder struct {
+	mock *MockObjectStorageService_ListAllObjectsClient
+}
+
+// NewMockObjectStorageService_ListAllObjectsClient creates a new mock instance.
+func NewMockObjectStorageService_ListAllObjectsClient(ctrl *gomock.Controller) *MockObjectStorageService_ListAllObjectsClient {
+	mock := &MockObjectStorageService_ListAllObjectsClient{ctrl: ctrl}
+	mock.recorder = &MockObjectStorageService_ListAllObjectsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStorageService_ListAllObjectsClient) EXPECT() *MockObjectStorageService_ListAllObjectsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockObjectStorageService_ListAllObjectsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockObjectStorageService_ListAllObjectsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockObjectStorageService_ListAllObjectsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsClient) Recv() (*s3.ListAllObjectsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*s3.ListAllObjectsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockObjectStorageService_ListAllObjectsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockObjectStorageService_ListAllObjectsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockObjectStorageService_ListAllObjectsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockObjectStorageService_ListAllObjectsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockObjectStorageService_ListAllObjectsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockObjectStorageService_ListAllObjectsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsClient)(nil).Trailer))
+}
+
 // MockObjectStorageService_UploadPartClient is a mock of ObjectStorageService_UploadPartClient interface.
 type MockObjectStorageService_UploadPartClient struct {
 	ctrl     *gomock.Controller
@@ -1309,6 +1452,20 @@ func (mr *MockObjectStorageServiceServerMockRecorder) IsObjectExist(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsObjectExist", reflect.TypeOf((*MockObjectStorageServiceServer)(nil).IsObjectExist), arg0, arg1)
 }
 
+// ListAllObjects mocks base method.
+func (m *MockObjectStorageServiceServer) ListAllObjects(arg0 *s3.ListAllObjectsInput, arg1 s3.ObjectStorageService_ListAllObjectsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllObjects", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAllObjects indicates an expected call of ListAllObjects.
+func (mr *MockObjectStorageServiceServerMockRecorder) ListAllObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllObjects", reflect.TypeOf((*MockObjectStorageServiceServer)(nil).ListAllObjects), arg0, arg1)
+}
+
 // ListMultipartUploads mocks base method.
 func (m *MockObjectStorageServiceServer) ListMultipartUploads(arg0 context.Context, arg1 *s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error) {
 	m.ctrl.T.Helper()
@@ -1790,6 +1947,125 @@ func (mr *MockObjectStorageService_GetObjectServerMockRecorder) SetTrailer(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockObjectStorageService_GetObjectServer)(nil).SetTrailer), arg0)
 }
 
+// MockObjectStorageService_ListAllObjectsServer is a mock of ObjectStorageService_ListAllObjectsServer interface.
+type MockObjectStorageService_ListAllObjectsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStorageService_ListAllObjectsServerMockRecorder
+}
+
+// MockObjectStorageService_ListAllObjectsServerMockRecorder is the mock recorder for MockObjectStorageService_ListAllObjectsServer.
+type MockObjectStorageService_ListAllObjectsServerMockRecorder struct {
+	mock *MockObjectStorageService_ListAllObjectsServer
+}
+
+// NewMockObjectStorageService_ListAllObjectsServer creates a new mock instance.
+func NewMockObjectStorageService_ListAllObjectsServer(ctrl *gomock.Controller) *MockObjectStorageService_ListAllObjectsServer {
+	mock := &MockObjectStorageService_ListAllObjectsServer{ctrl: ctrl}
+	mock.recorder = &MockObjectStorageService_ListAllObjectsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStorageService_ListAllObjectsServer) EXPECT() *MockObjectStorageService_ListAllObjectsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockObjectStorageService_ListAllObjectsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockObjectStorageService_ListAllObjectsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockObjectStorageService_ListAllObjectsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsServer) Send(arg0 *s3.ListAllObjectsOutput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockObjectStorageService_ListAllObjectsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockObjectStorageService_ListAllObjectsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockObjectStorageService_ListAllObjectsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockObjectStorageService_ListAllObjectsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockObjectStorageService_ListAllObjectsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockObjectStorageService_ListAllObjectsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockObjectStorageService_ListAllObjectsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockObjectStorageService_ListAllObjectsServer)(nil).SetTrailer), arg0)
+}
+
 // MockObjectStorageService_UploadPartServer is a mock of ObjectStorageService_UploadPartServer interface.
 type MockObjectStorageService_UploadPartServer struct {
 	ctrl     *gomock.Controller
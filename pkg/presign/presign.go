@@ -0,0 +1,348 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package presign
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/oss"
+	"mosn.io/layotto/components/ref"
+)
+
+const (
+	ComponentTypeFile = "file"
+	ComponentTypeOss  = "oss"
+
+	// SignKey is the secret injected by the secret_ref of the presign config, which signs the urls
+	SignKey = "sign_key"
+	// PreviousSignKey is optional, and the urls signed by it are still accepted during key rotation
+	PreviousSignKey = "previous_sign_key"
+
+	DefaultMaxExpiresInSec      = 7 * 24 * 3600
+	DefaultReadHeaderTimeoutSec = 10
+	DefaultReadTimeoutSec       = 600
+	DefaultIdleTimeoutSec       = 60
+	// shutdownTimeout is how long Close waits for the requests in flight
+	shutdownTimeout = 5 * time.Second
+
+	expiresParam   = "expires"
+	signatureParam = "signature"
+)
+
+var (
+	ErrDisabled          = errors.New("presign is not configured")
+	ErrSignKeyRequired   = errors.New("sign_key is not injected by the secret_ref of presign")
+	ErrInvalidEndpoint   = errors.New("presign endpoint must be an absolute http url")
+	ErrInvalidTarget     = errors.New("store_name and name are required")
+	ErrInvalidExpires    = errors.New("expires_in_sec must be positive and not greater than max_expires_in_sec")
+	ErrMethodNotAllowed  = errors.New("method not allowed")
+	ErrSignatureNotMatch = errors.New("signature does not match")
+	ErrExpired           = errors.New("signed url has expired")
+)
+
+// Config is the presign configuration of the runtime.
+// The urls are served by an http server of the Presigner instead of a MOSN listener,
+// because the http1 codec of MOSN reads the whole body (at most 4MB by default) before the stream filters,
+// and MOSN only streams bodies of h2c, which browsers don't use. Terminate tls by a proxy in front of Address. e.g.
+//
+//	"presign": {
+//	  "endpoint": "http://127.0.0.1:34905/presign",
+//	  "max_expires_in_sec": 3600,
+//	  "secret_ref": [{"store_name": "secret_demo", "key": "presign", "sub_key": "key", "inject_as": "sign_key"}]
+//	}
+type Config struct {
+	// Endpoint is the prefix of the urls
+	Endpoint string `json:"endpoint"`
+	// Address is the address the urls are served on, and the host of Endpoint is used if it's empty.
+	// It differs from the host of Endpoint if the urls are exposed through a proxy.
+	Address string `json:"address,omitempty"`
+	// MaxExpiresInSec limits the lifetime of the urls, and DefaultMaxExpiresInSec is used if it's 0
+	MaxExpiresInSec int64 `json:"max_expires_in_sec"`
+	// ReadHeaderTimeoutSec, ReadTimeoutSec and IdleTimeoutSec limit the connections of the server,
+	// and the defaults are used if they're 0. ReadTimeoutSec covers the body, so it must allow the largest uploads.
+	ReadHeaderTimeoutSec int64 `json:"read_header_timeout_sec,omitempty"`
+	ReadTimeoutSec       int64 `json:"read_timeout_sec,omitempty"`
+	IdleTimeoutSec       int64 `json:"idle_timeout_sec,omitempty"`
+	// WriteTimeoutSec limits writing a response including the body, and there is no limit if it's 0
+	WriteTimeoutSec int64 `json:"write_timeout_sec,omitempty"`
+	// SecretRef injects SignKey and PreviousSignKey from the secret stores
+	SecretRef []*ref.SecretRefConfig `json:"secret_ref"`
+	// Metadata is passed to the file stores by store name, e.g. the FileMode and FileFlag required by the local file store
+	Metadata map[string]map[string]string `json:"metadata,omitempty"`
+}
+
+// Target is the file or the object a url grants access to
+type Target struct {
+	// ComponentType is ComponentTypeFile or ComponentTypeOss, and ComponentTypeFile is used if it's empty
+	ComponentType string
+	StoreName     string
+	// Bucket is only used by oss stores
+	Bucket string
+	Name   string
+	// Method is the http method allowed, and GET is used if it's empty
+	Method string
+}
+
+type SignResult struct {
+	URL string
+	// ExpiresAt is the unix time in seconds
+	ExpiresAt int64
+}
+
+// Presigner signs the urls of the file and oss stores, and serves them after verifying
+type Presigner struct {
+	mu         sync.RWMutex
+	enabled    bool
+	keys       [][]byte
+	endpoint   *url.URL
+	address    string
+	maxExpires int64
+	metadata   map[string]map[string]string
+	files      map[string]file.File
+	oss        map[string]oss.Oss
+	now        func() time.Time
+	timeouts   timeouts
+	server     *http.Server
+}
+
+type timeouts struct {
+	readHeader time.Duration
+	read       time.Duration
+	idle       time.Duration
+	write      time.Duration
+}
+
+func newTimeouts(config *Config) timeouts {
+	orDefault := func(sec, def int64) time.Duration {
+		if sec <= 0 {
+			sec = def
+		}
+		return time.Duration(sec) * time.Second
+	}
+	return timeouts{
+		readHeader: orDefault(config.ReadHeaderTimeoutSec, DefaultReadHeaderTimeoutSec),
+		read:       orDefault(config.ReadTimeoutSec, DefaultReadTimeoutSec),
+		idle:       orDefault(config.IdleTimeoutSec, DefaultIdleTimeoutSec),
+		write:      time.Duration(config.WriteTimeoutSec) * time.Second,
+	}
+}
+
+var defaultPresigner = NewPresigner()
+
+// GetDefault returns the Presigner initialized and started by the runtime
+func GetDefault() *Presigner {
+	return defaultPresigner
+}
+
+func NewPresigner() *Presigner {
+	return &Presigner{now: time.Now}
+}
+
+// Init enables the Presigner. The secrets are injected by the secret_ref of the config.
+func (p *Presigner) Init(config *Config, secrets map[string]string, files map[string]file.File, osses map[string]oss.Oss) error {
+	if secrets[SignKey] == "" {
+		return ErrSignKeyRequired
+	}
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return ErrInvalidEndpoint
+	}
+	endpoint.Path = strings.TrimSuffix(endpoint.Path, "/")
+	keys := [][]byte{[]byte(secrets[SignKey])}
+	if secrets[PreviousSignKey] != "" {
+		keys = append(keys, []byte(secrets[PreviousSignKey]))
+	}
+	maxExpires := config.MaxExpiresInSec
+	if maxExpires <= 0 {
+		maxExpires = DefaultMaxExpiresInSec
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.enabled = true
+	p.keys = keys
+	p.endpoint = endpoint
+	p.address = config.Address
+	if p.address == "" {
+		p.address = endpoint.Host
+	}
+	p.maxExpires = maxExpires
+	p.timeouts = newTimeouts(config)
+	p.metadata = config.Metadata
+	p.files = files
+	p.oss = osses
+	return nil
+}
+
+// SignURL returns a url of the target, which expires after expiresInSec
+func (p *Presigner) SignURL(target *Target, expiresInSec int64) (*SignResult, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if !p.enabled {
+		return nil, ErrDisabled
+	}
+	t, err := p.normalize(target)
+	if err != nil {
+		return nil, err
+	}
+	if expiresInSec <= 0 || expiresInSec > p.maxExpires {
+		return nil, ErrInvalidExpires
+	}
+	expiresAt := p.now().Unix() + expiresInSec
+	expires := strconv.FormatInt(expiresAt, 10)
+	query := url.Values{}
+	query.Set(expiresParam, expires)
+	query.Set(signatureParam, sign(p.keys[0], t, expires))
+	u := *p.endpoint
+	u.Path = p.endpoint.Path + targetPath(t)
+	u.RawQuery = query.Encode()
+	return &SignResult{URL: u.String(), ExpiresAt: expiresAt}, nil
+}
+
+// OssPresignFunc returns the function signing the urls of an oss store, which is injected into the oss.Presignable stores
+func (p *Presigner) OssPresignFunc(storeName string) oss.PresignFunc {
+	return func(bucket string, key string, method string, expiresInSec int64) (string, error) {
+		res, err := p.SignURL(&Target{ComponentType: ComponentTypeOss, StoreName: storeName, Bucket: bucket, Name: key, Method: method}, expiresInSec)
+		if err != nil {
+			return "", err
+		}
+		return res.URL, nil
+	}
+}
+
+// Verify checks the signature, the expiry and the method of a request, and returns the target of the url
+func (p *Presigner) Verify(method string, path string, query url.Values) (*Target, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if !p.enabled {
+		return nil, ErrDisabled
+	}
+	t, err := parseTargetPath(strings.TrimPrefix(path, p.endpoint.Path))
+	if err != nil || !strings.HasPrefix(path, p.endpoint.Path+"/") {
+		return nil, ErrSignatureNotMatch
+	}
+	t.Method = strings.ToUpper(method)
+	switch t.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	default:
+		return nil, ErrMethodNotAllowed
+	}
+	expires := query.Get(expiresParam)
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return nil, ErrSignatureNotMatch
+	}
+	signature := []byte(query.Get(signatureParam))
+	matched := false
+	for _, key := range p.keys {
+		if hmac.Equal([]byte(sign(key, t, expires)), signature) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		// the signature of another method doesn't match either
+		return nil, ErrSignatureNotMatch
+	}
+	if p.now().Unix() > expiresAt {
+		return nil, ErrExpired
+	}
+	return t, nil
+}
+
+// normalize fills the defaults of the target, and checks the store exists
+func (p *Presigner) normalize(target *Target) (*Target, error) {
+	t := *target
+	if t.ComponentType == "" {
+		t.ComponentType = ComponentTypeFile
+	}
+	t.Method = strings.ToUpper(t.Method)
+	if t.Method == "" {
+		t.Method = http.MethodGet
+	}
+	switch t.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	default:
+		return nil, ErrMethodNotAllowed
+	}
+	if t.StoreName == "" || t.Name == "" || strings.Contains(t.StoreName, "/") || strings.Contains(t.Bucket, "/") {
+		return nil, ErrInvalidTarget
+	}
+	switch t.ComponentType {
+	case ComponentTypeFile:
+		if _, ok := p.files[t.StoreName]; !ok {
+			return nil, fmt.Errorf("file store %s not found", t.StoreName)
+		}
+		t.Bucket = ""
+	case ComponentTypeOss:
+		if _, ok := p.oss[t.StoreName]; !ok {
+			return nil, fmt.Errorf("oss store %s not found", t.StoreName)
+		}
+		if t.Bucket == "" {
+			return nil, ErrInvalidTarget
+		}
+	default:
+		return nil, fmt.Errorf("component type %s not supported", t.ComponentType)
+	}
+	return &t, nil
+}
+
+func sign(key []byte, t *Target, expires string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.Join([]string{t.Method, t.ComponentType, t.StoreName, t.Bucket, t.Name, expires}, "\n")))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// targetPath returns the path of the target under the endpoint, which is
+// /file/{store_name}/{name} or /oss/{store_name}/{bucket}/{key}
+func targetPath(t *Target) string {
+	path := "/" + t.ComponentType + "/" + t.StoreName
+	if t.ComponentType == ComponentTypeOss {
+		path += "/" + t.Bucket
+	}
+	return path + "/" + t.Name
+}
+
+func parseTargetPath(path string) (*Target, error) {
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(segments) < 3 {
+		return nil, ErrInvalidTarget
+	}
+	t := &Target{ComponentType: segments[0], StoreName: segments[1], Name: segments[2]}
+	if t.ComponentType == ComponentTypeOss {
+		parts := strings.SplitN(t.Name, "/", 2)
+		if len(parts) < 2 {
+			return nil, ErrInvalidTarget
+		}
+		t.Bucket, t.Name = parts[0], parts[1]
+	}
+	if t.StoreName == "" || t.Name == "" {
+		return nil, ErrInvalidTarget
+	}
+	return t, nil
+}
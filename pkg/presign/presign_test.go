@@ -0,0 +1,360 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package presign
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/file"
+	localfile "mosn.io/layotto/components/file/local"
+	"mosn.io/layotto/components/oss"
+	localoss "mosn.io/layotto/components/oss/local"
+)
+
+func newPresigner(t *testing.T) *Presigner {
+	ossStore := localoss.NewLocalOss()
+	conf := `{"rootPath": "` + t.TempDir() + `", "buckets": ["bucket"]}`
+	err := ossStore.Init(context.TODO(), &oss.Config{Metadata: map[string]json.RawMessage{oss.BasicConfiguration: []byte(conf)}})
+	assert.Nil(t, err)
+	p := NewPresigner()
+	config := &Config{
+		Endpoint: "http://127.0.0.1:34905/presign/",
+		// O_WRONLY|O_CREATE|O_TRUNC with 0644
+		Metadata: map[string]map[string]string{"file_demo": {localfile.FileMode: "420", localfile.FileFlag: "577"}},
+	}
+	err = p.Init(config, map[string]string{SignKey: "key"},
+		map[string]file.File{"file_demo": localfile.NewLocalStore()}, map[string]oss.Oss{"oss_demo": ossStore})
+	assert.Nil(t, err)
+	return p
+}
+
+func newRequest(t *testing.T, method string, signed string) *Request {
+	u, err := url.Parse(signed)
+	assert.Nil(t, err)
+	return &Request{Method: method, Path: u.Path, Query: u.Query(), Header: http.Header{}}
+}
+
+func TestInit(t *testing.T) {
+	p := NewPresigner()
+	_, err := p.SignURL(&Target{StoreName: "file_demo", Name: "a.txt"}, 60)
+	assert.Equal(t, ErrDisabled, err)
+	err = p.Init(&Config{Endpoint: "http://127.0.0.1:34905"}, nil, nil, nil)
+	assert.Equal(t, ErrSignKeyRequired, err)
+	err = p.Init(&Config{Endpoint: "/presign"}, map[string]string{SignKey: "key"}, nil, nil)
+	assert.Equal(t, ErrInvalidEndpoint, err)
+}
+
+func TestSignURL(t *testing.T) {
+	p := newPresigner(t)
+	p.now = func() time.Time { return time.Unix(1000, 0) }
+
+	res, err := p.SignURL(&Target{StoreName: "file_demo", Name: "a b.txt"}, 60)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1060), res.ExpiresAt)
+	assert.True(t, strings.HasPrefix(res.URL, "http://127.0.0.1:34905/presign/file/file_demo/a%20b.txt?expires=1060&signature="))
+
+	res, err = p.SignURL(&Target{ComponentType: ComponentTypeOss, StoreName: "oss_demo", Bucket: "bucket", Name: "a/b.txt", Method: "put"}, 60)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(res.URL, "http://127.0.0.1:34905/presign/oss/oss_demo/bucket/a/b.txt?"))
+
+	_, err = p.SignURL(&Target{StoreName: "file_demo", Name: "a.txt", Method: "POST"}, 60)
+	assert.Equal(t, ErrMethodNotAllowed, err)
+	_, err = p.SignURL(&Target{StoreName: "file_demo"}, 60)
+	assert.Equal(t, ErrInvalidTarget, err)
+	_, err = p.SignURL(&Target{ComponentType: ComponentTypeOss, StoreName: "oss_demo", Name: "a.txt"}, 60)
+	assert.Equal(t, ErrInvalidTarget, err)
+	_, err = p.SignURL(&Target{StoreName: "not_exist", Name: "a.txt"}, 60)
+	assert.NotNil(t, err)
+	_, err = p.SignURL(&Target{StoreName: "file_demo", Name: "a.txt"}, 0)
+	assert.Equal(t, ErrInvalidExpires, err)
+	_, err = p.SignURL(&Target{StoreName: "file_demo", Name: "a.txt"}, DefaultMaxExpiresInSec+1)
+	assert.Equal(t, ErrInvalidExpires, err)
+}
+
+func TestVerify(t *testing.T) {
+	p := newPresigner(t)
+	res, err := p.SignURL(&Target{StoreName: "file_demo", Name: "a.txt"}, 60)
+	assert.Nil(t, err)
+	req := newRequest(t, http.MethodGet, res.URL)
+
+	target, err := p.Verify(req.Method, req.Path, req.Query)
+	assert.Nil(t, err)
+	assert.Equal(t, &Target{ComponentType: ComponentTypeFile, StoreName: "file_demo", Name: "a.txt", Method: http.MethodGet}, target)
+
+	// the signature is bound to the method and the target
+	_, err = p.Verify(http.MethodDelete, req.Path, req.Query)
+	assert.Equal(t, ErrSignatureNotMatch, err)
+	_, err = p.Verify(http.MethodPost, req.Path, req.Query)
+	assert.Equal(t, ErrMethodNotAllowed, err)
+	_, err = p.Verify(http.MethodGet, strings.Replace(req.Path, "a.txt", "b.txt", 1), req.Query)
+	assert.Equal(t, ErrSignatureNotMatch, err)
+	_, err = p.Verify(http.MethodGet, "/other"+req.Path, req.Query)
+	assert.Equal(t, ErrSignatureNotMatch, err)
+
+	p.now = func() time.Time { return time.Now().Add(time.Minute * 2) }
+	_, err = p.Verify(req.Method, req.Path, req.Query)
+	assert.Equal(t, ErrExpired, err)
+}
+
+func TestVerifyPreviousKey(t *testing.T) {
+	p := newPresigner(t)
+	res, err := p.SignURL(&Target{StoreName: "file_demo", Name: "a.txt"}, 60)
+	assert.Nil(t, err)
+	req := newRequest(t, http.MethodGet, res.URL)
+
+	// rotate the key
+	err = p.Init(&Config{Endpoint: "http://127.0.0.1:34905/presign"}, map[string]string{SignKey: "new", PreviousSignKey: "key"}, p.files, p.oss)
+	assert.Nil(t, err)
+	_, err = p.Verify(req.Method, req.Path, req.Query)
+	assert.Nil(t, err)
+
+	err = p.Init(&Config{Endpoint: "http://127.0.0.1:34905/presign"}, map[string]string{SignKey: "new"}, p.files, p.oss)
+	assert.Nil(t, err)
+	_, err = p.Verify(req.Method, req.Path, req.Query)
+	assert.Equal(t, ErrSignatureNotMatch, err)
+}
+
+func TestServeFile(t *testing.T) {
+	p := newPresigner(t)
+	name := filepath.Join(t.TempDir(), "a.txt")
+	signed := func(method string) *Request {
+		res, err := p.SignURL(&Target{StoreName: "file_demo", Name: name, Method: method}, 60)
+		assert.Nil(t, err)
+		return newRequest(t, method, res.URL)
+	}
+
+	req := signed(http.MethodPut)
+	req.Body = strings.NewReader("hello world")
+	resp := p.Serve(context.TODO(), req)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = p.Serve(context.TODO(), signed(http.MethodGet))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "11", resp.Header.Get("Content-Length"))
+	data, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "hello world", string(data))
+	etag := resp.Header.Get("Etag")
+
+	req = signed(http.MethodGet)
+	req.Header.Set("Range", "bytes=6-")
+	resp = p.Serve(context.TODO(), req)
+	assert.Equal(t, http.StatusPartialContent, resp.StatusCode)
+	assert.Equal(t, "bytes 6-10/11", resp.Header.Get("Content-Range"))
+	data, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "world", string(data))
+
+	req = signed(http.MethodGet)
+	req.Header.Set("Range", "bytes=0-4")
+	resp = p.Serve(context.TODO(), req)
+	assert.Equal(t, "bytes 0-4/11", resp.Header.Get("Content-Range"))
+	data, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "hello", string(data))
+
+	req = signed(http.MethodGet)
+	req.Header.Set("Range", "bytes=4-0")
+	resp = p.Serve(context.TODO(), req)
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, resp.StatusCode)
+
+	req = signed(http.MethodGet)
+	req.Header.Set("If-None-Match", etag)
+	resp = p.Serve(context.TODO(), req)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	assert.Nil(t, resp.Body)
+
+	resp = p.Serve(context.TODO(), signed(http.MethodHead))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "11", resp.Header.Get("Content-Length"))
+
+	resp = p.Serve(context.TODO(), signed(http.MethodDelete))
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp = p.Serve(context.TODO(), signed(http.MethodGet))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	req = signed(http.MethodGet)
+	req.Query.Set("signature", "invalid")
+	resp = p.Serve(context.TODO(), req)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestServeHTTP(t *testing.T) {
+	p := newPresigner(t)
+	server := httptest.NewServer(p)
+	defer server.Close()
+	name := filepath.Join(t.TempDir(), "a.txt")
+	signed := func(method string) string {
+		res, err := p.SignURL(&Target{StoreName: "file_demo", Name: name, Method: method}, 60)
+		assert.Nil(t, err)
+		return strings.Replace(res.URL, "http://127.0.0.1:34905", server.URL, 1)
+	}
+
+	// the body is streamed to the store
+	body := strings.Repeat("hello world", 10000)
+	req, err := http.NewRequest(http.MethodPut, signed(http.MethodPut), strings.NewReader(body))
+	assert.Nil(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Get(signed(http.MethodGet))
+	assert.Nil(t, err)
+	data, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, body, string(data))
+
+	resp, err = http.Head(signed(http.MethodHead))
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int64(len(body)), resp.ContentLength)
+
+	resp, err = http.Get(server.URL + "/presign/file/file_demo/a.txt")
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestStart(t *testing.T) {
+	assert.Equal(t, ErrDisabled, NewPresigner().Start())
+
+	p := newPresigner(t)
+	p.address = "127.0.0.1:0"
+	assert.Nil(t, p.Start())
+	// restarted
+	assert.Nil(t, p.Start())
+	assert.Nil(t, p.Close())
+	assert.Nil(t, p.Close())
+}
+
+func TestTimeouts(t *testing.T) {
+	tos := newTimeouts(&Config{})
+	assert.Equal(t, DefaultReadHeaderTimeoutSec*time.Second, tos.readHeader)
+	assert.Equal(t, DefaultReadTimeoutSec*time.Second, tos.read)
+	assert.Equal(t, DefaultIdleTimeoutSec*time.Second, tos.idle)
+	assert.Equal(t, time.Duration(0), tos.write)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	address := ln.Addr().String()
+	ln.Close()
+	p := newPresigner(t)
+	p.address = address
+	p.timeouts = newTimeouts(&Config{ReadHeaderTimeoutSec: 1, WriteTimeoutSec: 5})
+	assert.Nil(t, p.Start())
+	defer p.Close()
+	assert.Equal(t, time.Second, p.server.ReadHeaderTimeout)
+	assert.Equal(t, 5*time.Second, p.server.WriteTimeout)
+
+	// a client never finishing the headers is disconnected
+	conn, err := net.Dial("tcp", address)
+	assert.Nil(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("GET /presign HTTP/1.1\r\nHost: localhost\r\n"))
+	assert.Nil(t, err)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	start := time.Now()
+	_, err = ioutil.ReadAll(conn)
+	assert.Nil(t, err)
+	assert.True(t, time.Since(start) < 3*time.Second)
+}
+
+func TestServeOss(t *testing.T) {
+	p := newPresigner(t)
+	signed := func(method string) *Request {
+		res, err := p.SignURL(&Target{ComponentType: ComponentTypeOss, StoreName: "oss_demo", Bucket: "bucket", Name: "a/b.txt", Method: method}, 60)
+		assert.Nil(t, err)
+		return newRequest(t, method, res.URL)
+	}
+
+	req := signed(http.MethodPut)
+	req.Body = strings.NewReader("hello world")
+	req.Header.Set("Cache-Control", "no-cache")
+	resp := p.Serve(context.TODO(), req)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NotEmpty(t, resp.Header.Get("Etag"))
+
+	resp = p.Serve(context.TODO(), signed(http.MethodGet))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+	data, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "hello world", string(data))
+
+	req = signed(http.MethodGet)
+	req.Header.Set("Range", "bytes=6-")
+	resp = p.Serve(context.TODO(), req)
+	assert.Equal(t, http.StatusPartialContent, resp.StatusCode)
+	data, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "world", string(data))
+
+	resp = p.Serve(context.TODO(), signed(http.MethodHead))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = p.Serve(context.TODO(), signed(http.MethodDelete))
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestParseRange(t *testing.T) {
+	start, end, err := parseRange("bytes=1-2")
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2}, []int64{start, end})
+	start, end, err = parseRange("bytes=3-")
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, -1}, []int64{start, end})
+	for _, value := range []string{"1-2", "bytes=-2", "bytes=1-2,3-4", "bytes=a-"} {
+		_, _, err = parseRange(value)
+		assert.Equal(t, file.ErrRange, err, value)
+	}
+}
+
+func TestOssPresignFunc(t *testing.T) {
+	p := newPresigner(t)
+	store := p.oss["oss_demo"].(*localoss.LocalOss)
+	store.SetPresignFunc(p.OssPresignFunc("oss_demo"))
+	_, err := store.PutObject(context.TODO(), &oss.PutObjectInput{Bucket: "bucket", Key: "a/b.txt", DataStream: strings.NewReader("hello")})
+	assert.Nil(t, err)
+
+	// the url signed by the local oss is served by the presigner
+	out, err := store.SignURL(context.TODO(), &oss.SignURLInput{Bucket: "bucket", Key: "a/b.txt", Method: "GET", ExpiredInSec: 60})
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(out.SignedUrl, "http://127.0.0.1:34905/presign/oss/oss_demo/bucket/a/b.txt?"))
+	resp := p.Serve(context.TODO(), newRequest(t, http.MethodGet, out.SignedUrl))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	data, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	_, err = store.SignURL(context.TODO(), &oss.SignURLInput{Bucket: "bucket", Key: "a/b.txt", Method: "GET", ExpiredInSec: DefaultMaxExpiresInSec + 1})
+	assert.Equal(t, ErrInvalidExpires, err)
+}
@@ -0,0 +1,345 @@
+/*
+ * Copyright 2021 Layotto Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package presign
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/file"
+	"mosn.io/layotto/components/oss"
+)
+
+// Request is a http request to a signed url
+type Request struct {
+	Method string
+	// Path is the decoded path of the url
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   io.Reader
+}
+
+// Response is the http response of a Request
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	// Body is nil if there is no body
+	Body io.ReadCloser
+}
+
+// Serve verifies the request and accesses the target of the url with the store
+func (p *Presigner) Serve(ctx context.Context, req *Request) *Response {
+	t, err := p.Verify(req.Method, req.Path, req.Query)
+	if err != nil {
+		log.DefaultLogger.Warnf("[presign] verify %s %s error: %v", req.Method, req.Path, err)
+		return errorResponse(err)
+	}
+	p.mu.RLock()
+	f, o := p.files[t.StoreName], p.oss[t.StoreName]
+	metadata := make(map[string]string)
+	for k, v := range p.metadata[t.StoreName] {
+		metadata[k] = v
+	}
+	p.mu.RUnlock()
+	var resp *Response
+	if t.ComponentType == ComponentTypeOss {
+		resp, err = serveOss(ctx, o, t, req)
+	} else {
+		resp, err = serveFile(ctx, f, t, metadata, req)
+	}
+	if err != nil {
+		log.DefaultLogger.Errorf("[presign] %s %s of %s store %s error: %v", t.Method, t.Name, t.ComponentType, t.StoreName, err)
+		return errorResponse(err)
+	}
+	return resp
+}
+
+// ServeHTTP serves a request to a signed url. The bodies are streamed rather than read into memory.
+func (p *Presigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// the stores use the content length to upload the body as a stream
+	if r.ContentLength > 0 {
+		r.Header.Set("Content-Length", strconv.FormatInt(r.ContentLength, 10))
+	}
+	resp := p.Serve(r.Context(), &Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query(), Header: r.Header, Body: r.Body})
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	header := w.Header()
+	for k, v := range resp.Header {
+		header[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	if resp.Body == nil || r.Method == http.MethodHead {
+		return
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		log.DefaultLogger.Errorf("[presign] write body of %s %s error: %v", r.Method, r.URL.Path, err)
+	}
+}
+
+// Start serves the signed urls on the address of the config, and the server started before is closed
+func (p *Presigner) Start() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.enabled {
+		return ErrDisabled
+	}
+	if p.server != nil {
+		p.server.Close()
+	}
+	ln, err := net.Listen("tcp", p.address)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           p,
+		ReadHeaderTimeout: p.timeouts.readHeader,
+		ReadTimeout:       p.timeouts.read,
+		WriteTimeout:      p.timeouts.write,
+		IdleTimeout:       p.timeouts.idle,
+	}
+	p.server = server
+	address := p.address
+	go func() {
+		if err := server.Serve(ln); err != nil && err != http.ErrServerClosed {
+			log.DefaultLogger.Errorf("[presign] serve on %s error: %v", address, err)
+		}
+	}()
+	return nil
+}
+
+// Close stops serving the signed urls, and waits for the requests in flight until shutdownTimeout
+func (p *Presigner) Close() error {
+	p.mu.Lock()
+	server := p.server
+	p.server = nil
+	p.mu.Unlock()
+	if server == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		return server.Close()
+	}
+	return nil
+}
+
+func serveFile(ctx context.Context, store file.File, t *Target, metadata map[string]string, req *Request) (*Response, error) {
+	if store == nil {
+		return nil, file.ErrNotExist
+	}
+	resp := &Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	switch t.Method {
+	case http.MethodGet:
+		reader, ok := store.(file.RangeReader)
+		if !ok {
+			// the range and the conditions are ignored by the stores without ranged reads, as HTTP allows
+			data, err := store.Get(ctx, &file.GetFileStu{FileName: t.Name, Metadata: metadata})
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = data
+			break
+		}
+		get := &file.GetRangeRequest{FileName: t.Name, Metadata: metadata, IfNoneMatch: req.Header.Get("If-None-Match")}
+		if since := req.Header.Get("If-Modified-Since"); since != "" {
+			get.IfModifiedSince, _ = http.ParseTime(since)
+		}
+		ranged := false
+		if r := req.Header.Get("Range"); r != "" {
+			start, end, err := parseRange(r)
+			if err != nil {
+				return nil, err
+			}
+			get.Offset = start
+			if end >= 0 {
+				get.Length = end - start + 1
+			}
+			ranged = true
+		}
+		out, err := reader.GetRange(ctx, get)
+		if err != nil {
+			return nil, err
+		}
+		setHeader(resp.Header, "Etag", out.ETag)
+		if out.NotModified {
+			resp.StatusCode = http.StatusNotModified
+			return resp, nil
+		}
+		resp.Body = out.DataStream
+		if ranged && out.Size > 0 {
+			end := out.Size - 1
+			if get.Length > 0 && get.Offset+get.Length < out.Size {
+				end = get.Offset + get.Length - 1
+			}
+			resp.StatusCode = http.StatusPartialContent
+			resp.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", get.Offset, end, out.Size))
+			resp.Header.Set("Content-Length", strconv.FormatInt(end-get.Offset+1, 10))
+		} else if out.Size > 0 {
+			resp.Header.Set("Content-Length", strconv.FormatInt(out.Size, 10))
+		}
+	case http.MethodHead:
+		out, err := store.Stat(ctx, &file.FileMetaRequest{FileName: t.Name, Metadata: metadata})
+		if err != nil {
+			return nil, err
+		}
+		resp.Header.Set("Content-Length", strconv.FormatInt(out.Size, 10))
+	case http.MethodPut:
+		err := store.Put(ctx, &file.PutFileStu{DataStream: req.Body, FileName: t.Name, Metadata: metadata})
+		if err != nil {
+			return nil, err
+		}
+	case http.MethodDelete:
+		if err := store.Del(ctx, &file.DelRequest{FileName: t.Name, Metadata: metadata}); err != nil {
+			return nil, err
+		}
+		resp.StatusCode = http.StatusNoContent
+	}
+	return resp, nil
+}
+
+func serveOss(ctx context.Context, store oss.Oss, t *Target, req *Request) (*Response, error) {
+	if store == nil {
+		return nil, file.ErrNotExist
+	}
+	resp := &Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	switch t.Method {
+	case http.MethodGet:
+		get := &oss.GetObjectInput{
+			Bucket:      t.Bucket,
+			Key:         t.Name,
+			IfMatch:     req.Header.Get("If-Match"),
+			IfNoneMatch: req.Header.Get("If-None-Match"),
+		}
+		if r := req.Header.Get("Range"); r != "" {
+			start, end, err := parseRange(r)
+			if err != nil {
+				return nil, err
+			}
+			// the oss api reads the whole object if both Start and End are 0
+			get.Start = start
+			if end > 0 {
+				get.End = end
+			}
+		}
+		out, err := store.GetObject(ctx, get)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = out.DataStream
+		resp.Header.Set("Content-Length", strconv.FormatInt(out.ContentLength, 10))
+		setHeader(resp.Header, "Content-Type", out.ContentType)
+		setHeader(resp.Header, "Etag", out.ETag)
+		setHeader(resp.Header, "Cache-Control", out.CacheControl)
+		setHeader(resp.Header, "Content-Disposition", out.ContentDisposition)
+		setHeader(resp.Header, "Content-Encoding", out.ContentEncoding)
+		if out.ContentRange != "" {
+			resp.StatusCode = http.StatusPartialContent
+			resp.Header.Set("Content-Range", out.ContentRange)
+		}
+	case http.MethodHead:
+		out, err := store.HeadObject(ctx, &oss.HeadObjectInput{Bucket: t.Bucket, Key: t.Name})
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range out.ResultMetadata {
+			resp.Header.Set(k, v)
+		}
+	case http.MethodPut:
+		put := &oss.PutObjectInput{
+			DataStream:         req.Body,
+			Bucket:             t.Bucket,
+			Key:                t.Name,
+			CacheControl:       req.Header.Get("Cache-Control"),
+			ContentDisposition: req.Header.Get("Content-Disposition"),
+			ContentEncoding:    req.Header.Get("Content-Encoding"),
+		}
+		put.ContentLength, _ = strconv.ParseInt(req.Header.Get("Content-Length"), 10, 64)
+		out, err := store.PutObject(ctx, put)
+		if err != nil {
+			return nil, err
+		}
+		setHeader(resp.Header, "Etag", out.ETag)
+	case http.MethodDelete:
+		if _, err := store.DeleteObject(ctx, &oss.DeleteObjectInput{Bucket: t.Bucket, Key: t.Name}); err != nil {
+			return nil, err
+		}
+		resp.StatusCode = http.StatusNoContent
+	}
+	return resp, nil
+}
+
+// parseRange parses a single range "bytes=start-end" or "bytes=start-", and end is -1 for the latter
+func parseRange(value string) (int64, int64, error) {
+	spec := strings.TrimPrefix(value, "bytes=")
+	i := strings.Index(spec, "-")
+	if spec == value || i <= 0 || strings.Contains(spec, ",") {
+		return 0, 0, file.ErrRange
+	}
+	start, err := strconv.ParseInt(spec[:i], 10, 64)
+	if err != nil {
+		return 0, 0, file.ErrRange
+	}
+	if spec[i+1:] == "" {
+		return start, -1, nil
+	}
+	end, err := strconv.ParseInt(spec[i+1:], 10, 64)
+	if err != nil || end < start {
+		return 0, 0, file.ErrRange
+	}
+	return start, end, nil
+}
+
+func errorResponse(err error) *Response {
+	code := http.StatusInternalServerError
+	switch err {
+	case ErrSignatureNotMatch, ErrExpired, file.ErrPermission:
+		code = http.StatusForbidden
+	case ErrMethodNotAllowed:
+		code = http.StatusMethodNotAllowed
+	case ErrDisabled, file.ErrNotExist:
+		code = http.StatusNotFound
+	case file.ErrRange:
+		code = http.StatusRequestedRangeNotSatisfiable
+	case file.ErrInvalid:
+		code = http.StatusBadRequest
+	}
+	if os.IsNotExist(err) {
+		code = http.StatusNotFound
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "text/plain; charset=utf-8")
+	return &Response{StatusCode: code, Header: header, Body: ioutil.NopCloser(strings.NewReader(err.Error()))}
+}
+
+func setHeader(header http.Header, key string, value string) {
+	if value != "" {
+		header.Set(key, value)
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"mosn.io/layotto/components/oss"
 
+	"mosn.io/layotto/pkg/presign"
 	"mosn.io/layotto/pkg/runtime/secretstores"
 
 	"mosn.io/layotto/components/custom"
@@ -58,6 +59,7 @@ type MosnRuntimeConfig struct {
 	SequencerManagement    map[string]sequencer.Config         `json:"sequencer"`
 	Bindings               map[string]bindings.Metadata        `json:"bindings"`
 	SecretStoresManagement map[string]secretstores.Metadata    `json:"secret_store"`
+	Presign                *presign.Config                     `json:"presign,omitempty"`
 	// <component type,component name,config>
 	// e.g. <"super_pubsub","etcd",config>
 	CustomComponent map[string]map[string]custom.Config `json:"custom_component,omitempty"`
@@ -0,0 +1,71 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package configstores
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// schemaConfiguration is the compiled JSON Schemas keyed by store name and then by configuration key
+var (
+	schemaConfiguration = map[string]map[string]*jsonschema.Schema{}
+	schemaLock          sync.RWMutex
+)
+
+// SaveSchemaConfiguration compiles the JSON Schemas of the configuration keys in a store.
+// The schemas of the store are removed if schemas is empty.
+func SaveSchemaConfiguration(storeName string, schemas map[string]json.RawMessage) error {
+	compiled := make(map[string]*jsonschema.Schema, len(schemas))
+	for key, schema := range schemas {
+		s, err := jsonschema.CompileString(fmt.Sprintf("%s/%s.json", storeName, key), string(schema))
+		if err != nil {
+			return fmt.Errorf("illegal schema of key %s in config store %s: %v", key, storeName, err)
+		}
+		compiled[key] = s
+	}
+	schemaLock.Lock()
+	defer schemaLock.Unlock()
+	if len(compiled) == 0 {
+		delete(schemaConfiguration, storeName)
+		return nil
+	}
+	schemaConfiguration[storeName] = compiled
+	return nil
+}
+
+// HasSchema reports whether the configuration key in a store has a JSON Schema
+func HasSchema(storeName string, key string) bool {
+	schemaLock.RLock()
+	defer schemaLock.RUnlock()
+	return schemaConfiguration[storeName][key] != nil
+}
+
+// ValidateContent validates the content of a configuration key against its JSON Schema.
+// It returns nil if the key has no schema, or the content is empty, which means the configuration is not set.
+func ValidateContent(storeName string, key string, content string) error {
+	schemaLock.RLock()
+	schema := schemaConfiguration[storeName][key]
+	schemaLock.RUnlock()
+	if schema == nil || content == "" {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(content), &v); err != nil {
+		return fmt.Errorf("content is not json: %v", err)
+	}
+	return schema.Validate(v)
+}
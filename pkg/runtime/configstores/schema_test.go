@@ -0,0 +1,59 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package configstores
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateContent(t *testing.T) {
+	err := SaveSchemaConfiguration("store", map[string]json.RawMessage{
+		"db": json.RawMessage(`{"type": "object", "properties": {"port": {"type": "integer"}}, "required": ["port"]}`),
+	})
+	assert.Nil(t, err)
+	defer SaveSchemaConfiguration("store", nil)
+
+	assert.True(t, HasSchema("store", "db"))
+	assert.False(t, HasSchema("store", "other"))
+	assert.False(t, HasSchema("other", "db"))
+
+	assert.Nil(t, ValidateContent("store", "db", `{"port": 3306}`))
+	// the configuration is not set
+	assert.Nil(t, ValidateContent("store", "db", ""))
+	// no schema
+	assert.Nil(t, ValidateContent("store", "other", "{"))
+	assert.Nil(t, ValidateContent("other", "db", "{"))
+
+	assert.NotNil(t, ValidateContent("store", "db", `{"port": "3306"}`))
+	assert.NotNil(t, ValidateContent("store", "db", `{}`))
+	assert.NotNil(t, ValidateContent("store", "db", `{"port": 3306`))
+}
+
+func TestSaveSchemaConfiguration(t *testing.T) {
+	err := SaveSchemaConfiguration("store", map[string]json.RawMessage{
+		"db": json.RawMessage(`{"type": 1}`),
+	})
+	assert.NotNil(t, err)
+
+	err = SaveSchemaConfiguration("store", map[string]json.RawMessage{
+		"db": json.RawMessage(`{"type": "integer"}`),
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, ValidateContent("store", "db", `"a"`))
+	err = SaveSchemaConfiguration("store", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, ValidateContent("store", "db", `"a"`))
+}
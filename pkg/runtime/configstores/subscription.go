@@ -0,0 +1,477 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package configstores
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"mosn.io/pkg/log"
+	"mosn.io/pkg/utils"
+
+	"mosn.io/layotto/components/configstores"
+)
+
+// DefaultChangeLogSize is the number of changes kept by a Hub
+const DefaultChangeLogSize = 1024
+
+// hubs is the Hubs keyed by store name
+var (
+	hubs    = map[string]*Hub{}
+	hubLock sync.Mutex
+)
+
+// GetHub returns the Hub of a config store, and creates it at the first time.
+func GetHub(storeName string, store configstores.Store) *Hub {
+	hubLock.Lock()
+	defer hubLock.Unlock()
+	if h, ok := hubs[storeName]; ok && h.store == store {
+		return h
+	}
+	h := NewHub(storeName, store, DefaultChangeLogSize)
+	hubs[storeName] = h
+	return h
+}
+
+// NewLayerSubscriber returns the LayerSubscriber of a LayeredStore, which subscribes the layers through their Hubs,
+// so that a layer shared with other subscribers is stopped after the last subscriber stops.
+func NewLayerSubscriber(names []string, layers []configstores.Store) configstores.LayerSubscriber {
+	return func(index int, req *configstores.SubscribeReq, ch chan *configstores.SubscribeResp) (func(), error) {
+		s, err := GetHub(names[index], layers[index]).Subscribe(req, 0, ch)
+		if err != nil {
+			return nil, err
+		}
+		return s.Stop, nil
+	}
+}
+
+// topic is a key subscribed from the config store. The empty key means all the keys in the group and label.
+type topic struct {
+	appId string
+	group string
+	label string
+	key   string
+}
+
+// change is an item changed at a revision
+type change struct {
+	revision int64
+	appId    string
+	item     *configstores.ConfigurationItem
+}
+
+// Hub shares one subscription of a config store among the subscribers, so that a subscriber can be stopped without affecting the others.
+// It keeps a bounded log of the changes, so that a subscriber can resume from the revision it received last time.
+// The topics are reference counted, and the subscription of the store is stopped after the last subscriber stops.
+//
+// The revisions are the native ones if the store is a RevisionStore.
+// Otherwise they are assigned by the Hub, starting from the time the Hub is created in nanoseconds,
+// so the revisions got before the runtime restarts are always older than the log.
+type Hub struct {
+	storeName string
+	store     configstores.Store
+	// native is nil if the store doesn't keep revisions
+	native configstores.RevisionStore
+	size   int
+
+	// subscribeLock serializes subscribing from the store and stopping it
+	subscribeLock sync.Mutex
+	started       bool
+	// ch receives the changes from the store, and stopped is closed to stop dispatching after the subscription of the store is stopped
+	ch      chan *configstores.SubscribeResp
+	stopped chan struct{}
+
+	mu sync.Mutex
+	// topics are subscribed from the store, with the revision since which their changes are logged.
+	// It's 0 for a native store until the next change is received.
+	topics map[topic]int64
+	// refs are the number of the subscribers of every topic
+	refs map[topic]int
+	log  []*change
+	// the changes after floor are all in the log
+	floor       int64
+	revision    int64
+	subscribers map[*Subscription]struct{}
+}
+
+// NewHub creates a Hub keeping size changes at most.
+func NewHub(storeName string, store configstores.Store, size int) *Hub {
+	h := &Hub{
+		storeName:   storeName,
+		store:       store,
+		size:        size,
+		topics:      make(map[topic]int64),
+		refs:        make(map[topic]int),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+	if native, ok := store.(configstores.RevisionStore); ok {
+		h.native = native
+	} else {
+		h.revision = time.Now().UnixNano()
+		h.floor = h.revision
+	}
+	return h
+}
+
+// Subscription is a subscriber of a Hub.
+// The changes are queued and sent to ch in the order received, so that a slow subscriber doesn't block the others.
+type Subscription struct {
+	hub    *Hub
+	appId  string
+	group  string
+	label  string
+	topics []topic
+	// keys are all the keys if empty
+	keys map[string]struct{}
+	ch   chan *configstores.SubscribeResp
+	done chan struct{}
+	once sync.Once
+
+	mu     sync.Mutex
+	queue  []*configstores.SubscribeResp
+	notify chan struct{}
+}
+
+// Stop stops the subscription. The other subscriptions of the store are not affected.
+func (s *Subscription) Stop() {
+	s.once.Do(func() {
+		close(s.done)
+		s.hub.unsubscribe(s)
+	})
+}
+
+// push queues a change to send
+func (s *Subscription) push(resp *configstores.SubscribeResp) {
+	s.mu.Lock()
+	s.queue = append(s.queue, resp)
+	s.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the first change queued, or nil if the queue is empty
+func (s *Subscription) pop() *configstores.SubscribeResp {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.queue) == 0 {
+		return nil
+	}
+	resp := s.queue[0]
+	s.queue[0] = nil
+	s.queue = s.queue[1:]
+	return resp
+}
+
+// send sends the changes queued to ch until the subscription is stopped
+func (s *Subscription) send() {
+	for {
+		select {
+		case <-s.notify:
+		case <-s.done:
+			return
+		}
+		for resp := s.pop(); resp != nil; resp = s.pop() {
+			select {
+			case s.ch <- resp:
+			case <-s.done:
+				return
+			}
+		}
+	}
+}
+
+// Subscribe subscribes the changes and sends them to ch.
+// If sinceRevision is not 0, the items changed after it are sent first.
+// They are got from the log, or from the store if the log doesn't cover it.
+func (h *Hub) Subscribe(req *configstores.SubscribeReq, sinceRevision int64, ch chan *configstores.SubscribeResp) (*Subscription, error) {
+	// 1. subscribe the new topics from the store.
+	// The store may block on sending changes when subscribing, so h.mu is not held here.
+	h.subscribeLock.Lock()
+	defer h.subscribeLock.Unlock()
+	topics := topicsOf(req)
+	h.mu.Lock()
+	newKeys := make([]string, 0, len(req.Keys))
+	for _, t := range topics {
+		if _, ok := h.topics[t]; !ok {
+			newKeys = append(newKeys, t.key)
+		}
+	}
+	h.mu.Unlock()
+	if len(newKeys) > 0 {
+		if !h.started {
+			h.ch = make(chan *configstores.SubscribeResp)
+			h.stopped = make(chan struct{})
+			storeCh, stopped := h.ch, h.stopped
+			utils.GoWithRecover(func() {
+				h.dispatch(storeCh, stopped)
+			}, nil)
+			h.started = true
+		}
+		subReq := &configstores.SubscribeReq{AppId: req.AppId, Group: req.Group, Label: req.Label, Metadata: req.Metadata}
+		if len(req.Keys) > 0 {
+			subReq.Keys = newKeys
+		}
+		if err := h.store.Subscribe(subReq, h.ch); err != nil {
+			return nil, err
+		}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, t := range topics {
+		if _, ok := h.topics[t]; !ok {
+			h.topics[t] = h.loggedSince()
+		}
+		h.refs[t]++
+	}
+	// 2. register the subscriber
+	s := &Subscription{
+		hub:    h,
+		appId:  req.AppId,
+		group:  req.Group,
+		label:  req.Label,
+		topics: topics,
+		keys:   make(map[string]struct{}, len(req.Keys)),
+		ch:     ch,
+		done:   make(chan struct{}),
+		notify: make(chan struct{}, 1),
+	}
+	for _, key := range req.Keys {
+		s.keys[key] = struct{}{}
+	}
+	h.subscribers[s] = struct{}{}
+	utils.GoWithRecover(s.send, nil)
+	// 3. send the changes missed. The lock is held, so the changes received later are queued after them.
+	if sinceRevision != 0 {
+		if resp := h.replay(req, topics, sinceRevision); resp != nil {
+			s.push(resp)
+		}
+	}
+	return s, nil
+}
+
+// unsubscribe removes the subscriber, and stops the subscription of the store if no topic is subscribed any more.
+// The store can't stop subscribing a part of the topics, so the other topics are kept subscribed until then.
+func (h *Hub) unsubscribe(s *Subscription) {
+	h.subscribeLock.Lock()
+	defer h.subscribeLock.Unlock()
+	h.mu.Lock()
+	delete(h.subscribers, s)
+	for _, t := range s.topics {
+		if h.refs[t]--; h.refs[t] <= 0 {
+			delete(h.refs, t)
+		}
+	}
+	release := h.started && len(h.refs) == 0
+	if release {
+		h.topics = make(map[topic]int64)
+		h.started = false
+		close(h.stopped)
+	}
+	h.mu.Unlock()
+	if release {
+		h.store.StopSubscribe()
+	}
+}
+
+func (h *Hub) loggedSince() int64 {
+	if h.native != nil {
+		// the revision of the store is unknown until the next change
+		return 0
+	}
+	return h.revision
+}
+
+// replay returns the items changed after the revision.
+func (h *Hub) replay(req *configstores.SubscribeReq, topics []topic, since int64) *configstores.SubscribeResp {
+	// 1. split the topics covered by the log
+	logged := make(map[string]struct{}, len(topics))
+	missed := make([]string, 0, len(topics))
+	for _, t := range topics {
+		if h.covers(t, since) {
+			logged[t.key] = struct{}{}
+		} else {
+			missed = append(missed, t.key)
+		}
+	}
+	resp := &configstores.SubscribeResp{StoreName: h.storeName, AppId: req.AppId, Revision: h.revision}
+	// 2. get the latest changes from the log
+	index := make(map[string]int)
+	for _, c := range h.log {
+		if c.revision <= since || !match(req.AppId, c.appId) || !match(req.Group, c.item.Group) || !match(req.Label, c.item.Label) {
+			continue
+		}
+		_, ok := logged[c.item.Key]
+		if _, all := logged[""]; !ok && !all {
+			continue
+		}
+		if i, ok := index[c.item.Key]; ok {
+			resp.Items[i] = c.item
+			continue
+		}
+		index[c.item.Key] = len(resp.Items)
+		resp.Items = append(resp.Items, c.item)
+	}
+	// 3. get the others from the store
+	if len(missed) > 0 {
+		items, revision := h.catchUp(req, missed, since)
+		resp.Items = append(resp.Items, items...)
+		if revision > resp.Revision {
+			resp.Revision = revision
+		}
+	}
+	if len(resp.Items) == 0 {
+		return nil
+	}
+	return resp
+}
+
+// covers checks whether all the changes of the topic after the revision are in the log
+func (h *Hub) covers(t topic, since int64) bool {
+	if since < h.floor {
+		return false
+	}
+	if loggedSince, ok := h.topics[t]; ok && loggedSince != 0 && since >= loggedSince {
+		return true
+	}
+	// all the keys are subscribed
+	all := t
+	all.key = ""
+	loggedSince, ok := h.topics[all]
+	return ok && loggedSince != 0 && since >= loggedSince
+}
+
+// catchUp gets the items changed after the revision from a RevisionStore,
+// or all the current items otherwise, and returns the revision of them.
+func (h *Hub) catchUp(req *configstores.SubscribeReq, keys []string, since int64) ([]*configstores.ConfigurationItem, int64) {
+	if len(keys) == 1 && keys[0] == "" {
+		keys = nil
+	}
+	ctx := context.Background()
+	if h.native != nil {
+		items, revision, err := h.native.GetChanges(ctx, &configstores.GetChangesRequest{
+			AppId:         req.AppId,
+			Group:         req.Group,
+			Label:         req.Label,
+			Keys:          keys,
+			SinceRevision: since,
+			Metadata:      req.Metadata,
+		})
+		if err == nil {
+			return items, revision
+		}
+		log.DefaultLogger.Warnf("[runtime] get changes of config store %s since revision %d error, fallback to get all the items: %v", h.storeName, since, err)
+	}
+	items, err := h.store.Get(ctx, &configstores.GetRequest{AppId: req.AppId, Group: req.Group, Label: req.Label, Keys: keys, Metadata: req.Metadata})
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] get items of config store %s error: %v", h.storeName, err)
+		return nil, 0
+	}
+	// the keys not found are sent with empty content, as they may have been deleted
+	found := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		found[item.Key] = struct{}{}
+	}
+	for _, key := range keys {
+		if _, ok := found[key]; !ok {
+			items = append(items, &configstores.ConfigurationItem{Group: req.Group, Label: req.Label, Key: key})
+		}
+	}
+	return items, 0
+}
+
+// dispatch logs the changes received from the store and sends them to the subscribers, until the subscription of the store is stopped
+func (h *Hub) dispatch(ch chan *configstores.SubscribeResp, stopped chan struct{}) {
+	for {
+		select {
+		case resp, ok := <-ch:
+			if !ok {
+				return
+			}
+			h.publish(resp)
+		case <-stopped:
+			return
+		}
+	}
+}
+
+// publish logs the change and queues it to the subscribers, without blocking on them
+func (h *Hub) publish(resp *configstores.SubscribeResp) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// 1. assign the revision
+	if h.native == nil {
+		h.revision++
+	} else if resp.Revision > 0 {
+		if resp.Revision > h.revision {
+			h.revision = resp.Revision
+		}
+		// the changes of the new topics are logged since now
+		for t, loggedSince := range h.topics {
+			if loggedSince == 0 {
+				h.topics[t] = resp.Revision - 1
+			}
+		}
+	}
+	revision := h.revision
+	// 2. append to the log
+	for _, item := range resp.Items {
+		h.log = append(h.log, &change{revision: revision, appId: resp.AppId, item: item})
+	}
+	if overflow := len(h.log) - h.size; overflow > 0 {
+		h.floor = h.log[overflow-1].revision
+		h.log = append(h.log[:0:0], h.log[overflow:]...)
+	}
+	// 3. send to the subscribers
+	for s := range h.subscribers {
+		items := make([]*configstores.ConfigurationItem, 0, len(resp.Items))
+		for _, item := range resp.Items {
+			if s.match(resp.AppId, item) {
+				items = append(items, item)
+			}
+		}
+		if len(items) == 0 {
+			continue
+		}
+		s.push(&configstores.SubscribeResp{StoreName: h.storeName, AppId: resp.AppId, Items: items, Revision: revision})
+	}
+}
+
+func (s *Subscription) match(appId string, item *configstores.ConfigurationItem) bool {
+	if !match(s.appId, appId) || !match(s.group, item.Group) || !match(s.label, item.Label) {
+		return false
+	}
+	if len(s.keys) == 0 {
+		return true
+	}
+	_, ok := s.keys[item.Key]
+	return ok
+}
+
+// match returns true if either side is not specified, because some stores don't fill all the fields in the changes
+func match(expected string, actual string) bool {
+	return expected == "" || actual == "" || expected == configstores.All || expected == actual
+}
+
+func topicsOf(req *configstores.SubscribeReq) []topic {
+	if len(req.Keys) == 0 {
+		return []topic{{appId: req.AppId, group: req.Group, label: req.Label}}
+	}
+	res := make([]topic, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		res = append(res, topic{appId: req.AppId, group: req.Group, label: req.Label, key: key})
+	}
+	return res
+}
@@ -0,0 +1,252 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package configstores
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/configstores"
+	"mosn.io/layotto/components/configstores/composite"
+	in_memory "mosn.io/layotto/components/configstores/in-memory"
+)
+
+func receive(t *testing.T, ch chan *configstores.SubscribeResp) *configstores.SubscribeResp {
+	select {
+	case resp := <-ch:
+		return resp
+	case <-time.After(time.Second):
+		t.Fatal("no update received")
+		return nil
+	}
+}
+
+func set(t *testing.T, store configstores.Store, key string, content string) {
+	err := store.Set(context.Background(), &configstores.SetRequest{AppId: "app", Items: []*configstores.ConfigurationItem{
+		{Group: "default", Label: "default", Key: key, Content: content},
+	}})
+	assert.Nil(t, err)
+}
+
+func TestHub(t *testing.T) {
+	store := in_memory.NewInMemoryConfigStore()
+	assert.Nil(t, store.Init(&configstores.StoreConfig{StoreName: "memory"}))
+	set(t, store, "k1", "v1")
+	set(t, store, "k2", "v1")
+	hub := NewHub("memory", store, 2)
+	req := &configstores.SubscribeReq{AppId: "app", Group: "default", Label: "default", Keys: []string{"k1", "k2"}}
+
+	ch1 := make(chan *configstores.SubscribeResp, 1)
+	sub1, err := hub.Subscribe(req, 0, ch1)
+	assert.Nil(t, err)
+	ch2 := make(chan *configstores.SubscribeResp, 1)
+	sub2, err := hub.Subscribe(&configstores.SubscribeReq{AppId: "app", Group: "default", Label: "default", Keys: []string{"k1"}}, 0, ch2)
+	assert.Nil(t, err)
+
+	go set(t, store, "k1", "v2")
+	resp := receive(t, ch1)
+	assert.Equal(t, "memory", resp.StoreName)
+	assert.Equal(t, "v2", resp.Items[0].Content)
+	revision := resp.Revision
+	assert.Equal(t, revision, receive(t, ch2).Revision)
+
+	t.Run("stop a subscription", func(t *testing.T) {
+		sub1.Stop()
+		go set(t, store, "k1", "v3")
+		resp := receive(t, ch2)
+		assert.Equal(t, "v3", resp.Items[0].Content)
+		assert.Equal(t, revision+1, resp.Revision)
+		select {
+		case <-ch1:
+			t.Fatal("the subscription is stopped")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("resume from the log", func(t *testing.T) {
+		set(t, store, "k2", "v2")
+		// wait for the change to be logged
+		time.Sleep(50 * time.Millisecond)
+		ch := make(chan *configstores.SubscribeResp, 1)
+		sub, err := hub.Subscribe(req, revision, ch)
+		assert.Nil(t, err)
+		defer sub.Stop()
+		resp := receive(t, ch)
+		assert.Equal(t, revision+2, resp.Revision)
+		assert.Len(t, resp.Items, 2)
+		assert.Equal(t, "v3", resp.Items[0].Content)
+		assert.Equal(t, "v2", resp.Items[1].Content)
+	})
+
+	t.Run("resume from a revision older than the log", func(t *testing.T) {
+		set(t, store, "k2", "v3")
+		// wait for the change to be logged
+		time.Sleep(50 * time.Millisecond)
+		ch := make(chan *configstores.SubscribeResp, 1)
+		sub, err := hub.Subscribe(&configstores.SubscribeReq{AppId: "app", Group: "default", Label: "default", Keys: []string{"k1", "k3"}}, revision, ch)
+		assert.Nil(t, err)
+		defer sub.Stop()
+		// all the items are sent
+		resp := receive(t, ch)
+		assert.Equal(t, revision+3, resp.Revision)
+		assert.Len(t, resp.Items, 2)
+		assert.Equal(t, "v3", resp.Items[0].Content)
+		assert.Equal(t, "k3", resp.Items[1].Key)
+		assert.Equal(t, "", resp.Items[1].Content)
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		ch := make(chan *configstores.SubscribeResp, 1)
+		sub, err := hub.Subscribe(req, revision+3, ch)
+		assert.Nil(t, err)
+		defer sub.Stop()
+		select {
+		case <-ch:
+			t.Fatal("nothing should be replayed")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+	sub2.Stop()
+}
+
+func TestHub_SlowSubscriber(t *testing.T) {
+	store := in_memory.NewInMemoryConfigStore()
+	assert.Nil(t, store.Init(&configstores.StoreConfig{StoreName: "memory"}))
+	hub := NewHub("memory", store, DefaultChangeLogSize)
+	req := &configstores.SubscribeReq{AppId: "app", Group: "default", Label: "default", Keys: []string{"k1"}}
+	slow, err := hub.Subscribe(req, 0, make(chan *configstores.SubscribeResp))
+	assert.Nil(t, err)
+	defer slow.Stop()
+	ch := make(chan *configstores.SubscribeResp)
+	sub, err := hub.Subscribe(req, 0, ch)
+	assert.Nil(t, err)
+	defer sub.Stop()
+
+	// the changes are queued for the slow subscriber, and the others still receive them
+	go func() {
+		for i := 0; i < 3; i++ {
+			set(t, store, "k1", strconv.Itoa(i))
+		}
+	}()
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, strconv.Itoa(i), receive(t, ch).Items[0].Content)
+	}
+}
+
+// countingStore counts the subscriptions from the store
+type countingStore struct {
+	*in_memory.InMemoryConfigStore
+	subscribed int
+	stopped    int
+}
+
+func (s *countingStore) Subscribe(req *configstores.SubscribeReq, ch chan *configstores.SubscribeResp) error {
+	s.subscribed++
+	return s.InMemoryConfigStore.Subscribe(req, ch)
+}
+
+func (s *countingStore) StopSubscribe() {
+	s.stopped++
+	s.InMemoryConfigStore.StopSubscribe()
+}
+
+func TestHub_Release(t *testing.T) {
+	store := &countingStore{InMemoryConfigStore: in_memory.NewInMemoryConfigStore()}
+	assert.Nil(t, store.Init(&configstores.StoreConfig{StoreName: "memory"}))
+	hub := NewHub("memory", store, DefaultChangeLogSize)
+	req := &configstores.SubscribeReq{AppId: "app", Group: "default", Label: "default", Keys: []string{"k1"}}
+	sub1, err := hub.Subscribe(req, 0, make(chan *configstores.SubscribeResp))
+	assert.Nil(t, err)
+	sub2, err := hub.Subscribe(req, 0, make(chan *configstores.SubscribeResp))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, store.subscribed)
+
+	// the subscription of the store is stopped after the last subscriber stops
+	sub1.Stop()
+	assert.Equal(t, 0, store.stopped)
+	sub2.Stop()
+	assert.Equal(t, 1, store.stopped)
+
+	// and subscribed again for the next subscriber
+	ch := make(chan *configstores.SubscribeResp)
+	sub, err := hub.Subscribe(req, 0, ch)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, store.subscribed)
+	go set(t, store, "k1", "v1")
+	assert.Equal(t, "v1", receive(t, ch).Items[0].Content)
+	sub.Stop()
+	assert.Equal(t, 2, store.stopped)
+}
+
+func TestNewLayerSubscriber(t *testing.T) {
+	layer := &countingStore{InMemoryConfigStore: in_memory.NewInMemoryConfigStore()}
+	assert.Nil(t, layer.Init(&configstores.StoreConfig{StoreName: "layer"}))
+	merged := composite.NewStore()
+	assert.Nil(t, merged.(configstores.LayeredStore).SetLayers([]configstores.Store{layer}))
+	merged.(configstores.LayeredStore).SetLayerSubscriber(NewLayerSubscriber([]string{"layer"}, []configstores.Store{layer}))
+	assert.Nil(t, merged.Init(&configstores.StoreConfig{StoreName: "merged"}))
+
+	req := &configstores.SubscribeReq{AppId: "app", Group: "default", Label: "default", Keys: []string{"k1"}}
+	ch := make(chan *configstores.SubscribeResp)
+	sub, err := GetHub("merged", merged).Subscribe(req, 0, ch)
+	assert.Nil(t, err)
+	direct, err := GetHub("layer", layer).Subscribe(req, 0, make(chan *configstores.SubscribeResp, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, layer.subscribed)
+	go set(t, layer, "k1", "v1")
+	assert.Equal(t, "v1", receive(t, ch).Items[0].Content)
+
+	// the layer is shared with the direct subscriber
+	sub.Stop()
+	assert.Equal(t, 0, layer.stopped)
+	direct.Stop()
+	assert.Equal(t, 1, layer.stopped)
+}
+
+type revisionStore struct {
+	*in_memory.InMemoryConfigStore
+	req *configstores.GetChangesRequest
+}
+
+func (s *revisionStore) GetChanges(ctx context.Context, req *configstores.GetChangesRequest) ([]*configstores.ConfigurationItem, int64, error) {
+	s.req = req
+	return []*configstores.ConfigurationItem{{Group: req.Group, Label: req.Label, Key: "k1", Content: "v1"}}, 10, nil
+}
+
+func TestHub_RevisionStore(t *testing.T) {
+	store := &revisionStore{InMemoryConfigStore: in_memory.NewInMemoryConfigStore()}
+	assert.Nil(t, store.Init(&configstores.StoreConfig{StoreName: "native"}))
+	hub := NewHub("native", store, DefaultChangeLogSize)
+
+	ch := make(chan *configstores.SubscribeResp, 1)
+	sub, err := hub.Subscribe(&configstores.SubscribeReq{AppId: "app", Group: "default", Label: "default", Keys: []string{"k1"}}, 5, ch)
+	assert.Nil(t, err)
+	defer sub.Stop()
+	resp := receive(t, ch)
+	assert.Equal(t, int64(5), store.req.SinceRevision)
+	assert.Equal(t, []string{"k1"}, store.req.Keys)
+	assert.Equal(t, int64(10), resp.Revision)
+	assert.Equal(t, "v1", resp.Items[0].Content)
+}
+
+func TestGetHub(t *testing.T) {
+	store := in_memory.NewInMemoryConfigStore()
+	hub := GetHub("memory", store)
+	assert.Same(t, hub, GetHub("memory", store))
+	// the store is replaced
+	assert.NotSame(t, hub, GetHub("memory", in_memory.NewInMemoryConfigStore()))
+}
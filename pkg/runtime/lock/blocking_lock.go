@@ -0,0 +1,130 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package lock
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"mosn.io/layotto/components/lock"
+)
+
+const (
+	minPollInterval = 50 * time.Millisecond
+	maxPollInterval = time.Second
+)
+
+// waitQueueKey identifies the waiters of the same lock
+type waitQueueKey struct {
+	store      lock.LockStore
+	resourceId string
+}
+
+var (
+	waitQueuesMu sync.Mutex
+	// the head of each queue is the only waiter polling the lock store
+	waitQueues = map[waitQueueKey][]chan struct{}{}
+)
+
+// Lock waits until the lock is acquired or req.WaitTimeout elapses.
+// It delegates exclusive and non-reentrant requests to the store if the store implements lock.BlockingLockStore.
+// Otherwise it polls TryLock with jittered backoff, and the waiters in this runtime take turns to poll in FIFO order.
+func Lock(ctx context.Context, store lock.LockStore, req *lock.LockRequest) (*lock.LockResponse, error) {
+	if s, ok := store.(lock.BlockingLockStore); ok && req.Mode == lock.EXCLUSIVE && !req.Reentrant {
+		return s.Lock(ctx, req)
+	}
+	ctx, cancel := context.WithTimeout(ctx, req.WaitTimeout)
+	defer cancel()
+
+	// 1. wait for our turn
+	k := waitQueueKey{store: store, resourceId: req.ResourceId}
+	turn := enqueue(k)
+	defer dequeue(k, turn)
+	select {
+	case <-turn:
+	case <-ctx.Done():
+		return &lock.LockResponse{Success: false}, nil
+	}
+	// 2. poll until the lock is acquired
+	interval := minPollInterval
+	for {
+		resp, err := store.TryLock(ctx, &lock.TryLockRequest{
+			ResourceId: req.ResourceId,
+			LockOwner:  req.LockOwner,
+			Expire:     req.Expire,
+			Mode:       req.Mode,
+			Reentrant:  req.Reentrant,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Success {
+			return &lock.LockResponse{
+				Success:      true,
+				FencingToken: resp.FencingToken,
+			}, nil
+		}
+		select {
+		case <-time.After(jitter(interval)):
+		case <-ctx.Done():
+			return &lock.LockResponse{Success: false}, nil
+		}
+		interval *= 2
+		if interval > maxPollInterval {
+			interval = maxPollInterval
+		}
+	}
+}
+
+// jitter returns a random duration in [d/2, d)
+func jitter(d time.Duration) time.Duration {
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
+// enqueue appends a waiter to the queue, and returns a channel which is closed when it's the waiter's turn
+func enqueue(k waitQueueKey) chan struct{} {
+	waitQueuesMu.Lock()
+	defer waitQueuesMu.Unlock()
+
+	turn := make(chan struct{})
+	if len(waitQueues[k]) == 0 {
+		close(turn)
+	}
+	waitQueues[k] = append(waitQueues[k], turn)
+	return turn
+}
+
+// dequeue removes the waiter from the queue, and wakes up the next one if the waiter is the head
+func dequeue(k waitQueueKey, turn chan struct{}) {
+	waitQueuesMu.Lock()
+	defer waitQueuesMu.Unlock()
+
+	q := waitQueues[k]
+	for i, w := range q {
+		if w != turn {
+			continue
+		}
+		q = append(q[:i], q[i+1:]...)
+		if i == 0 && len(q) > 0 {
+			close(q[0])
+		}
+		break
+	}
+	if len(q) == 0 {
+		delete(waitQueues, k)
+		return
+	}
+	waitQueues[k] = q
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package lock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/lock"
+	lock_inmemory "mosn.io/layotto/components/lock/in-memory"
+)
+
+func TestLock(t *testing.T) {
+	store := lock_inmemory.NewInMemoryLock()
+	resp, err := store.TryLock(context.Background(), &lock.TryLockRequest{
+		ResourceId: key,
+		LockOwner:  "owner1",
+		Expire:     10,
+	})
+	assert.Nil(t, err)
+	assert.True(t, resp.Success)
+
+	t.Run("wait timeout", func(t *testing.T) {
+		resp, err := Lock(context.Background(), store, &lock.LockRequest{
+			ResourceId:  key,
+			LockOwner:   "owner2",
+			Expire:      10,
+			WaitTimeout: 100 * time.Millisecond,
+		})
+		assert.Nil(t, err)
+		assert.False(t, resp.Success)
+		assert.Empty(t, waitQueues)
+	})
+
+	t.Run("waiters get the lock in FIFO order", func(t *testing.T) {
+		acquired := make(chan string, 2)
+		wait := func(owner string) {
+			resp, err := Lock(context.Background(), store, &lock.LockRequest{
+				ResourceId:  key,
+				LockOwner:   owner,
+				Expire:      10,
+				WaitTimeout: 5 * time.Second,
+			})
+			assert.Nil(t, err)
+			assert.True(t, resp.Success)
+			acquired <- owner
+		}
+		go wait("owner2")
+		time.Sleep(100 * time.Millisecond)
+		go wait("owner3")
+		time.Sleep(100 * time.Millisecond)
+
+		_, err := store.Unlock(context.Background(), &lock.UnlockRequest{ResourceId: key, LockOwner: "owner1"})
+		assert.Nil(t, err)
+		assert.Equal(t, "owner2", <-acquired)
+		_, err = store.Unlock(context.Background(), &lock.UnlockRequest{ResourceId: key, LockOwner: "owner2"})
+		assert.Nil(t, err)
+		assert.Equal(t, "owner3", <-acquired)
+	})
+}
+
+func TestDequeue(t *testing.T) {
+	k := waitQueueKey{resourceId: key}
+	first := enqueue(k)
+	second := enqueue(k)
+	third := enqueue(k)
+	<-first
+
+	// a waiter leaving from the middle doesn't wake up others
+	dequeue(k, second)
+	select {
+	case <-third:
+		t.Fatal("third waiter shouldn't be woken up")
+	default:
+	}
+	// the head leaving wakes up the next one
+	dequeue(k, first)
+	<-third
+	dequeue(k, third)
+	assert.Empty(t, waitQueues)
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package lock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"mosn.io/pkg/log"
+
+	"mosn.io/layotto/components/lock"
+)
+
+const (
+	// the lease is renewed every 1/renewFactor of the expire time
+	renewFactor = 3
+	// the timeout of the unlock request sent after the lease is stopped
+	releaseTimeout = 3 * time.Second
+)
+
+// ErrLeaseLost means the lock expired or was taken by others while keeping the lease
+var ErrLeaseLost = errors.New("lock lease is lost")
+
+// KeepLease renews the lease of a lock held by req.LockOwner in the background of the caller,
+// and releases the lock as soon as ctx is done.
+// It works with any lock store, because LockKeepAlive is a part of lock.LockStore.
+// It returns ErrLeaseLost if the lock is lost before ctx is done,
+// i.e. the store reports the lock doesn't belong to the owner any more,
+// or the lease can't be renewed before it expires.
+func KeepLease(ctx context.Context, store lock.LockStore, req *lock.LockKeepAliveRequest) error {
+	expire := time.Duration(req.Expire) * time.Second
+	ticker := time.NewTicker(expire / renewFactor)
+	defer ticker.Stop()
+
+	renewed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			release(store, req)
+			return nil
+		case <-ticker.C:
+		}
+		resp, err := store.LockKeepAlive(ctx, req)
+		if err != nil {
+			log.DefaultLogger.Errorf("[runtime] [lock.KeepLease] renew lease of %s error: %v", req.ResourceId, err)
+			// retry in the next round unless the lease has expired
+			if time.Since(renewed) >= expire {
+				return ErrLeaseLost
+			}
+			continue
+		}
+		switch resp.Status {
+		case lock.SUCCESS:
+			renewed = time.Now()
+		case lock.LOCK_UNEXIST, lock.LOCK_BELONG_TO_OTHERS:
+			return ErrLeaseLost
+		default:
+			if time.Since(renewed) >= expire {
+				return ErrLeaseLost
+			}
+		}
+	}
+}
+
+// release unlocks the lock with a new context, because the context of the lease is done
+func release(store lock.LockStore, req *lock.LockKeepAliveRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	_, err := store.Unlock(ctx, &lock.UnlockRequest{
+		ResourceId: req.ResourceId,
+		LockOwner:  req.LockOwner,
+	})
+	if err != nil {
+		log.DefaultLogger.Errorf("[runtime] [lock.KeepLease] release lock %s error: %v", req.ResourceId, err)
+	}
+}
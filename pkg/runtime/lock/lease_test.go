@@ -0,0 +1,70 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package lock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/layotto/components/lock"
+	lock_inmemory "mosn.io/layotto/components/lock/in-memory"
+)
+
+func TestKeepLease(t *testing.T) {
+	store := lock_inmemory.NewInMemoryLock()
+	tryLock := func(owner string) bool {
+		resp, err := store.TryLock(context.Background(), &lock.TryLockRequest{
+			ResourceId: "lease",
+			LockOwner:  owner,
+			Expire:     1,
+		})
+		assert.Nil(t, err)
+		return resp.Success
+	}
+
+	t.Run("renew until ctx is done", func(t *testing.T) {
+		assert.True(t, tryLock("owner1"))
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- KeepLease(ctx, store, &lock.LockKeepAliveRequest{
+				ResourceId: "lease",
+				LockOwner:  "owner1",
+				Expire:     1,
+			})
+		}()
+		// the lock outlives its expire time
+		time.Sleep(1500 * time.Millisecond)
+		assert.False(t, tryLock("owner2"))
+		// and it's released right after ctx is done
+		cancel()
+		assert.Nil(t, <-done)
+		assert.True(t, tryLock("owner2"))
+	})
+
+	t.Run("lease lost", func(t *testing.T) {
+		done := make(chan error)
+		go func() {
+			done <- KeepLease(context.Background(), store, &lock.LockKeepAliveRequest{
+				ResourceId: "lease",
+				LockOwner:  "owner1",
+				Expire:     1,
+			})
+		}()
+		// owner2 holds the lock
+		assert.Equal(t, ErrLeaseLost, <-done)
+	})
+}
@@ -73,6 +73,20 @@ func GetModifiedLockKey(key, storeName, appID string) (string, error) {
 	}
 }
 
+// GetOriginalLockKey is the reverse of GetModifiedLockKey.
+// It returns false if the key isn't a key of the app,e.g. the fencing token key of a lock or a lock of another app.
+func GetOriginalLockKey(modifiedKey, storeName, appID string) (string, bool) {
+	prefix, _ := GetModifiedLockKey("", storeName, appID)
+	if !strings.HasPrefix(modifiedKey, prefix) {
+		return "", false
+	}
+	key := strings.TrimPrefix(modifiedKey, prefix)
+	if key == "" || strings.Contains(key, separator) {
+		return "", false
+	}
+	return key, true
+}
+
 func getConfiguration(storeName string) *StoreConfiguration {
 	c := lockConfiguration[storeName]
 	if c == nil {
@@ -115,3 +115,19 @@ func TestPrefix_StoreNotInitial(t *testing.T) {
 	modifiedLockKey, _ := GetModifiedLockKey(key, "store999", "appid99")
 	require.Equal(t, "lock|||appid99||lock-key-1234567", modifiedLockKey)
 }
+
+func TestGetOriginalLockKey(t *testing.T) {
+	modifiedLockKey, _ := GetModifiedLockKey(key, "store2", "appid1")
+	originalKey, ok := GetOriginalLockKey(modifiedLockKey, "store2", "appid1")
+	require.True(t, ok)
+	require.Equal(t, key, originalKey)
+
+	// keys of other apps
+	_, ok = GetOriginalLockKey(modifiedLockKey, "store2", "appid2")
+	require.False(t, ok)
+	_, ok = GetOriginalLockKey(modifiedLockKey, "store1", "appid2")
+	require.False(t, ok)
+	// internal keys of the lock store
+	_, ok = GetOriginalLockKey(modifiedLockKey+"||fencing_token", "store2", "appid1")
+	require.False(t, ok)
+}
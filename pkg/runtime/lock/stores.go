@@ -0,0 +1,42 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package lock
+
+import (
+	"sync"
+
+	"mosn.io/layotto/components/lock"
+)
+
+// the lock stores initialized by the runtime, which are inspected by the lock actuator
+var (
+	storesLock sync.RWMutex
+	storesApp  string
+	lockStores map[string]lock.LockStore
+)
+
+// SetLockStores saves the lock stores after they are initialized, with the appId which prefixes their resource ids
+func SetLockStores(appId string, stores map[string]lock.LockStore) {
+	storesLock.Lock()
+	defer storesLock.Unlock()
+	storesApp = appId
+	lockStores = stores
+}
+
+// GetLockStore returns the lock store and the appId saved by SetLockStores
+func GetLockStore(storeName string) (lock.LockStore, string, bool) {
+	storesLock.RLock()
+	defer storesLock.RUnlock()
+	store, ok := lockStores[storeName]
+	return store, storesApp, ok
+}
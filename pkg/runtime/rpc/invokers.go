@@ -0,0 +1,39 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package rpc
+
+import (
+	"sync"
+
+	"mosn.io/layotto/components/rpc"
+)
+
+// the rpc invokers initialized by the runtime, which are inspected by the rpc actuator
+var (
+	invokersLock sync.RWMutex
+	invokers     map[string]rpc.Invoker
+)
+
+// SetInvokers saves the rpc invokers after they are initialized
+func SetInvokers(i map[string]rpc.Invoker) {
+	invokersLock.Lock()
+	defer invokersLock.Unlock()
+	invokers = i
+}
+
+// GetInvokers returns the rpc invokers saved by SetInvokers
+func GetInvokers() map[string]rpc.Invoker {
+	invokersLock.RLock()
+	defer invokersLock.RUnlock()
+	return invokers
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -58,13 +59,17 @@ import (
 	"mosn.io/layotto/components/lock"
 	"mosn.io/layotto/components/pkg/info"
 	"mosn.io/layotto/components/rpc"
+	rpc_callback "mosn.io/layotto/components/rpc/callback"
 	"mosn.io/layotto/components/sequencer"
 	"mosn.io/layotto/pkg/grpc"
+	"mosn.io/layotto/pkg/presign"
+	runtime_configstores "mosn.io/layotto/pkg/runtime/configstores"
 	clock "mosn.io/layotto/pkg/runtime/lock"
 	runtime_lock "mosn.io/layotto/pkg/runtime/lock"
 	"mosn.io/layotto/pkg/runtime/pluggable"
 	cpubsub "mosn.io/layotto/pkg/runtime/pubsub"
 	runtime_pubsub "mosn.io/layotto/pkg/runtime/pubsub"
+	runtime_rpc "mosn.io/layotto/pkg/runtime/rpc"
 	csecretsotres "mosn.io/layotto/pkg/runtime/secretstores"
 	csequencer "mosn.io/layotto/pkg/runtime/sequencer"
 	runtime_sequencer "mosn.io/layotto/pkg/runtime/sequencer"
@@ -235,6 +240,7 @@ func (m *MosnRuntime) Stop() {
 	if m.srv != nil {
 		m.srv.Stop()
 	}
+	presign.GetDefault().Close()
 }
 
 func (m *MosnRuntime) storeDynamicComponent(kind string, name string, store interface{}) {
@@ -291,6 +297,9 @@ func DefaultInitRuntimeStage(o *runtimeOptions, m *MosnRuntime) error {
 	if err := m.initOss(o.services.oss...); err != nil {
 		return err
 	}
+	if err := m.initPresign(); err != nil {
+		return err
+	}
 	if err := m.initLocks(o.services.locks...); err != nil {
 		return err
 	}
@@ -332,22 +341,81 @@ func (m *MosnRuntime) initConfigStores(configStores ...*configstores.StoreFactor
 	log.DefaultLogger.Infof("[runtime] init config service")
 	// register all config store services implementation
 	m.configStoreRegistry.Register(configStores...)
-	for name, config := range m.runtimeConfig.ConfigStoreManagement {
-		c, err := m.configStoreRegistry.Create(config.Type)
-		if err != nil {
-			m.errInt(err, "create configstore's component %s failed", name)
+	// the stores layering other stores are initialized after their layers
+	pending := make([]string, 0, len(m.runtimeConfig.ConfigStoreManagement))
+	for name := range m.runtimeConfig.ConfigStoreManagement {
+		pending = append(pending, name)
+	}
+	sort.Strings(pending)
+	for len(pending) > 0 {
+		rest := make([]string, 0, len(pending))
+		for _, name := range pending {
+			config := m.runtimeConfig.ConfigStoreManagement[name]
+			if !m.configLayersReady(config.Layers) {
+				rest = append(rest, name)
+				continue
+			}
+			if err := m.initConfigStore(name, config); err != nil {
+				return err
+			}
+		}
+		if len(rest) == len(pending) {
+			err := fmt.Errorf("the layers of config stores %v are not found or cyclic", rest)
+			m.errInt(err, "init configstore's component failed")
+			return err
+		}
+		pending = rest
+	}
+	return nil
+}
+
+func (m *MosnRuntime) configLayersReady(layers []string) bool {
+	for _, layer := range layers {
+		if _, ok := m.configStores[layer]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MosnRuntime) initConfigStore(name string, config configstores.StoreConfig) error {
+	c, err := m.configStoreRegistry.Create(config.Type)
+	if err != nil {
+		m.errInt(err, "create configstore's component %s failed", name)
+		return err
+	}
+	config.AppId = m.runtimeConfig.AppManagement.AppId
+	config.StoreName = name
+	// inject the layers
+	if len(config.Layers) > 0 {
+		layered, ok := c.(configstores.LayeredStore)
+		if !ok {
+			err := fmt.Errorf("config store type %s doesn't support layers", config.Type)
+			m.errInt(err, "init configstore's component %s failed", name)
 			return err
 		}
-		config.AppId = m.runtimeConfig.AppManagement.AppId
-		config.StoreName = name
-		if err := c.Init(&config); err != nil {
+		layers := make([]configstores.Store, 0, len(config.Layers))
+		for _, layer := range config.Layers {
+			layers = append(layers, m.configStores[layer])
+		}
+		if err := layered.SetLayers(layers); err != nil {
 			m.errInt(err, "init configstore's component %s failed", name)
 			return err
 		}
-		// register this component
-		m.configStores[name] = c
-		m.storeDynamicComponent(lifecycle.KindConfig, name, c)
+		// the layers may be subscribed by others too, so they are subscribed through their hubs
+		layered.SetLayerSubscriber(runtime_configstores.NewLayerSubscriber(config.Layers, layers))
 	}
+	if err := c.Init(&config); err != nil {
+		m.errInt(err, "init configstore's component %s failed", name)
+		return err
+	}
+	if err := runtime_configstores.SaveSchemaConfiguration(name, config.Schemas); err != nil {
+		m.errInt(err, "init configstore's component %s failed", name)
+		return err
+	}
+	// register this component
+	m.configStores[name] = c
+	m.storeDynamicComponent(lifecycle.KindConfig, name, c)
 	return nil
 }
 
@@ -355,6 +423,8 @@ func (m *MosnRuntime) initRpcs(rpcs ...*rpc.Factory) error {
 	log.DefaultLogger.Infof("[runtime] init rpc service")
 	// register all rpc components
 	m.rpcRegistry.Register(rpcs...)
+	// the sign filters of the rpc callbacks read the keys from the secret stores
+	rpc_callback.SetSecretStores(m.secretStores)
 	for name, config := range m.runtimeConfig.RpcManagement {
 		c, err := m.rpcRegistry.Create(name)
 		if err != nil {
@@ -369,6 +439,8 @@ func (m *MosnRuntime) initRpcs(rpcs ...*rpc.Factory) error {
 		m.rpcs[name] = c
 		m.storeDynamicComponent(lifecycle.KindRPC, name, c)
 	}
+	// let operators inspect the channel stats through actuator
+	runtime_rpc.SetInvokers(m.rpcs)
 	return nil
 }
 
@@ -487,6 +559,35 @@ func (m *MosnRuntime) initOss(factorys ...*oss.Factory) error {
 	return nil
 }
 
+// initPresign signs the urls of the file and oss stores, and serves them on the address of the presign config
+func (m *MosnRuntime) initPresign() error {
+	// the oss stores unable to sign urls delegate to the presigner, which returns presign.ErrDisabled without the presign config
+	for name, o := range m.oss {
+		if p, ok := o.(oss.Presignable); ok {
+			p.SetPresignFunc(presign.GetDefault().OssPresignFunc(name))
+		}
+	}
+	config := m.runtimeConfig.Presign
+	if config == nil {
+		return nil
+	}
+	log.DefaultLogger.Infof("[runtime] init presign")
+	secrets, err := m.Injector.InjectSecretRef(config.SecretRef, nil)
+	if err != nil {
+		m.errInt(err, "inject the secret_ref of presign failed")
+		return err
+	}
+	if err = presign.GetDefault().Init(config, secrets, m.files, m.oss); err != nil {
+		m.errInt(err, "init presign failed")
+		return err
+	}
+	if err = presign.GetDefault().Start(); err != nil {
+		m.errInt(err, "start presign server failed")
+		return err
+	}
+	return nil
+}
+
 func (m *MosnRuntime) initFiles(files ...*file.Factory) error {
 	log.DefaultLogger.Infof("[runtime] init file service")
 
@@ -547,6 +648,8 @@ func (m *MosnRuntime) initLocks(factorys ...*runtime_lock.Factory) error {
 		m.locks[name] = comp
 		m.storeDynamicComponent(lifecycle.KindLock, name, comp)
 	}
+	// 3. let operators inspect and release the locks through actuator
+	runtime_lock.SetLockStores(m.runtimeConfig.AppManagement.AppId, m.locks)
 	return nil
 }
 
@@ -584,6 +687,16 @@ func (m *MosnRuntime) initSequencers(factorys ...*runtime_sequencer.Factory) err
 			m.errInt(err, "save sequencer configuration %s failed", name)
 			return err
 		}
+		err = runtime_sequencer.SaveSegmentConfiguration(name, m.runtimeConfig.AppManagement.AppId, config.Segments)
+		if err != nil {
+			m.errInt(err, "save sequencer segment configuration %s failed", name)
+			return err
+		}
+		err = runtime_sequencer.SaveFormatConfiguration(name, m.runtimeConfig.AppManagement.AppId, config.Formats, config.BiggerThan)
+		if err != nil {
+			m.errInt(err, "save sequencer format configuration %s failed", name)
+			return err
+		}
 		// register this component
 		m.sequencers[name] = comp
 		m.storeDynamicComponent(lifecycle.KindSequencer, name, comp)
@@ -833,5 +946,14 @@ func (m *MosnRuntime) initComponentInject(comp interface{}, config *refconfig.Co
 			}
 		}
 	}
+	if setLock, ok := comp.(common.SetLockStore); ok && config != nil && config.LockStore != "" {
+		lockStore, ok := m.locks[config.LockStore]
+		if !ok {
+			return fmt.Errorf("fail to get lockStore:%v", config.LockStore)
+		}
+		if err := setLock.SetLockStore(lockStore); err != nil {
+			return err
+		}
+	}
 	return nil
 }
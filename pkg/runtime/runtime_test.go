@@ -65,6 +65,8 @@ import (
 	"mosn.io/pkg/log"
 
 	"mosn.io/layotto/components/configstores"
+	configstore_composite "mosn.io/layotto/components/configstores/composite"
+	configstore_inmemory "mosn.io/layotto/components/configstores/in-memory"
 	"mosn.io/layotto/components/hello"
 	"mosn.io/layotto/components/lock"
 	mock_component "mosn.io/layotto/components/pkg/mock"
@@ -494,6 +496,50 @@ func TestMosnRuntime_initConfigStores(t *testing.T) {
 		err := m.initConfigStores(configstores.NewStoreFactory("store_config", f))
 		assert.Nil(t, err)
 	})
+
+	t.Run("init layered store after its layers", func(t *testing.T) {
+		cfg := &MosnRuntimeConfig{
+			ConfigStoreManagement: map[string]configstores.StoreConfig{
+				"a_merged": {
+					Type:   "composite",
+					Layers: []string{"overrides", "defaults"},
+				},
+				"overrides": {
+					Type: "in-memory",
+				},
+				"defaults": {
+					Type: "in-memory",
+				},
+			},
+		}
+		m := NewMosnRuntime(cfg)
+		m.errInt = func(err error, format string, args ...interface{}) {
+			log.DefaultLogger.Errorf("[runtime] occurs an error: "+err.Error()+", "+format, args...)
+		}
+		err := m.initConfigStores(
+			configstores.NewStoreFactory("composite", configstore_composite.NewStore),
+			configstores.NewStoreFactory("in-memory", configstore_inmemory.NewStore),
+		)
+		assert.Nil(t, err)
+		assert.Len(t, m.configStores, 3)
+	})
+
+	t.Run("layer not found", func(t *testing.T) {
+		cfg := &MosnRuntimeConfig{
+			ConfigStoreManagement: map[string]configstores.StoreConfig{
+				"merged": {
+					Type:   "composite",
+					Layers: []string{"overrides"},
+				},
+			},
+		}
+		m := NewMosnRuntime(cfg)
+		m.errInt = func(err error, format string, args ...interface{}) {
+			log.DefaultLogger.Errorf("[runtime] occurs an error: "+err.Error()+", "+format, args...)
+		}
+		err := m.initConfigStores(configstores.NewStoreFactory("composite", configstore_composite.NewStore))
+		assert.NotNil(t, err)
+	})
 }
 
 func TestMosnRuntime_initHellos(t *testing.T) {
@@ -15,6 +15,7 @@ package sequencer
 import (
 	"context"
 	"errors"
+	"sort"
 	"sync"
 	"time"
 
@@ -29,22 +30,61 @@ const defaultLimit = 1000
 const defaultRetry = 5
 const waitTime = time.Second * 2
 
+// the default bounds and refill interval of adaptive segments
+const defaultMinSize = 1000
+const defaultMaxSize = 1000000
+const defaultRefillInterval = time.Minute * 15
+
 // DoubleBuffer is double segment id buffer.
 // There are two buffers in DoubleBuffer: inUseBuffer is in use, BackUpBuffer is a backup buffer.
-// Their default capacity is 1000. When the inUseBuffer usage exceeds 30%, the BackUpBuffer will be initialized.
+// Their default capacity is 10000. When less than 1000 ids are left in the inUseBuffer, the BackUpBuffer will be initialized.
 // When inUseBuffer is used up, swap them.
+// The capacity and the threshold can be configured for each key, see SaveSegmentConfiguration.
 type DoubleBuffer struct {
 	Key              string
 	size             int
+	limit            int
+	options          *SegmentOptions
 	inUseBuffer      *Buffer
 	backUpBufferChan chan *Buffer
 	lock             sync.Mutex
 	Store            sequencer.Store
+	// statLock guards size, limit and the refill statistics,
+	// which are updated by the refilling goroutine without holding lock
+	statLock   sync.Mutex
+	lastRefill time.Time
+	refills    int64
 }
 
 type Buffer struct {
 	from int64
 	to   int64
+	// limit is the threshold of the buffer to initialize the BackUpBuffer
+	limit int64
+}
+
+// SegmentOptions is the segment cache options of a key
+type SegmentOptions struct {
+	Size           int
+	Limit          int
+	Adaptive       bool
+	MinSize        int
+	MaxSize        int
+	RefillInterval time.Duration
+}
+
+// BufferState is the state of a DoubleBuffer, which is exposed through actuator
+type BufferState struct {
+	Key         string    `json:"key"`
+	Size        int       `json:"size"`
+	Threshold   int       `json:"threshold"`
+	Adaptive    bool      `json:"adaptive"`
+	From        int64     `json:"from"`
+	To          int64     `json:"to"`
+	Remaining   int64     `json:"remaining"`
+	BackUpReady bool      `json:"backUpReady"`
+	Refills     int64     `json:"refills"`
+	LastRefill  time.Time `json:"lastRefill"`
 }
 
 func NewDoubleBuffer(key string, store sequencer.Store) *DoubleBuffer {
@@ -52,9 +92,15 @@ func NewDoubleBuffer(key string, store sequencer.Store) *DoubleBuffer {
 	d := &DoubleBuffer{
 		Key:              key,
 		size:             defaultSize,
+		limit:            defaultLimit,
 		Store:            store,
 		backUpBufferChan: make(chan *Buffer, 1),
 	}
+	if options := getSegmentOptions(key); options != nil {
+		d.options = options
+		d.size = options.Size
+		d.limit = options.Limit
+	}
 
 	return d
 }
@@ -78,6 +124,29 @@ func (d *DoubleBuffer) getId() (int64, error) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
+	return d.next()
+}
+
+// getIds next count ids.
+// The ids are taken under the same lock, so they are consecutive unless the inUseBuffer is used up in the middle.
+func (d *DoubleBuffer) getIds(count int) ([]int64, error) {
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	ids := make([]int64, 0, count)
+	for i := 0; i < count; i++ {
+		next, err := d.next()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, next)
+	}
+	return ids, nil
+}
+
+// next id, must be locked
+func (d *DoubleBuffer) next() (int64, error) {
 	if d.inUseBuffer == nil {
 		return 0, errors.New("[DoubleBuffer] Get error: inUseBuffer nil ")
 	}
@@ -93,7 +162,7 @@ func (d *DoubleBuffer) getId() (int64, error) {
 
 	//when inUseBuffer id more than limit used, initialize BackUpBuffer.
 	//equal make sure only one thread enter
-	if d.inUseBuffer.to-d.inUseBuffer.from == defaultLimit {
+	if d.inUseBuffer.to-d.inUseBuffer.from == d.inUseBuffer.limit {
 		utils.GoWithRecover(func() {
 			//quick retry
 			for i := 0; i < defaultRetry; i++ {
@@ -141,9 +210,10 @@ func (d *DoubleBuffer) swap() error {
 
 // getNewBuffer return a new segment
 func (d *DoubleBuffer) getNewBuffer() (*Buffer, error) {
+	size, limit := d.nextSize()
 	support, result, err := d.Store.GetSegment(&sequencer.GetSegmentRequest{
 		Key:  d.Key,
-		Size: d.size,
+		Size: size,
 	})
 	if err != nil {
 		return nil, err
@@ -151,12 +221,76 @@ func (d *DoubleBuffer) getNewBuffer() (*Buffer, error) {
 	if !support {
 		return nil, errors.New("[DoubleBuffer] unSupport Segment id")
 	}
+	d.statLock.Lock()
+	d.lastRefill = time.Now()
+	d.refills++
+	d.statLock.Unlock()
 	return &Buffer{
-		from: result.From,
-		to:   result.To,
+		from:  result.From,
+		to:    result.To,
+		limit: int64(limit),
 	}, nil
 }
 
+// nextSize returns the size and the limit of the next segment.
+// In adaptive mode, the size doubles if the last segment was used up within the refill interval,
+// and halves if it lasted more than twice as long.
+func (d *DoubleBuffer) nextSize() (int, int) {
+	d.statLock.Lock()
+	defer d.statLock.Unlock()
+	if d.options == nil || !d.options.Adaptive || d.lastRefill.IsZero() {
+		return d.size, d.limit
+	}
+	elapsed := time.Since(d.lastRefill)
+	size := d.size
+	if elapsed < d.options.RefillInterval {
+		size = size * 2
+	} else if elapsed > d.options.RefillInterval*2 {
+		size = size / 2
+	}
+	if size > d.options.MaxSize {
+		size = d.options.MaxSize
+	}
+	if size < d.options.MinSize {
+		size = d.options.MinSize
+	}
+	if size != d.size {
+		log.DefaultLogger.Infof("[DoubleBuffer] resize segment of %s from %d to %d, elapsed since last refill: %v", d.Key, d.size, size, elapsed)
+		// keep the ratio of the limit to the size
+		d.limit = int(int64(size) * int64(d.options.Limit) / int64(d.options.Size))
+		if d.limit > size-2 {
+			d.limit = size - 2
+		}
+		d.size = size
+	}
+	return d.size, d.limit
+}
+
+// state returns a snapshot of the DoubleBuffer
+func (d *DoubleBuffer) state() *BufferState {
+	st := &BufferState{Key: d.Key}
+	d.lock.Lock()
+	if d.inUseBuffer != nil {
+		st.From = d.inUseBuffer.from
+		st.To = d.inUseBuffer.to
+		st.Remaining = d.inUseBuffer.to - d.inUseBuffer.from + 1
+		if st.Remaining < 0 {
+			st.Remaining = 0
+		}
+	}
+	st.BackUpReady = len(d.backUpBufferChan) > 0
+	d.lock.Unlock()
+
+	d.statLock.Lock()
+	st.Size = d.size
+	st.Threshold = d.limit
+	st.Adaptive = d.options != nil && d.options.Adaptive
+	st.Refills = d.refills
+	st.LastRefill = d.lastRefill
+	d.statLock.Unlock()
+	return st
+}
+
 // BufferCatch catch key and buffer
 var BufferCatch = map[string]*DoubleBuffer{}
 
@@ -200,6 +334,39 @@ func GetNextIdFromCache(ctx context.Context, store sequencer.Store, req *sequenc
 	return true, id, nil
 }
 
+// GetNextIdsFromCache gets count ids from the DoubleBuffer of the key, just like GetNextIdFromCache.
+func GetNextIdsFromCache(ctx context.Context, store sequencer.Store, req *sequencer.GetNextIdRequest, count int) (bool, []int64, error) {
+
+	// 1. check support
+	support, _, _ := store.GetSegment(&sequencer.GetSegmentRequest{
+		Key:  req.Key,
+		Size: 0,
+	})
+
+	// return if not support
+	if !support {
+		return false, nil, nil
+	}
+
+	// 2. find the DoubleBuffer for this store and key
+	d := getDoubleBufferInRL(req.Key)
+	if d == nil {
+		var err error
+		d, err = getDoubleBufferInWL(req.Key, store)
+		if err != nil {
+			return true, nil, err
+		}
+	}
+
+	// 3. get the ids
+	ids, err := d.getIds(count)
+	if err != nil {
+		return true, nil, err
+	}
+
+	return true, ids, nil
+}
+
 // get DoubleBuffer using write lock
 func getDoubleBufferInWL(key string, store sequencer.Store) (*DoubleBuffer, error) {
 	d := NewDoubleBuffer(key, store)
@@ -214,6 +381,7 @@ func getDoubleBufferInWL(key string, store sequencer.Store) (*DoubleBuffer, erro
 		return nil, err
 	}
 	BufferCatch[key] = d
+	evictPastPartitions(key)
 	return d, nil
 }
 
@@ -226,3 +394,22 @@ func getDoubleBufferInRL(key string) *DoubleBuffer {
 	}
 	return nil
 }
+
+// GetBufferStates returns the states of all the cached DoubleBuffers, sorted by key
+func GetBufferStates() []*BufferState {
+	rwLock.RLock()
+	buffers := make([]*DoubleBuffer, 0, len(BufferCatch))
+	for _, d := range BufferCatch {
+		buffers = append(buffers, d)
+	}
+	rwLock.RUnlock()
+
+	states := make([]*BufferState, 0, len(buffers))
+	for _, d := range buffers {
+		states = append(states, d.state())
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Key < states[j].Key
+	})
+	return states
+}
@@ -15,12 +15,14 @@ package sequencer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/stretchr/testify/assert"
 	"mosn.io/pkg/log"
 
 	"mosn.io/layotto/components/sequencer"
+	sequencer_inmemory "mosn.io/layotto/components/sequencer/in-memory"
 	"mosn.io/layotto/components/sequencer/redis"
 )
 
@@ -51,3 +53,93 @@ func TestGetNextIdFromCache(t *testing.T) {
 		assert.Equal(t, id, int64(i))
 	}
 }
+
+func TestGetNextIdFromCache_SegmentConfig(t *testing.T) {
+	store := sequencer_inmemory.NewInMemorySequencer()
+	err := SaveSegmentConfiguration("segment_store", "", map[string]sequencer.SegmentConfig{
+		"fixed": {Size: 100, Threshold: 20},
+	})
+	assert.NoError(t, err)
+	req := &sequencer.GetNextIdRequest{Key: "sequencer|||fixed"}
+
+	for i := 1; i <= 250; i++ {
+		_, id, err := GetNextIdFromCache(context.Background(), store, req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(i), id)
+	}
+	d := getDoubleBufferInRL(req.Key)
+	// wait for the backup segment
+	time.Sleep(100 * time.Millisecond)
+	state := d.state()
+	assert.Equal(t, 100, state.Size)
+	assert.Equal(t, 20, state.Threshold)
+	assert.Equal(t, int64(3), state.Refills)
+	assert.Equal(t, int64(251), state.From)
+	assert.Equal(t, int64(300), state.To)
+}
+
+func TestDoubleBuffer_Adaptive(t *testing.T) {
+	d := NewDoubleBuffer("adaptive", sequencer_inmemory.NewInMemorySequencer())
+	d.options = &SegmentOptions{
+		Size:           1000,
+		Limit:          100,
+		Adaptive:       true,
+		MinSize:        500,
+		MaxSize:        2000,
+		RefillInterval: time.Minute,
+	}
+	d.size, d.limit = 1000, 100
+	// the first segment
+	size, limit := d.nextSize()
+	assert.Equal(t, 1000, size)
+	assert.Equal(t, 100, limit)
+
+	// used up fast, grow
+	d.lastRefill = time.Now()
+	size, limit = d.nextSize()
+	assert.Equal(t, 2000, size)
+	assert.Equal(t, 200, limit)
+	// bounded by MaxSize
+	size, _ = d.nextSize()
+	assert.Equal(t, 2000, size)
+
+	// used up slowly, shrink
+	d.lastRefill = time.Now().Add(-3 * time.Minute)
+	size, limit = d.nextSize()
+	assert.Equal(t, 1000, size)
+	assert.Equal(t, 100, limit)
+	size, _ = d.nextSize()
+	assert.Equal(t, 500, size)
+	// bounded by MinSize
+	size, _ = d.nextSize()
+	assert.Equal(t, 500, size)
+
+	// as expected, keep the size
+	d.lastRefill = time.Now().Add(-90 * time.Second)
+	size, _ = d.nextSize()
+	assert.Equal(t, 500, size)
+}
+
+func TestGetNextIdsFromCache(t *testing.T) {
+	store := sequencer_inmemory.NewInMemorySequencer()
+	err := SaveSegmentConfiguration("batch_store", "", map[string]sequencer.SegmentConfig{
+		"batch": {Size: 100, Threshold: 20},
+	})
+	assert.NoError(t, err)
+	req := &sequencer.GetNextIdRequest{Key: "sequencer|||batch"}
+
+	next := int64(1)
+	for i := 0; i < 5; i++ {
+		support, ids, err := GetNextIdsFromCache(context.Background(), store, req, 70)
+		assert.NoError(t, err)
+		assert.True(t, support)
+		assert.Len(t, ids, 70)
+		for _, id := range ids {
+			assert.Equal(t, next, id)
+			next++
+		}
+	}
+	_, id, err := GetNextIdFromCache(context.Background(), store, req)
+	assert.NoError(t, err)
+	assert.Equal(t, next, id)
+}
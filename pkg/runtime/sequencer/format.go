@@ -0,0 +1,156 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package sequencer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"mosn.io/layotto/components/sequencer"
+)
+
+const (
+	resetNone    = ""
+	resetDaily   = "daily"
+	resetMonthly = "monthly"
+	resetYearly  = "yearly"
+)
+
+// the layouts of the period appended to the keys
+var resetLayouts = map[string]string{
+	resetDaily:   "20060102",
+	resetMonthly: "200601",
+	resetYearly:  "2006",
+}
+
+// formatConfiguration is the id formatters of the modified keys
+var formatConfiguration = map[string]*IdFormatter{}
+
+// IdFormatter formats the ids of a key into business ids.
+// If the ids are reset every period, the key is partitioned by the period,
+// so that every store starts a new sequence for each period without knowing anything about the format.
+type IdFormatter struct {
+	prefix     string
+	separator  string
+	dateLayout string
+	reset      string
+	padding    int
+	location   *time.Location
+}
+
+func newIdFormatter(cfg sequencer.IdFormat) (*IdFormatter, error) {
+	f := &IdFormatter{
+		prefix:     cfg.Prefix,
+		separator:  cfg.Separator,
+		dateLayout: cfg.DateLayout,
+		reset:      strings.ToLower(cfg.Reset),
+		padding:    cfg.Padding,
+		location:   time.Local,
+	}
+	if _, ok := resetLayouts[f.reset]; !ok && f.reset != resetNone {
+		return nil, errors.Errorf("unknown reset period '%s'", cfg.Reset)
+	}
+	if f.padding < 0 {
+		return nil, errors.New("padding can't be negative")
+	}
+	if cfg.TimeZone != "" {
+		location, err := time.LoadLocation(cfg.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		f.location = location
+	}
+	return f, nil
+}
+
+// SaveFormatConfiguration saves the id formats of the keys in a store.
+// The keys are modified in the same way as the api does, so it must be invoked after SaveSeqConfiguration.
+// The keys reset every period can't have biggerThan, since the stores only apply it to the keys before partitioned.
+func SaveFormatConfiguration(storeName, appID string, formats map[string]sequencer.IdFormat, biggerThan map[string]int64) error {
+	for key, cfg := range formats {
+		f, err := newIdFormatter(cfg)
+		if err != nil {
+			return errors.Wrapf(err, "invalid id format of key '%s'", key)
+		}
+		modifiedKey, err := GetModifiedSeqKey(key, storeName, appID)
+		if err != nil {
+			return err
+		}
+		if f.reset != resetNone {
+			_, ok := biggerThan[key]
+			_, modifiedOk := biggerThan[modifiedKey]
+			if ok || modifiedOk {
+				return errors.Errorf("biggerThan of key '%s' can't be used with reset '%s'", key, f.reset)
+			}
+		}
+		formatConfiguration[modifiedKey] = f
+	}
+	return nil
+}
+
+// GetIdFormatter returns the id formatter of the modified key, or nil if the ids of the key are not formatted
+func GetIdFormatter(modifiedKey string) *IdFormatter {
+	return formatConfiguration[modifiedKey]
+}
+
+// PartitionKey returns the key used to get ids from the store at the time.
+// User keys can't contain the separator, so the period is appended after it to avoid conflicts.
+func (f *IdFormatter) PartitionKey(modifiedKey string, now time.Time) string {
+	if f.reset == resetNone {
+		return modifiedKey
+	}
+	return fmt.Sprintf("%s%s%s", modifiedKey, separator, now.In(f.location).Format(resetLayouts[f.reset]))
+}
+
+// Format formats the id got at the time
+func (f *IdFormatter) Format(id int64, now time.Time) string {
+	parts := make([]string, 0, 3)
+	if f.prefix != "" {
+		parts = append(parts, f.prefix)
+	}
+	if f.dateLayout != "" {
+		parts = append(parts, now.In(f.location).Format(f.dateLayout))
+	}
+	parts = append(parts, fmt.Sprintf("%0*d", f.padding, id))
+	return strings.Join(parts, f.separator)
+}
+
+// evictPastPartitions removes the DoubleBuffers of the periods before the partitioned key, which are never used again.
+// It must be invoked with the write lock of BufferCatch.
+func evictPastPartitions(key string) {
+	baseKey := getBaseKey(key)
+	if baseKey == "" {
+		return
+	}
+	// the periods have the same layout, so the past ones are smaller
+	for k := range BufferCatch {
+		if k < key && getBaseKey(k) == baseKey {
+			delete(BufferCatch, k)
+		}
+	}
+}
+
+// getBaseKey returns the modified key before partitioned, or "" if the key is not partitioned
+func getBaseKey(key string) string {
+	i := strings.LastIndex(key, separator)
+	if i <= 0 {
+		return ""
+	}
+	if f := formatConfiguration[key[:i]]; f != nil && f.reset != resetNone {
+		return key[:i]
+	}
+	return ""
+}
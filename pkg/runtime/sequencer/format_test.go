@@ -0,0 +1,110 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package sequencer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/sequencer"
+	sequencer_inmemory "mosn.io/layotto/components/sequencer/in-memory"
+)
+
+func TestIdFormatter(t *testing.T) {
+	err := SaveFormatConfiguration("store2", "appid1", map[string]sequencer.IdFormat{
+		"order": {
+			Prefix:     "ORD",
+			Separator:  "-",
+			DateLayout: "20060102",
+			Reset:      "daily",
+			Padding:    6,
+			TimeZone:   "Asia/Shanghai",
+		},
+		"ticket": {
+			Prefix:  "T",
+			Padding: 4,
+		},
+	}, map[string]int64{"ticket": 1000})
+	require.Nil(t, err)
+	require.Nil(t, GetIdFormatter("sequencer|||appid1||other"))
+
+	// 2026-10-15 20:00 UTC is 2026-10-16 in Shanghai
+	now := time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+	f := GetIdFormatter("sequencer|||appid1||order")
+	require.NotNil(t, f)
+	require.Equal(t, "sequencer|||appid1||order||20261016", f.PartitionKey("sequencer|||appid1||order", now))
+	require.Equal(t, "ORD-20261016-000123", f.Format(123, now))
+	require.Equal(t, "ORD-20261016-1234567", f.Format(1234567, now))
+
+	f = GetIdFormatter("sequencer|||appid1||ticket")
+	require.NotNil(t, f)
+	require.Equal(t, "sequencer|||appid1||ticket", f.PartitionKey("sequencer|||appid1||ticket", now))
+	require.Equal(t, "T0042", f.Format(42, now))
+
+	// segment options are shared by the partitions
+	err = SaveSegmentConfiguration("store2", "appid1", map[string]sequencer.SegmentConfig{
+		"order": {Size: 100},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 100, getSegmentOptions("sequencer|||appid1||order||20261016").Size)
+	require.Nil(t, getSegmentOptions("sequencer|||appid1||ticket||20261016"))
+
+	// invalid formats
+	for _, cfg := range []sequencer.IdFormat{
+		{Reset: "hourly"},
+		{Padding: -1},
+		{TimeZone: "Mars/Olympus"},
+	} {
+		err = SaveFormatConfiguration("store2", "appid1", map[string]sequencer.IdFormat{"invalid": cfg}, nil)
+		require.NotNil(t, err)
+	}
+	// biggerThan doesn't apply to the partitions
+	err = SaveFormatConfiguration("store2", "appid1", map[string]sequencer.IdFormat{"daily": {Reset: "daily"}}, map[string]int64{"daily": 1000})
+	require.Equal(t, "biggerThan of key 'daily' can't be used with reset 'daily'", err.Error())
+	err = SaveFormatConfiguration("store2", "appid1", map[string]sequencer.IdFormat{"daily": {Reset: "daily"}}, map[string]int64{"sequencer|||appid1||daily": 1000})
+	require.NotNil(t, err)
+}
+
+func TestEvictPastPartitions(t *testing.T) {
+	err := SaveFormatConfiguration("store3", "appid1", map[string]sequencer.IdFormat{
+		"order": {Reset: "daily"},
+	}, nil)
+	require.Nil(t, err)
+	store := sequencer_inmemory.NewInMemorySequencer()
+	for _, key := range []string{
+		"sequencer|||appid1||order||20261015",
+		"sequencer|||appid1||order||20261016",
+		"sequencer|||appid1||other||20261014",
+	} {
+		_, err = getDoubleBufferInWL(key, store)
+		require.Nil(t, err)
+	}
+	rwLock.RLock()
+	_, past := BufferCatch["sequencer|||appid1||order||20261015"]
+	_, current := BufferCatch["sequencer|||appid1||order||20261016"]
+	_, other := BufferCatch["sequencer|||appid1||other||20261014"]
+	rwLock.RUnlock()
+	require.False(t, past)
+	require.True(t, current)
+	require.True(t, other)
+
+	// a late request of the past period doesn't evict the current one
+	_, err = getDoubleBufferInWL("sequencer|||appid1||order||20261015", store)
+	require.Nil(t, err)
+	rwLock.RLock()
+	_, current = BufferCatch["sequencer|||appid1||order||20261016"]
+	rwLock.RUnlock()
+	require.True(t, current)
+}
@@ -15,8 +15,11 @@ package sequencer
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+
+	"mosn.io/layotto/components/sequencer"
 )
 
 const (
@@ -32,6 +35,9 @@ const (
 
 var seqConfiguration = map[string]*StoreConfiguration{}
 
+// segmentConfiguration is the segment options of the modified keys
+var segmentConfiguration = map[string]*SegmentOptions{}
+
 type StoreConfiguration struct {
 	keyPrefixStrategy string
 }
@@ -51,6 +57,80 @@ func SaveSeqConfiguration(storeName string, metadata map[string]string) error {
 	return nil
 }
 
+// SaveSegmentConfiguration saves the segment cache options of the keys in a store.
+// The keys are modified in the same way as the api does, so it must be invoked after SaveSeqConfiguration.
+func SaveSegmentConfiguration(storeName, appID string, segments map[string]sequencer.SegmentConfig) error {
+	for key, cfg := range segments {
+		options, err := newSegmentOptions(cfg)
+		if err != nil {
+			return errors.Wrapf(err, "invalid segment config of key '%s'", key)
+		}
+		modifiedKey, err := GetModifiedSeqKey(key, storeName, appID)
+		if err != nil {
+			return err
+		}
+		segmentConfiguration[modifiedKey] = options
+	}
+	return nil
+}
+
+func newSegmentOptions(cfg sequencer.SegmentConfig) (*SegmentOptions, error) {
+	if cfg.Size < 0 || cfg.Threshold < 0 || cfg.MinSize < 0 || cfg.MaxSize < 0 || cfg.RefillInterval < 0 {
+		return nil, errors.New("segment config can't be negative")
+	}
+	options := &SegmentOptions{
+		Size:           cfg.Size,
+		Limit:          cfg.Threshold,
+		Adaptive:       cfg.Adaptive,
+		MinSize:        cfg.MinSize,
+		MaxSize:        cfg.MaxSize,
+		RefillInterval: time.Duration(cfg.RefillInterval) * time.Second,
+	}
+	if options.Size == 0 {
+		options.Size = defaultSize
+	}
+	if options.Limit == 0 {
+		options.Limit = options.Size * defaultLimit / defaultSize
+	}
+	// the threshold is checked after an id is taken, so at most size-2 ids are left then
+	if options.Limit > options.Size-2 {
+		return nil, errors.Errorf("threshold %d is too large for size %d", options.Limit, options.Size)
+	}
+	if !options.Adaptive {
+		return options, nil
+	}
+	if options.MinSize == 0 {
+		options.MinSize = defaultMinSize
+		if options.MinSize > options.Size {
+			options.MinSize = options.Size
+		}
+	}
+	if options.MaxSize == 0 {
+		options.MaxSize = defaultMaxSize
+		if options.MaxSize < options.Size {
+			options.MaxSize = options.Size
+		}
+	}
+	if options.MinSize < 2 || options.MinSize > options.Size || options.Size > options.MaxSize {
+		return nil, errors.Errorf("size %d must be between minSize %d and maxSize %d", options.Size, options.MinSize, options.MaxSize)
+	}
+	if options.RefillInterval == 0 {
+		options.RefillInterval = defaultRefillInterval
+	}
+	return options, nil
+}
+
+func getSegmentOptions(key string) *SegmentOptions {
+	if options, ok := segmentConfiguration[key]; ok {
+		return options
+	}
+	// the key may be partitioned by the id formatter
+	if baseKey := getBaseKey(key); baseKey != "" {
+		return segmentConfiguration[baseKey]
+	}
+	return nil
+}
+
 func GetModifiedSeqKey(key, storeName, appID string) (string, error) {
 	if err := checkKeyIllegal(key); err != nil {
 		return "", err
@@ -15,8 +15,11 @@ package sequencer
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"mosn.io/layotto/components/sequencer"
 )
 
 const key = "lock-key-1234567"
@@ -72,3 +75,34 @@ func TestPrefix_StoreNotInitial(t *testing.T) {
 	modifiedLockKey, _ := GetModifiedSeqKey(key, "store999", "appid99")
 	require.Equal(t, "sequencer|||appid99||lock-key-1234567", modifiedLockKey)
 }
+
+func TestSaveSegmentConfiguration(t *testing.T) {
+	err := SaveSegmentConfiguration("store2", "appid1", map[string]sequencer.SegmentConfig{
+		"small":    {Size: 100},
+		"adaptive": {Size: 2000, Threshold: 500, Adaptive: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, &SegmentOptions{Size: 100, Limit: 10}, getSegmentOptions("sequencer|||appid1||small"))
+	require.Equal(t, &SegmentOptions{
+		Size:           2000,
+		Limit:          500,
+		Adaptive:       true,
+		MinSize:        defaultMinSize,
+		MaxSize:        defaultMaxSize,
+		RefillInterval: 15 * time.Minute,
+	}, getSegmentOptions("sequencer|||appid1||adaptive"))
+	require.Nil(t, getSegmentOptions("sequencer|||appid1||other"))
+
+	// invalid configs
+	for _, cfg := range []sequencer.SegmentConfig{
+		{Size: -1},
+		{Size: 100, Threshold: 99},
+		{Size: 100, Adaptive: true, MinSize: 200},
+		{Size: 100, Adaptive: true, MaxSize: 50},
+	} {
+		err = SaveSegmentConfiguration("store2", "appid1", map[string]sequencer.SegmentConfig{"invalid": cfg})
+		require.NotNil(t, err)
+	}
+	err = SaveSegmentConfiguration("store2", "appid1", map[string]sequencer.SegmentConfig{"a||b": {Size: 100}})
+	require.NotNil(t, err)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 
+	"mosn.io/layotto/spec/proto/extension/v1/s3"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
 )
 
@@ -70,6 +71,12 @@ type runtimeAPI interface {
 	// SubscribeConfiguration gets configuration from configuration store and subscribe the updates.
 	SubscribeConfiguration(ctx context.Context, in *ConfigurationRequestItem) WatchChan
 
+	// GetConfigurationHistory gets the revisions of a configuration, the newest first.
+	GetConfigurationHistory(ctx context.Context, in *runtimev1pb.GetConfigurationHistoryRequest) (*runtimev1pb.GetConfigurationHistoryResponse, error)
+
+	// RollbackConfiguration restores a configuration to one of its revisions.
+	RollbackConfiguration(ctx context.Context, in *runtimev1pb.RollbackConfigurationRequest) error
+
 	// SaveState saves the raw data into store using default state options.
 	SaveState(ctx context.Context, storeName, key string, data []byte, so ...StateOption) error
 
@@ -102,16 +109,32 @@ type runtimeAPI interface {
 
 	// Distributed Lock API
 	TryLock(context.Context, *runtimev1pb.TryLockRequest) (*runtimev1pb.TryLockResponse, error)
+	// Lock waits until the lock is acquired or the wait timeout elapses
+	Lock(context.Context, *runtimev1pb.LockRequest) (*runtimev1pb.LockResponse, error)
 	Unlock(context.Context, *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockResponse, error)
+	// GetLockHolderCount gets the number of holders of a lock and the reentrant count of an owner
+	GetLockHolderCount(context.Context, *runtimev1pb.GetLockHolderCountRequest) (*runtimev1pb.GetLockHolderCountResponse, error)
+	// LockWithLease gets a lock whose lease is renewed by Layotto until ctx is canceled
+	LockWithLease(context.Context, *runtimev1pb.LockWithLeaseRequest) (runtimev1pb.Runtime_LockWithLeaseClient, error)
+	// GetLockInfo gets the holders of a lock
+	GetLockInfo(context.Context, *runtimev1pb.GetLockInfoRequest) (*runtimev1pb.GetLockInfoResponse, error)
+	// ListLocks lists the locks held by somebody
+	ListLocks(context.Context, *runtimev1pb.ListLocksRequest) (*runtimev1pb.ListLocksResponse, error)
 
 	// Sequencer API
 	// Get next unique id with some auto-increment guarantee
 	GetNextId(ctx context.Context, in *runtimev1pb.GetNextIdRequest) (*runtimev1pb.GetNextIdResponse, error)
+	// GetNextIds get a batch of unique ids in one request
+	GetNextIds(ctx context.Context, in *runtimev1pb.GetNextIdsRequest) (*runtimev1pb.GetNextIdsResponse, error)
 
 	// Secret API
 	GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest, opts ...grpc.CallOption) (*runtimev1pb.GetSecretResponse, error)
 	GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRequest, opts ...grpc.CallOption) (*runtimev1pb.GetBulkSecretResponse, error)
 
+	// Object Storage API
+	// ListAllObjectsIterator lists all the objects under the prefix with ListAllObjects, and iterates them one by one
+	ListAllObjectsIterator(ctx context.Context, in *s3.ListAllObjectsInput) (*ObjectIterator, error)
+
 	// Close cleans up all resources created by the client.
 	Close()
 }
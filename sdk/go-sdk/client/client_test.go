@@ -22,6 +22,7 @@ import (
 
 	"net"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,6 +31,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 
+	"mosn.io/layotto/spec/proto/extension/v1/s3"
 	pb "mosn.io/layotto/spec/proto/runtime/v1"
 	runtimev1pb "mosn.io/layotto/spec/proto/runtime/v1"
 )
@@ -84,6 +86,7 @@ func getTestClient(ctx context.Context) (client Client, closer func()) {
 		state:      make(map[string][]byte),
 		lock:       make(map[string]string),
 	})
+	s3.RegisterObjectStorageServiceServer(s, &testOssServer{})
 
 	l := bufconn.Listen(testBufSize)
 	go func() {
@@ -116,6 +119,8 @@ type testRuntimeServer struct {
 	subscribed map[string]bool
 	state      map[string][]byte
 	lock       map[string]string
+	// fencingToken is the token of the last successful TryLock
+	fencingToken int64
 }
 
 func (t *testRuntimeServer) InvokeService(ctx context.Context, req *runtimev1pb.InvokeServiceRequest) (*runtimev1pb.InvokeResponse, error) {
@@ -271,8 +276,10 @@ func (*testRuntimeServer) GetBulkSecret(ctx context.Context, in *runtimev1pb.Get
 func (t *testRuntimeServer) TryLock(ctx context.Context, in *runtimev1pb.TryLockRequest) (*runtimev1pb.TryLockResponse, error) {
 	if len(t.lock[in.ResourceId]) == 0 {
 		t.lock[in.ResourceId] = in.LockOwner
+		t.fencingToken++
 		return &runtimev1pb.TryLockResponse{
-			Success: true,
+			Success:      true,
+			FencingToken: t.fencingToken,
 		}, nil
 	}
 	// lock exist
@@ -281,6 +288,22 @@ func (t *testRuntimeServer) TryLock(ctx context.Context, in *runtimev1pb.TryLock
 	}, nil
 }
 
+func (t *testRuntimeServer) Lock(ctx context.Context, in *runtimev1pb.LockRequest) (*runtimev1pb.LockResponse, error) {
+	resp, err := t.TryLock(ctx, &runtimev1pb.TryLockRequest{
+		StoreName:  in.StoreName,
+		ResourceId: in.ResourceId,
+		LockOwner:  in.LockOwner,
+		Expire:     in.Expire,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &runtimev1pb.LockResponse{
+		Success:      resp.Success,
+		FencingToken: resp.FencingToken,
+	}, nil
+}
+
 func (t *testRuntimeServer) Unlock(ctx context.Context, in *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockResponse, error) {
 	// LOCK_UNEXIST
 	if len(t.lock[in.ResourceId]) == 0 {
@@ -300,3 +323,61 @@ func (t *testRuntimeServer) Unlock(ctx context.Context, in *runtimev1pb.UnlockRe
 		Status: pb.UnlockResponse_LOCK_BELONG_TO_OTHERS,
 	}, nil
 }
+
+func (t *testRuntimeServer) LockWithLease(in *runtimev1pb.LockWithLeaseRequest, srv runtimev1pb.Runtime_LockWithLeaseServer) error {
+	resp, err := t.TryLock(srv.Context(), &runtimev1pb.TryLockRequest{
+		StoreName:  in.StoreName,
+		ResourceId: in.ResourceId,
+		LockOwner:  in.LockOwner,
+		Expire:     in.Expire,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return srv.Send(&runtimev1pb.LockWithLeaseResponse{Status: runtimev1pb.LockWithLeaseResponse_FAILED})
+	}
+	err = srv.Send(&runtimev1pb.LockWithLeaseResponse{
+		Status:       runtimev1pb.LockWithLeaseResponse_ACQUIRED,
+		FencingToken: resp.FencingToken,
+	})
+	if err != nil {
+		return err
+	}
+	<-srv.Context().Done()
+	return nil
+}
+
+func (t *testRuntimeServer) GetLockInfo(ctx context.Context, in *runtimev1pb.GetLockInfoRequest) (*runtimev1pb.GetLockInfoResponse, error) {
+	resp := &runtimev1pb.GetLockInfoResponse{}
+	if owner := t.lock[in.ResourceId]; len(owner) > 0 {
+		resp.Holders = append(resp.Holders, &runtimev1pb.LockHolder{LockOwner: owner})
+	}
+	return resp, nil
+}
+
+func (t *testRuntimeServer) ListLocks(ctx context.Context, in *runtimev1pb.ListLocksRequest) (*runtimev1pb.ListLocksResponse, error) {
+	resp := &runtimev1pb.ListLocksResponse{}
+	for resourceId, owner := range t.lock {
+		if strings.HasPrefix(resourceId, in.Prefix) {
+			resp.Locks = append(resp.Locks, &runtimev1pb.LockInfo{
+				ResourceId: resourceId,
+				Holders:    []*runtimev1pb.LockHolder{{LockOwner: owner}},
+			})
+		}
+	}
+	return resp, nil
+}
+
+func (t *testRuntimeServer) GetLockHolderCount(ctx context.Context, in *runtimev1pb.GetLockHolderCountRequest) (*runtimev1pb.GetLockHolderCountResponse, error) {
+	resp := &runtimev1pb.GetLockHolderCountResponse{}
+	owner := t.lock[in.ResourceId]
+	if len(owner) == 0 {
+		return resp, nil
+	}
+	resp.HolderCount = 1
+	if owner == in.LockOwner {
+		resp.ReentrantCount = 1
+	}
+	return resp, nil
+}
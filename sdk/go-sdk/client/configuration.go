@@ -39,6 +39,9 @@ type ConfigurationRequestItem struct {
 	Keys []string
 	// The metadata which will be sent to configuration store components.
 	Metadata map[string]string
+	// The revision of the last response received, only used by SubscribeConfiguration.
+	// The changes missed after it are sent first.
+	SinceRevision int64
 }
 
 type ConfigurationItem struct {
@@ -79,6 +82,20 @@ type SubConfigurationResp struct {
 	// The list of configuration items to save.
 	// To delete a exist item, set the key (also label) and let content to be empty
 	Items []*ConfigurationItem
+	// The items rejected because their contents don't match the JSON Schemas of their keys.
+	RejectedItems []*ConfigurationValidationResult
+	// The revision of the config store after the change.
+	// Set it as SinceRevision when subscribing again to get the changes missed.
+	Revision int64
+}
+
+// ConfigurationValidationResult is the result of validating a configuration item against the JSON Schema of its key.
+type ConfigurationValidationResult struct {
+	Group string
+	Label string
+	Key   string
+	// The reason why the content is invalid.
+	Error string
 }
 
 type WatchResponse struct {
@@ -129,7 +146,7 @@ func (c *GRPCClient) SubscribeConfiguration(ctx context.Context, in *Configurati
 		close(resCh)
 		return resCh
 	}
-	request := &runtimev1pb.SubscribeConfigurationRequest{StoreName: in.StoreName, AppId: in.AppId, Group: in.Group, Label: in.Label, Keys: in.Keys, Metadata: in.Metadata}
+	request := &runtimev1pb.SubscribeConfigurationRequest{StoreName: in.StoreName, AppId: in.AppId, Group: in.Group, Label: in.Label, Keys: in.Keys, Metadata: in.Metadata, SinceRevision: in.SinceRevision}
 	err = cli.Send(request)
 	if err != nil {
 		res.Err = err
@@ -149,6 +166,7 @@ func (c *GRPCClient) SubscribeConfiguration(ctx context.Context, in *Configurati
 			item := &SubConfigurationResp{}
 			item.StoreName = resp.StoreName
 			item.AppId = resp.AppId
+			item.Revision = resp.Revision
 			for _, v := range resp.Items {
 				c := &ConfigurationItem{}
 				c.Metadata = v.Metadata
@@ -159,6 +177,9 @@ func (c *GRPCClient) SubscribeConfiguration(ctx context.Context, in *Configurati
 				c.Content = v.Content
 				item.Items = append(item.Items, c)
 			}
+			for _, v := range resp.RejectedItems {
+				item.RejectedItems = append(item.RejectedItems, &ConfigurationValidationResult{Group: v.Group, Label: v.Label, Key: v.Key, Error: v.Error})
+			}
 			res.Item = item
 			res.Err = nil
 			resCh <- res
@@ -166,3 +187,14 @@ func (c *GRPCClient) SubscribeConfiguration(ctx context.Context, in *Configurati
 	}, nil)
 	return resCh
 }
+
+// GetConfigurationHistory gets the revisions of a configuration, the newest first.
+func (c *GRPCClient) GetConfigurationHistory(ctx context.Context, in *runtimev1pb.GetConfigurationHistoryRequest) (*runtimev1pb.GetConfigurationHistoryResponse, error) {
+	return c.protoClient.GetConfigurationHistory(ctx, in)
+}
+
+// RollbackConfiguration restores a configuration to one of its revisions.
+func (c *GRPCClient) RollbackConfiguration(ctx context.Context, in *runtimev1pb.RollbackConfigurationRequest) error {
+	_, err := c.protoClient.RollbackConfiguration(ctx, in)
+	return err
+}
@@ -22,6 +22,28 @@ func (c *GRPCClient) TryLock(ctx context.Context, req *runtimev1pb.TryLockReques
 	return c.protoClient.TryLock(ctx, req)
 }
 
+func (c *GRPCClient) Lock(ctx context.Context, req *runtimev1pb.LockRequest) (*runtimev1pb.LockResponse, error) {
+	return c.protoClient.Lock(ctx, req)
+}
+
 func (c *GRPCClient) Unlock(ctx context.Context, req *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockResponse, error) {
 	return c.protoClient.Unlock(ctx, req)
 }
+
+func (c *GRPCClient) GetLockHolderCount(ctx context.Context, req *runtimev1pb.GetLockHolderCountRequest) (*runtimev1pb.GetLockHolderCountResponse, error) {
+	return c.protoClient.GetLockHolderCount(ctx, req)
+}
+
+// LockWithLease returns a stream whose first message tells if the lock is acquired.
+// The lock is released by Layotto after ctx is canceled.
+func (c *GRPCClient) LockWithLease(ctx context.Context, req *runtimev1pb.LockWithLeaseRequest) (runtimev1pb.Runtime_LockWithLeaseClient, error) {
+	return c.protoClient.LockWithLease(ctx, req)
+}
+
+func (c *GRPCClient) GetLockInfo(ctx context.Context, req *runtimev1pb.GetLockInfoRequest) (*runtimev1pb.GetLockInfoResponse, error) {
+	return c.protoClient.GetLockInfo(ctx, req)
+}
+
+func (c *GRPCClient) ListLocks(ctx context.Context, req *runtimev1pb.ListLocksRequest) (*runtimev1pb.ListLocksResponse, error) {
+	return c.protoClient.ListLocks(ctx, req)
+}
@@ -37,6 +37,94 @@ func TestTryLock(t *testing.T) {
 		lock, err := testClient.TryLock(ctx, &request)
 		assert.Nil(t, err)
 		assert.True(t, lock.Success)
+		assert.True(t, lock.FencingToken > 0)
+	})
+}
+
+func TestLock(t *testing.T) {
+	ctx := context.Background()
+	t.Run("lock successfully", func(t *testing.T) {
+		request := runtimev1pb.LockRequest{
+			StoreName:   "demo",
+			ResourceId:  "blocking_lock_test",
+			LockOwner:   "layotto",
+			Expire:      10,
+			WaitTimeout: 1000,
+		}
+		lock, err := testClient.Lock(ctx, &request)
+		assert.Nil(t, err)
+		assert.True(t, lock.Success)
+		assert.True(t, lock.FencingToken > 0)
+	})
+}
+
+func TestGetLockHolderCount(t *testing.T) {
+	ctx := context.Background()
+	t.Run("get holder count successfully", func(t *testing.T) {
+		request := runtimev1pb.TryLockRequest{
+			StoreName:  "demo",
+			ResourceId: "holder_count_test",
+			LockOwner:  "layotto",
+		}
+		lock, err := testClient.TryLock(ctx, &request)
+		assert.Nil(t, err)
+		assert.True(t, lock.Success)
+		resp, err := testClient.GetLockHolderCount(ctx, &runtimev1pb.GetLockHolderCountRequest{
+			StoreName:  "demo",
+			ResourceId: "holder_count_test",
+			LockOwner:  "layotto",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, runtimev1pb.LockMode_EXCLUSIVE, resp.Mode)
+		assert.Equal(t, int32(1), resp.HolderCount)
+		assert.Equal(t, int32(1), resp.ReentrantCount)
+	})
+}
+
+func TestLockWithLease(t *testing.T) {
+	t.Run("lock with lease successfully", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream, err := testClient.LockWithLease(ctx, &runtimev1pb.LockWithLeaseRequest{
+			StoreName:  "demo",
+			ResourceId: "lease_test",
+			LockOwner:  "layotto",
+			Expire:     10,
+		})
+		assert.Nil(t, err)
+		resp, err := stream.Recv()
+		assert.Nil(t, err)
+		assert.Equal(t, runtimev1pb.LockWithLeaseResponse_ACQUIRED, resp.Status)
+		assert.True(t, resp.FencingToken > 0)
+	})
+}
+
+func TestGetLockInfo(t *testing.T) {
+	ctx := context.Background()
+	t.Run("get lock info successfully", func(t *testing.T) {
+		request := runtimev1pb.TryLockRequest{
+			StoreName:  "demo",
+			ResourceId: "lock_info_test",
+			LockOwner:  "layotto",
+		}
+		lock, err := testClient.TryLock(ctx, &request)
+		assert.Nil(t, err)
+		assert.True(t, lock.Success)
+		resp, err := testClient.GetLockInfo(ctx, &runtimev1pb.GetLockInfoRequest{
+			StoreName:  "demo",
+			ResourceId: "lock_info_test",
+		})
+		assert.Nil(t, err)
+		assert.Len(t, resp.Holders, 1)
+		assert.Equal(t, "layotto", resp.Holders[0].LockOwner)
+
+		listResp, err := testClient.ListLocks(ctx, &runtimev1pb.ListLocksRequest{
+			StoreName: "demo",
+			Prefix:    "lock_info_",
+		})
+		assert.Nil(t, err)
+		assert.Len(t, listResp.Locks, 1)
+		assert.Equal(t, "lock_info_test", listResp.Locks[0].ResourceId)
 	})
 }
 
@@ -0,0 +1,106 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package client
+
+import (
+	"context"
+	"io"
+
+	"mosn.io/layotto/spec/proto/extension/v1/s3"
+)
+
+// ObjectIterator iterates the objects and the common prefixes returned by ListAllObjects, in the order of the pages.
+//
+//	it, err := cli.ListAllObjectsIterator(ctx, &s3.ListAllObjectsInput{StoreName: "oss_demo", Bucket: "bucket"})
+//	for it.Next() {
+//		fmt.Println(it.Object().Key)
+//	}
+//	err = it.Err()
+type ObjectIterator struct {
+	stream s3.ObjectStorageService_ListAllObjectsClient
+	page   *s3.ListAllObjectsOutput
+	// index of the next object and the next common prefix in the page
+	i, j   int
+	object *s3.Object
+	prefix string
+	err    error
+	done   bool
+
+	isTruncated bool
+	nextMarker  string
+}
+
+func (c *GRPCClient) ListAllObjectsIterator(ctx context.Context, in *s3.ListAllObjectsInput) (*ObjectIterator, error) {
+	stream, err := c.ListAllObjects(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectIterator{stream: stream, page: &s3.ListAllObjectsOutput{}}, nil
+}
+
+// Next moves to the next object or common prefix, and returns false if there is no more or an error occurs
+func (it *ObjectIterator) Next() bool {
+	it.object, it.prefix = nil, ""
+	for !it.done {
+		contents, prefixes := it.page.Contents, it.page.CommonPrefixes
+		if it.i < len(contents) || it.j < len(prefixes) {
+			// the objects and the common prefixes are merged in the order of keys
+			if it.j == len(prefixes) || (it.i < len(contents) && contents[it.i].Key < prefixes[it.j]) {
+				it.object = contents[it.i]
+				it.i++
+			} else {
+				it.prefix = prefixes[it.j]
+				it.j++
+			}
+			return true
+		}
+		page, err := it.stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				it.err = err
+			}
+			it.done = true
+			return false
+		}
+		if page.IsTruncated {
+			it.isTruncated, it.nextMarker = true, page.NextMarker
+		}
+		it.page, it.i, it.j = page, 0, 0
+	}
+	return false
+}
+
+// Object returns the current object, which is nil if the current one is a common prefix
+func (it *ObjectIterator) Object() *s3.Object {
+	return it.object
+}
+
+// CommonPrefix returns the current common prefix, which is empty if the current one is an object
+func (it *ObjectIterator) CommonPrefix() string {
+	return it.prefix
+}
+
+// Err returns the error which stops the iteration
+func (it *ObjectIterator) Err() error {
+	return it.err
+}
+
+// IsTruncated tells if the listing stops at max_items, after Next returns false
+func (it *ObjectIterator) IsTruncated() bool {
+	return it.isTruncated
+}
+
+// NextMarker is the start_after to continue a truncated listing, and it's empty if the listing is recursive
+func (it *ObjectIterator) NextMarker() string {
+	return it.nextMarker
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Layotto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mosn.io/layotto/spec/proto/extension/v1/s3"
+)
+
+type testOssServer struct {
+	s3.UnimplementedObjectStorageServiceServer
+}
+
+func (s *testOssServer) ListAllObjects(in *s3.ListAllObjectsInput, stream s3.ObjectStorageService_ListAllObjectsServer) error {
+	if in.StoreName != "oss_demo" {
+		return status.Errorf(codes.InvalidArgument, "not supported store type: %+v", in.StoreName)
+	}
+	pages := []*s3.ListAllObjectsOutput{
+		{Contents: []*s3.Object{{Key: "a"}, {Key: "c"}}, CommonPrefixes: []string{"b/"}},
+		{},
+		{Contents: []*s3.Object{{Key: "d"}}, IsTruncated: true, NextMarker: "d"},
+	}
+	for _, page := range pages {
+		if err := stream.Send(page); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestListAllObjectsIterator(t *testing.T) {
+	ctx := context.Background()
+	it, err := testClient.ListAllObjectsIterator(ctx, &s3.ListAllObjectsInput{StoreName: "oss_demo", Bucket: "bucket", Delimiter: "/"})
+	assert.Nil(t, err)
+	var items []string
+	for it.Next() {
+		if it.Object() != nil {
+			items = append(items, it.Object().Key)
+		} else {
+			items = append(items, it.CommonPrefix())
+		}
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"a", "b/", "c", "d"}, items)
+	assert.True(t, it.IsTruncated())
+	assert.Equal(t, "d", it.NextMarker())
+	assert.False(t, it.Next())
+
+	it, err = testClient.ListAllObjectsIterator(ctx, &s3.ListAllObjectsInput{StoreName: "not_exist"})
+	assert.Nil(t, err)
+	assert.False(t, it.Next())
+	assert.Equal(t, codes.InvalidArgument, status.Code(it.Err()))
+}
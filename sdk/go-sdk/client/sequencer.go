@@ -21,3 +21,7 @@ import (
 func (c *GRPCClient) GetNextId(ctx context.Context, req *runtimev1pb.GetNextIdRequest) (*runtimev1pb.GetNextIdResponse, error) {
 	return c.protoClient.GetNextId(ctx, req)
 }
+
+func (c *GRPCClient) GetNextIds(ctx context.Context, req *runtimev1pb.GetNextIdsRequest) (*runtimev1pb.GetNextIdsResponse, error) {
+	return c.protoClient.GetNextIds(ctx, req)
+}
@@ -43,6 +43,9 @@ type ObjectStorageServiceClient interface {
 	//Returns some or all (up to 1,000) of the objects in a bucket.
 	//Refer https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_ListObjects.html
 	ListObjects(ctx context.Context, in *ListObjectsInput, opts ...grpc.CallOption) (*ListObjectsOutput, error)
+	//Returns all the objects under the prefix in a stream, paging through the bucket with the marker or the
+	//continuation token of the provider.
+	ListAllObjects(ctx context.Context, in *ListAllObjectsInput, opts ...grpc.CallOption) (ObjectStorageService_ListAllObjectsClient, error)
 	//The HEAD action retrieves metadata from an object without returning the object itself.
 	//Refer https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadObject.html
 	HeadObject(ctx context.Context, in *HeadObjectInput, opts ...grpc.CallOption) (*HeadObjectOutput, error)
@@ -216,6 +219,38 @@ func (c *objectStorageServiceClient) ListObjects(ctx context.Context, in *ListOb
 	return out, nil
 }
 
+func (c *objectStorageServiceClient) ListAllObjects(ctx context.Context, in *ListAllObjectsInput, opts ...grpc.CallOption) (ObjectStorageService_ListAllObjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ObjectStorageService_ServiceDesc.Streams[2], "/spec.proto.extension.v1.s3.ObjectStorageService/ListAllObjects", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &objectStorageServiceListAllObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ObjectStorageService_ListAllObjectsClient interface {
+	Recv() (*ListAllObjectsOutput, error)
+	grpc.ClientStream
+}
+
+type objectStorageServiceListAllObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectStorageServiceListAllObjectsClient) Recv() (*ListAllObjectsOutput, error) {
+	m := new(ListAllObjectsOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *objectStorageServiceClient) HeadObject(ctx context.Context, in *HeadObjectInput, opts ...grpc.CallOption) (*HeadObjectOutput, error) {
 	out := new(HeadObjectOutput)
 	err := c.cc.Invoke(ctx, "/spec.proto.extension.v1.s3.ObjectStorageService/HeadObject", in, out, opts...)
@@ -289,7 +324,7 @@ func (c *objectStorageServiceClient) CreateMultipartUpload(ctx context.Context,
 }
 
 func (c *objectStorageServiceClient) UploadPart(ctx context.Context, opts ...grpc.CallOption) (ObjectStorageService_UploadPartClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ObjectStorageService_ServiceDesc.Streams[2], "/spec.proto.extension.v1.s3.ObjectStorageService/UploadPart", opts...)
+	stream, err := c.cc.NewStream(ctx, &ObjectStorageService_ServiceDesc.Streams[3], "/spec.proto.extension.v1.s3.ObjectStorageService/UploadPart", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -404,7 +439,7 @@ func (c *objectStorageServiceClient) UpdateUploadBandwidthRateLimit(ctx context.
 }
 
 func (c *objectStorageServiceClient) AppendObject(ctx context.Context, opts ...grpc.CallOption) (ObjectStorageService_AppendObjectClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ObjectStorageService_ServiceDesc.Streams[3], "/spec.proto.extension.v1.s3.ObjectStorageService/AppendObject", opts...)
+	stream, err := c.cc.NewStream(ctx, &ObjectStorageService_ServiceDesc.Streams[4], "/spec.proto.extension.v1.s3.ObjectStorageService/AppendObject", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -469,6 +504,9 @@ type ObjectStorageServiceServer interface {
 	//Returns some or all (up to 1,000) of the objects in a bucket.
 	//Refer https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_ListObjects.html
 	ListObjects(context.Context, *ListObjectsInput) (*ListObjectsOutput, error)
+	//Returns all the objects under the prefix in a stream, paging through the bucket with the marker or the
+	//continuation token of the provider.
+	ListAllObjects(*ListAllObjectsInput, ObjectStorageService_ListAllObjectsServer) error
 	//The HEAD action retrieves metadata from an object without returning the object itself.
 	//Refer https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadObject.html
 	HeadObject(context.Context, *HeadObjectInput) (*HeadObjectOutput, error)
@@ -554,6 +592,9 @@ func (UnimplementedObjectStorageServiceServer) DeleteObjects(context.Context, *D
 func (UnimplementedObjectStorageServiceServer) ListObjects(context.Context, *ListObjectsInput) (*ListObjectsOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
 }
+func (UnimplementedObjectStorageServiceServer) ListAllObjects(*ListAllObjectsInput, ObjectStorageService_ListAllObjectsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAllObjects not implemented")
+}
 func (UnimplementedObjectStorageServiceServer) HeadObject(context.Context, *HeadObjectInput) (*HeadObjectOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HeadObject not implemented")
 }
@@ -745,6 +786,27 @@ func _ObjectStorageService_ListObjects_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ObjectStorageService_ListAllObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAllObjectsInput)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectStorageServiceServer).ListAllObjects(m, &objectStorageServiceListAllObjectsServer{stream})
+}
+
+type ObjectStorageService_ListAllObjectsServer interface {
+	Send(*ListAllObjectsOutput) error
+	grpc.ServerStream
+}
+
+type objectStorageServiceListAllObjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectStorageServiceListAllObjectsServer) Send(m *ListAllObjectsOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ObjectStorageService_HeadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HeadObjectInput)
 	if err := dec(in); err != nil {
@@ -1228,6 +1290,11 @@ var ObjectStorageService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ObjectStorageService_GetObject_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListAllObjects",
+			Handler:       _ObjectStorageService_ListAllObjects_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "UploadPart",
 			Handler:       _ObjectStorageService_UploadPart_Handler,
@@ -28,6 +28,16 @@ type RuntimeClient interface {
 	SayHello(ctx context.Context, in *SayHelloRequest, opts ...grpc.CallOption) (*SayHelloResponse, error)
 	// InvokeService do rpc calls
 	InvokeService(ctx context.Context, in *InvokeServiceRequest, opts ...grpc.CallOption) (*InvokeResponse, error)
+	// InvokeServiceClientStream do rpc calls with a stream of request data, e.g. uploading a large payload,
+	// so that the request data is not buffered in Layotto.
+	// The id, method, content_type and http_extension are read from the first message.
+	InvokeServiceClientStream(ctx context.Context, opts ...grpc.CallOption) (Runtime_InvokeServiceClientStreamClient, error)
+	// InvokeServiceServerStream do rpc calls with a stream of response data, e.g. downloading a large payload.
+	InvokeServiceServerStream(ctx context.Context, in *InvokeServiceRequest, opts ...grpc.CallOption) (Runtime_InvokeServiceServerStreamClient, error)
+	// InvokeServiceBidiStream do rpc calls with streams of both request data and response data,
+	// and the response data is streamed back while the request data is still being sent.
+	// The id, method, content_type and http_extension are read from the first message.
+	InvokeServiceBidiStream(ctx context.Context, opts ...grpc.CallOption) (Runtime_InvokeServiceBidiStreamClient, error)
 	// GetConfiguration gets configuration from configuration store.
 	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
 	// SaveConfiguration saves configuration into configuration store.
@@ -36,16 +46,37 @@ type RuntimeClient interface {
 	DeleteConfiguration(ctx context.Context, in *DeleteConfigurationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// SubscribeConfiguration gets configuration from configuration store and subscribe the updates.
 	SubscribeConfiguration(ctx context.Context, opts ...grpc.CallOption) (Runtime_SubscribeConfigurationClient, error)
+	// GetConfigurationHistory gets the prior revisions of a configuration item, latest first.
+	// It's only available when the configuration store supports history.
+	GetConfigurationHistory(ctx context.Context, in *GetConfigurationHistoryRequest, opts ...grpc.CallOption) (*GetConfigurationHistoryResponse, error)
+	// RollbackConfiguration restores a configuration item to a prior revision.
+	// It's only available when the configuration store supports history.
+	RollbackConfiguration(ctx context.Context, in *RollbackConfigurationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Distributed Lock API
 	// A non-blocking method trying to get a lock with ttl.
 	TryLock(ctx context.Context, in *TryLockRequest, opts ...grpc.CallOption) (*TryLockResponse, error)
+	// A blocking method trying to get a lock with ttl.
+	// It waits until the lock is acquired or wait_timeout elapses,and waiters of the same lock get it in FIFO order.
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
 	// A method trying to unlock.
 	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
 	// A method used to support lease renewal for distributed lock.
 	LockKeepAlive(ctx context.Context, in *LockKeepAliveRequest, opts ...grpc.CallOption) (*LockKeepAliveResponse, error)
+	// A method to get the number of holders of a lock and the reentrant count of an owner.
+	GetLockHolderCount(ctx context.Context, in *GetLockHolderCountRequest, opts ...grpc.CallOption) (*GetLockHolderCountResponse, error)
+	// A streaming method trying to get a lock,and Layotto renews the lease in the background while the stream is open.
+	// The lock is released as soon as the stream is closed,e.g. the app cancels the call or the app process dies.
+	LockWithLease(ctx context.Context, in *LockWithLeaseRequest, opts ...grpc.CallOption) (Runtime_LockWithLeaseClient, error)
+	// A method to get the holders of a lock.
+	GetLockInfo(ctx context.Context, in *GetLockInfoRequest, opts ...grpc.CallOption) (*GetLockInfoResponse, error)
+	// A method to list the locks held by somebody.
+	// It's only available when the lock store supports the `LIST_LOCK` feature.
+	ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*ListLocksResponse, error)
 	// Sequencer API
 	// Get next unique id with some auto-increment guarantee
 	GetNextId(ctx context.Context, in *GetNextIdRequest, opts ...grpc.CallOption) (*GetNextIdResponse, error)
+	// Get a batch of unique ids in one request.
+	GetNextIds(ctx context.Context, in *GetNextIdsRequest, opts ...grpc.CallOption) (*GetNextIdsResponse, error)
 	// Gets the state for a specific key.
 	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
 	// Gets a bulk of state items for a list of keys
@@ -60,7 +91,8 @@ type RuntimeClient interface {
 	ExecuteStateTransaction(ctx context.Context, in *ExecuteStateTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Publishes events to the specific topic
 	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Get file with stream
+	// Get file with stream, a range of the file can be read and the read can be conditional.
+	// If the range is not satisfiable, return code.OutOfRange error
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (Runtime_GetFileClient, error)
 	// Put file with stream
 	PutFile(ctx context.Context, opts ...grpc.CallOption) (Runtime_PutFileClient, error)
@@ -70,6 +102,25 @@ type RuntimeClient interface {
 	DelFile(ctx context.Context, in *DelFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Get file meta data, if file not exist,return code.NotFound error
 	GetFileMeta(ctx context.Context, in *GetFileMetaRequest, opts ...grpc.CallOption) (*GetFileMetaResponse, error)
+	// Initiate a resumable upload, if the store doesn't support it, return code.Unimplemented error
+	InitiateFileUpload(ctx context.Context, in *InitiateFileUploadRequest, opts ...grpc.CallOption) (*InitiateFileUploadResponse, error)
+	// Upload a part of file with stream, the offset must be the committed size, otherwise return code.FailedPrecondition error
+	UploadFilePart(ctx context.Context, opts ...grpc.CallOption) (Runtime_UploadFilePartClient, error)
+	// Get the committed size of a resumable upload, if the upload not exist, return code.NotFound error
+	GetFileUploadStatus(ctx context.Context, in *FileUploadRequest, opts ...grpc.CallOption) (*FileUploadStatusResponse, error)
+	// Complete a resumable upload, and the file is visible after that
+	CompleteFileUpload(ctx context.Context, in *FileUploadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Abort a resumable upload, and the parts uploaded are removed
+	AbortFileUpload(ctx context.Context, in *FileUploadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Copy file by server-side copy, and the file is streamed through the runtime if the target store is another store.
+	// If the source file not exist, return code.NotFound error
+	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Rename file atomically if the store supports it, otherwise copy the file and delete the source file.
+	// If the source file not exist, return code.NotFound error
+	RenameFile(ctx context.Context, in *RenameFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Generate a url signed by the runtime, so that a client without credentials can access the file or the object
+	// through the presign http listener of the runtime until the url expires.
+	PresignURL(ctx context.Context, in *PresignURLRequest, opts ...grpc.CallOption) (*PresignURLResponse, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -104,6 +155,103 @@ func (c *runtimeClient) InvokeService(ctx context.Context, in *InvokeServiceRequ
 	return out, nil
 }
 
+func (c *runtimeClient) InvokeServiceClientStream(ctx context.Context, opts ...grpc.CallOption) (Runtime_InvokeServiceClientStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[0], "/spec.proto.runtime.v1.Runtime/InvokeServiceClientStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeInvokeServiceClientStreamClient{stream}
+	return x, nil
+}
+
+type Runtime_InvokeServiceClientStreamClient interface {
+	Send(*InvokeServiceStreamRequest) error
+	CloseAndRecv() (*InvokeResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeInvokeServiceClientStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeInvokeServiceClientStreamClient) Send(m *InvokeServiceStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *runtimeInvokeServiceClientStreamClient) CloseAndRecv() (*InvokeResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(InvokeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *runtimeClient) InvokeServiceServerStream(ctx context.Context, in *InvokeServiceRequest, opts ...grpc.CallOption) (Runtime_InvokeServiceServerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[1], "/spec.proto.runtime.v1.Runtime/InvokeServiceServerStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeInvokeServiceServerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Runtime_InvokeServiceServerStreamClient interface {
+	Recv() (*InvokeServiceStreamResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeInvokeServiceServerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeInvokeServiceServerStreamClient) Recv() (*InvokeServiceStreamResponse, error) {
+	m := new(InvokeServiceStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *runtimeClient) InvokeServiceBidiStream(ctx context.Context, opts ...grpc.CallOption) (Runtime_InvokeServiceBidiStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[2], "/spec.proto.runtime.v1.Runtime/InvokeServiceBidiStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeInvokeServiceBidiStreamClient{stream}
+	return x, nil
+}
+
+type Runtime_InvokeServiceBidiStreamClient interface {
+	Send(*InvokeServiceStreamRequest) error
+	Recv() (*InvokeServiceStreamResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeInvokeServiceBidiStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeInvokeServiceBidiStreamClient) Send(m *InvokeServiceStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *runtimeInvokeServiceBidiStreamClient) Recv() (*InvokeServiceStreamResponse, error) {
+	m := new(InvokeServiceStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *runtimeClient) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
 	out := new(GetConfigurationResponse)
 	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetConfiguration", in, out, opts...)
@@ -132,7 +280,7 @@ func (c *runtimeClient) DeleteConfiguration(ctx context.Context, in *DeleteConfi
 }
 
 func (c *runtimeClient) SubscribeConfiguration(ctx context.Context, opts ...grpc.CallOption) (Runtime_SubscribeConfigurationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[0], "/spec.proto.runtime.v1.Runtime/SubscribeConfiguration", opts...)
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[3], "/spec.proto.runtime.v1.Runtime/SubscribeConfiguration", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +310,24 @@ func (x *runtimeSubscribeConfigurationClient) Recv() (*SubscribeConfigurationRes
 	return m, nil
 }
 
+func (c *runtimeClient) GetConfigurationHistory(ctx context.Context, in *GetConfigurationHistoryRequest, opts ...grpc.CallOption) (*GetConfigurationHistoryResponse, error) {
+	out := new(GetConfigurationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetConfigurationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) RollbackConfiguration(ctx context.Context, in *RollbackConfigurationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/RollbackConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeClient) TryLock(ctx context.Context, in *TryLockRequest, opts ...grpc.CallOption) (*TryLockResponse, error) {
 	out := new(TryLockResponse)
 	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/TryLock", in, out, opts...)
@@ -171,6 +337,15 @@ func (c *runtimeClient) TryLock(ctx context.Context, in *TryLockRequest, opts ..
 	return out, nil
 }
 
+func (c *runtimeClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
 	out := new(UnlockResponse)
 	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/Unlock", in, out, opts...)
@@ -189,6 +364,65 @@ func (c *runtimeClient) LockKeepAlive(ctx context.Context, in *LockKeepAliveRequ
 	return out, nil
 }
 
+func (c *runtimeClient) GetLockHolderCount(ctx context.Context, in *GetLockHolderCountRequest, opts ...grpc.CallOption) (*GetLockHolderCountResponse, error) {
+	out := new(GetLockHolderCountResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetLockHolderCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) LockWithLease(ctx context.Context, in *LockWithLeaseRequest, opts ...grpc.CallOption) (Runtime_LockWithLeaseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[4], "/spec.proto.runtime.v1.Runtime/LockWithLease", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeLockWithLeaseClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Runtime_LockWithLeaseClient interface {
+	Recv() (*LockWithLeaseResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeLockWithLeaseClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeLockWithLeaseClient) Recv() (*LockWithLeaseResponse, error) {
+	m := new(LockWithLeaseResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *runtimeClient) GetLockInfo(ctx context.Context, in *GetLockInfoRequest, opts ...grpc.CallOption) (*GetLockInfoResponse, error) {
+	out := new(GetLockInfoResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetLockInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*ListLocksResponse, error) {
+	out := new(ListLocksResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/ListLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeClient) GetNextId(ctx context.Context, in *GetNextIdRequest, opts ...grpc.CallOption) (*GetNextIdResponse, error) {
 	out := new(GetNextIdResponse)
 	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetNextId", in, out, opts...)
@@ -198,6 +432,15 @@ func (c *runtimeClient) GetNextId(ctx context.Context, in *GetNextIdRequest, opt
 	return out, nil
 }
 
+func (c *runtimeClient) GetNextIds(ctx context.Context, in *GetNextIdsRequest, opts ...grpc.CallOption) (*GetNextIdsResponse, error) {
+	out := new(GetNextIdsResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetNextIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
 	out := new(GetStateResponse)
 	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetState", in, out, opts...)
@@ -262,7 +505,7 @@ func (c *runtimeClient) PublishEvent(ctx context.Context, in *PublishEventReques
 }
 
 func (c *runtimeClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (Runtime_GetFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[1], "/spec.proto.runtime.v1.Runtime/GetFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[5], "/spec.proto.runtime.v1.Runtime/GetFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -294,7 +537,7 @@ func (x *runtimeGetFileClient) Recv() (*GetFileResponse, error) {
 }
 
 func (c *runtimeClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (Runtime_PutFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[2], "/spec.proto.runtime.v1.Runtime/PutFile", opts...)
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[6], "/spec.proto.runtime.v1.Runtime/PutFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -354,6 +597,103 @@ func (c *runtimeClient) GetFileMeta(ctx context.Context, in *GetFileMetaRequest,
 	return out, nil
 }
 
+func (c *runtimeClient) InitiateFileUpload(ctx context.Context, in *InitiateFileUploadRequest, opts ...grpc.CallOption) (*InitiateFileUploadResponse, error) {
+	out := new(InitiateFileUploadResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/InitiateFileUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) UploadFilePart(ctx context.Context, opts ...grpc.CallOption) (Runtime_UploadFilePartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Runtime_ServiceDesc.Streams[7], "/spec.proto.runtime.v1.Runtime/UploadFilePart", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeUploadFilePartClient{stream}
+	return x, nil
+}
+
+type Runtime_UploadFilePartClient interface {
+	Send(*UploadFilePartRequest) error
+	CloseAndRecv() (*FileUploadStatusResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeUploadFilePartClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeUploadFilePartClient) Send(m *UploadFilePartRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *runtimeUploadFilePartClient) CloseAndRecv() (*FileUploadStatusResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(FileUploadStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *runtimeClient) GetFileUploadStatus(ctx context.Context, in *FileUploadRequest, opts ...grpc.CallOption) (*FileUploadStatusResponse, error) {
+	out := new(FileUploadStatusResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/GetFileUploadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) CompleteFileUpload(ctx context.Context, in *FileUploadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/CompleteFileUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) AbortFileUpload(ctx context.Context, in *FileUploadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/AbortFileUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/CopyFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) RenameFile(ctx context.Context, in *RenameFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/RenameFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeClient) PresignURL(ctx context.Context, in *PresignURLRequest, opts ...grpc.CallOption) (*PresignURLResponse, error) {
+	out := new(PresignURLResponse)
+	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/PresignURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeClient) InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error) {
 	out := new(InvokeBindingResponse)
 	err := c.cc.Invoke(ctx, "/spec.proto.runtime.v1.Runtime/InvokeBinding", in, out, opts...)
@@ -389,6 +729,16 @@ type RuntimeServer interface {
 	SayHello(context.Context, *SayHelloRequest) (*SayHelloResponse, error)
 	// InvokeService do rpc calls
 	InvokeService(context.Context, *InvokeServiceRequest) (*InvokeResponse, error)
+	// InvokeServiceClientStream do rpc calls with a stream of request data, e.g. uploading a large payload,
+	// so that the request data is not buffered in Layotto.
+	// The id, method, content_type and http_extension are read from the first message.
+	InvokeServiceClientStream(Runtime_InvokeServiceClientStreamServer) error
+	// InvokeServiceServerStream do rpc calls with a stream of response data, e.g. downloading a large payload.
+	InvokeServiceServerStream(*InvokeServiceRequest, Runtime_InvokeServiceServerStreamServer) error
+	// InvokeServiceBidiStream do rpc calls with streams of both request data and response data,
+	// and the response data is streamed back while the request data is still being sent.
+	// The id, method, content_type and http_extension are read from the first message.
+	InvokeServiceBidiStream(Runtime_InvokeServiceBidiStreamServer) error
 	// GetConfiguration gets configuration from configuration store.
 	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
 	// SaveConfiguration saves configuration into configuration store.
@@ -397,16 +747,37 @@ type RuntimeServer interface {
 	DeleteConfiguration(context.Context, *DeleteConfigurationRequest) (*emptypb.Empty, error)
 	// SubscribeConfiguration gets configuration from configuration store and subscribe the updates.
 	SubscribeConfiguration(Runtime_SubscribeConfigurationServer) error
+	// GetConfigurationHistory gets the prior revisions of a configuration item, latest first.
+	// It's only available when the configuration store supports history.
+	GetConfigurationHistory(context.Context, *GetConfigurationHistoryRequest) (*GetConfigurationHistoryResponse, error)
+	// RollbackConfiguration restores a configuration item to a prior revision.
+	// It's only available when the configuration store supports history.
+	RollbackConfiguration(context.Context, *RollbackConfigurationRequest) (*emptypb.Empty, error)
 	// Distributed Lock API
 	// A non-blocking method trying to get a lock with ttl.
 	TryLock(context.Context, *TryLockRequest) (*TryLockResponse, error)
+	// A blocking method trying to get a lock with ttl.
+	// It waits until the lock is acquired or wait_timeout elapses,and waiters of the same lock get it in FIFO order.
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
 	// A method trying to unlock.
 	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
 	// A method used to support lease renewal for distributed lock.
 	LockKeepAlive(context.Context, *LockKeepAliveRequest) (*LockKeepAliveResponse, error)
+	// A method to get the number of holders of a lock and the reentrant count of an owner.
+	GetLockHolderCount(context.Context, *GetLockHolderCountRequest) (*GetLockHolderCountResponse, error)
+	// A streaming method trying to get a lock,and Layotto renews the lease in the background while the stream is open.
+	// The lock is released as soon as the stream is closed,e.g. the app cancels the call or the app process dies.
+	LockWithLease(*LockWithLeaseRequest, Runtime_LockWithLeaseServer) error
+	// A method to get the holders of a lock.
+	GetLockInfo(context.Context, *GetLockInfoRequest) (*GetLockInfoResponse, error)
+	// A method to list the locks held by somebody.
+	// It's only available when the lock store supports the `LIST_LOCK` feature.
+	ListLocks(context.Context, *ListLocksRequest) (*ListLocksResponse, error)
 	// Sequencer API
 	// Get next unique id with some auto-increment guarantee
 	GetNextId(context.Context, *GetNextIdRequest) (*GetNextIdResponse, error)
+	// Get a batch of unique ids in one request.
+	GetNextIds(context.Context, *GetNextIdsRequest) (*GetNextIdsResponse, error)
 	// Gets the state for a specific key.
 	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
 	// Gets a bulk of state items for a list of keys
@@ -421,7 +792,8 @@ type RuntimeServer interface {
 	ExecuteStateTransaction(context.Context, *ExecuteStateTransactionRequest) (*emptypb.Empty, error)
 	// Publishes events to the specific topic
 	PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error)
-	// Get file with stream
+	// Get file with stream, a range of the file can be read and the read can be conditional.
+	// If the range is not satisfiable, return code.OutOfRange error
 	GetFile(*GetFileRequest, Runtime_GetFileServer) error
 	// Put file with stream
 	PutFile(Runtime_PutFileServer) error
@@ -431,6 +803,25 @@ type RuntimeServer interface {
 	DelFile(context.Context, *DelFileRequest) (*emptypb.Empty, error)
 	// Get file meta data, if file not exist,return code.NotFound error
 	GetFileMeta(context.Context, *GetFileMetaRequest) (*GetFileMetaResponse, error)
+	// Initiate a resumable upload, if the store doesn't support it, return code.Unimplemented error
+	InitiateFileUpload(context.Context, *InitiateFileUploadRequest) (*InitiateFileUploadResponse, error)
+	// Upload a part of file with stream, the offset must be the committed size, otherwise return code.FailedPrecondition error
+	UploadFilePart(Runtime_UploadFilePartServer) error
+	// Get the committed size of a resumable upload, if the upload not exist, return code.NotFound error
+	GetFileUploadStatus(context.Context, *FileUploadRequest) (*FileUploadStatusResponse, error)
+	// Complete a resumable upload, and the file is visible after that
+	CompleteFileUpload(context.Context, *FileUploadRequest) (*emptypb.Empty, error)
+	// Abort a resumable upload, and the parts uploaded are removed
+	AbortFileUpload(context.Context, *FileUploadRequest) (*emptypb.Empty, error)
+	// Copy file by server-side copy, and the file is streamed through the runtime if the target store is another store.
+	// If the source file not exist, return code.NotFound error
+	CopyFile(context.Context, *CopyFileRequest) (*emptypb.Empty, error)
+	// Rename file atomically if the store supports it, otherwise copy the file and delete the source file.
+	// If the source file not exist, return code.NotFound error
+	RenameFile(context.Context, *RenameFileRequest) (*emptypb.Empty, error)
+	// Generate a url signed by the runtime, so that a client without credentials can access the file or the object
+	// through the presign http listener of the runtime until the url expires.
+	PresignURL(context.Context, *PresignURLRequest) (*PresignURLResponse, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -449,6 +840,15 @@ func (UnimplementedRuntimeServer) SayHello(context.Context, *SayHelloRequest) (*
 func (UnimplementedRuntimeServer) InvokeService(context.Context, *InvokeServiceRequest) (*InvokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeService not implemented")
 }
+func (UnimplementedRuntimeServer) InvokeServiceClientStream(Runtime_InvokeServiceClientStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeServiceClientStream not implemented")
+}
+func (UnimplementedRuntimeServer) InvokeServiceServerStream(*InvokeServiceRequest, Runtime_InvokeServiceServerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeServiceServerStream not implemented")
+}
+func (UnimplementedRuntimeServer) InvokeServiceBidiStream(Runtime_InvokeServiceBidiStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeServiceBidiStream not implemented")
+}
 func (UnimplementedRuntimeServer) GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
 }
@@ -461,18 +861,42 @@ func (UnimplementedRuntimeServer) DeleteConfiguration(context.Context, *DeleteCo
 func (UnimplementedRuntimeServer) SubscribeConfiguration(Runtime_SubscribeConfigurationServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeConfiguration not implemented")
 }
+func (UnimplementedRuntimeServer) GetConfigurationHistory(context.Context, *GetConfigurationHistoryRequest) (*GetConfigurationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationHistory not implemented")
+}
+func (UnimplementedRuntimeServer) RollbackConfiguration(context.Context, *RollbackConfigurationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfiguration not implemented")
+}
 func (UnimplementedRuntimeServer) TryLock(context.Context, *TryLockRequest) (*TryLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TryLock not implemented")
 }
+func (UnimplementedRuntimeServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
 func (UnimplementedRuntimeServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
 }
 func (UnimplementedRuntimeServer) LockKeepAlive(context.Context, *LockKeepAliveRequest) (*LockKeepAliveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockKeepAlive not implemented")
 }
+func (UnimplementedRuntimeServer) GetLockHolderCount(context.Context, *GetLockHolderCountRequest) (*GetLockHolderCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLockHolderCount not implemented")
+}
+func (UnimplementedRuntimeServer) LockWithLease(*LockWithLeaseRequest, Runtime_LockWithLeaseServer) error {
+	return status.Errorf(codes.Unimplemented, "method LockWithLease not implemented")
+}
+func (UnimplementedRuntimeServer) GetLockInfo(context.Context, *GetLockInfoRequest) (*GetLockInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLockInfo not implemented")
+}
+func (UnimplementedRuntimeServer) ListLocks(context.Context, *ListLocksRequest) (*ListLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocks not implemented")
+}
 func (UnimplementedRuntimeServer) GetNextId(context.Context, *GetNextIdRequest) (*GetNextIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNextId not implemented")
 }
+func (UnimplementedRuntimeServer) GetNextIds(context.Context, *GetNextIdsRequest) (*GetNextIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextIds not implemented")
+}
 func (UnimplementedRuntimeServer) GetState(context.Context, *GetStateRequest) (*GetStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
 }
@@ -509,6 +933,30 @@ func (UnimplementedRuntimeServer) DelFile(context.Context, *DelFileRequest) (*em
 func (UnimplementedRuntimeServer) GetFileMeta(context.Context, *GetFileMetaRequest) (*GetFileMetaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFileMeta not implemented")
 }
+func (UnimplementedRuntimeServer) InitiateFileUpload(context.Context, *InitiateFileUploadRequest) (*InitiateFileUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateFileUpload not implemented")
+}
+func (UnimplementedRuntimeServer) UploadFilePart(Runtime_UploadFilePartServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFilePart not implemented")
+}
+func (UnimplementedRuntimeServer) GetFileUploadStatus(context.Context, *FileUploadRequest) (*FileUploadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileUploadStatus not implemented")
+}
+func (UnimplementedRuntimeServer) CompleteFileUpload(context.Context, *FileUploadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteFileUpload not implemented")
+}
+func (UnimplementedRuntimeServer) AbortFileUpload(context.Context, *FileUploadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortFileUpload not implemented")
+}
+func (UnimplementedRuntimeServer) CopyFile(context.Context, *CopyFileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFile not implemented")
+}
+func (UnimplementedRuntimeServer) RenameFile(context.Context, *RenameFileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFile not implemented")
+}
+func (UnimplementedRuntimeServer) PresignURL(context.Context, *PresignURLRequest) (*PresignURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PresignURL not implemented")
+}
 func (UnimplementedRuntimeServer) InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeBinding not implemented")
 }
@@ -526,194 +974,414 @@ type UnsafeRuntimeServer interface {
 	mustEmbedUnimplementedRuntimeServer()
 }
 
-func RegisterRuntimeServer(s grpc.ServiceRegistrar, srv RuntimeServer) {
-	s.RegisterService(&Runtime_ServiceDesc, srv)
+func RegisterRuntimeServer(s grpc.ServiceRegistrar, srv RuntimeServer) {
+	s.RegisterService(&Runtime_ServiceDesc, srv)
+}
+
+func _Runtime_SayHello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SayHelloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).SayHello(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/SayHello",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).SayHello(ctx, req.(*SayHelloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_InvokeService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).InvokeService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/InvokeService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).InvokeService(ctx, req.(*InvokeServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_InvokeServiceClientStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RuntimeServer).InvokeServiceClientStream(&runtimeInvokeServiceClientStreamServer{stream})
+}
+
+type Runtime_InvokeServiceClientStreamServer interface {
+	SendAndClose(*InvokeResponse) error
+	Recv() (*InvokeServiceStreamRequest, error)
+	grpc.ServerStream
+}
+
+type runtimeInvokeServiceClientStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeInvokeServiceClientStreamServer) SendAndClose(m *InvokeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *runtimeInvokeServiceClientStreamServer) Recv() (*InvokeServiceStreamRequest, error) {
+	m := new(InvokeServiceStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Runtime_InvokeServiceServerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeServiceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServer).InvokeServiceServerStream(m, &runtimeInvokeServiceServerStreamServer{stream})
+}
+
+type Runtime_InvokeServiceServerStreamServer interface {
+	Send(*InvokeServiceStreamResponse) error
+	grpc.ServerStream
+}
+
+type runtimeInvokeServiceServerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeInvokeServiceServerStreamServer) Send(m *InvokeServiceStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Runtime_InvokeServiceBidiStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RuntimeServer).InvokeServiceBidiStream(&runtimeInvokeServiceBidiStreamServer{stream})
+}
+
+type Runtime_InvokeServiceBidiStreamServer interface {
+	Send(*InvokeServiceStreamResponse) error
+	Recv() (*InvokeServiceStreamRequest, error)
+	grpc.ServerStream
+}
+
+type runtimeInvokeServiceBidiStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeInvokeServiceBidiStreamServer) Send(m *InvokeServiceStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *runtimeInvokeServiceBidiStreamServer) Recv() (*InvokeServiceStreamRequest, error) {
+	m := new(InvokeServiceStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Runtime_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).GetConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/GetConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).GetConfiguration(ctx, req.(*GetConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_SaveConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).SaveConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/SaveConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).SaveConfiguration(ctx, req.(*SaveConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_DeleteConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).DeleteConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/DeleteConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).DeleteConfiguration(ctx, req.(*DeleteConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_SubscribeConfiguration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RuntimeServer).SubscribeConfiguration(&runtimeSubscribeConfigurationServer{stream})
+}
+
+type Runtime_SubscribeConfigurationServer interface {
+	Send(*SubscribeConfigurationResponse) error
+	Recv() (*SubscribeConfigurationRequest, error)
+	grpc.ServerStream
+}
+
+type runtimeSubscribeConfigurationServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeSubscribeConfigurationServer) Send(m *SubscribeConfigurationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *runtimeSubscribeConfigurationServer) Recv() (*SubscribeConfigurationRequest, error) {
+	m := new(SubscribeConfigurationRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Runtime_GetConfigurationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).GetConfigurationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/GetConfigurationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).GetConfigurationHistory(ctx, req.(*GetConfigurationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_RollbackConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).RollbackConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/RollbackConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).RollbackConfiguration(ctx, req.(*RollbackConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Runtime_SayHello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SayHelloRequest)
+func _Runtime_TryLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TryLockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServer).SayHello(ctx, in)
+		return srv.(RuntimeServer).TryLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spec.proto.runtime.v1.Runtime/SayHello",
+		FullMethod: "/spec.proto.runtime.v1.Runtime/TryLock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServer).SayHello(ctx, req.(*SayHelloRequest))
+		return srv.(RuntimeServer).TryLock(ctx, req.(*TryLockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Runtime_InvokeService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InvokeServiceRequest)
+func _Runtime_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServer).InvokeService(ctx, in)
+		return srv.(RuntimeServer).Lock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spec.proto.runtime.v1.Runtime/InvokeService",
+		FullMethod: "/spec.proto.runtime.v1.Runtime/Lock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServer).InvokeService(ctx, req.(*InvokeServiceRequest))
+		return srv.(RuntimeServer).Lock(ctx, req.(*LockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Runtime_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigurationRequest)
+func _Runtime_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServer).GetConfiguration(ctx, in)
+		return srv.(RuntimeServer).Unlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spec.proto.runtime.v1.Runtime/GetConfiguration",
+		FullMethod: "/spec.proto.runtime.v1.Runtime/Unlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServer).GetConfiguration(ctx, req.(*GetConfigurationRequest))
+		return srv.(RuntimeServer).Unlock(ctx, req.(*UnlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Runtime_SaveConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveConfigurationRequest)
+func _Runtime_LockKeepAlive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockKeepAliveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServer).SaveConfiguration(ctx, in)
+		return srv.(RuntimeServer).LockKeepAlive(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spec.proto.runtime.v1.Runtime/SaveConfiguration",
+		FullMethod: "/spec.proto.runtime.v1.Runtime/LockKeepAlive",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServer).SaveConfiguration(ctx, req.(*SaveConfigurationRequest))
+		return srv.(RuntimeServer).LockKeepAlive(ctx, req.(*LockKeepAliveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Runtime_DeleteConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteConfigurationRequest)
+func _Runtime_GetLockHolderCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLockHolderCountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServer).DeleteConfiguration(ctx, in)
+		return srv.(RuntimeServer).GetLockHolderCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spec.proto.runtime.v1.Runtime/DeleteConfiguration",
+		FullMethod: "/spec.proto.runtime.v1.Runtime/GetLockHolderCount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServer).DeleteConfiguration(ctx, req.(*DeleteConfigurationRequest))
+		return srv.(RuntimeServer).GetLockHolderCount(ctx, req.(*GetLockHolderCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Runtime_SubscribeConfiguration_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(RuntimeServer).SubscribeConfiguration(&runtimeSubscribeConfigurationServer{stream})
+func _Runtime_LockWithLease_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LockWithLeaseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServer).LockWithLease(m, &runtimeLockWithLeaseServer{stream})
 }
 
-type Runtime_SubscribeConfigurationServer interface {
-	Send(*SubscribeConfigurationResponse) error
-	Recv() (*SubscribeConfigurationRequest, error)
+type Runtime_LockWithLeaseServer interface {
+	Send(*LockWithLeaseResponse) error
 	grpc.ServerStream
 }
 
-type runtimeSubscribeConfigurationServer struct {
+type runtimeLockWithLeaseServer struct {
 	grpc.ServerStream
 }
 
-func (x *runtimeSubscribeConfigurationServer) Send(m *SubscribeConfigurationResponse) error {
+func (x *runtimeLockWithLeaseServer) Send(m *LockWithLeaseResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *runtimeSubscribeConfigurationServer) Recv() (*SubscribeConfigurationRequest, error) {
-	m := new(SubscribeConfigurationRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _Runtime_TryLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TryLockRequest)
+func _Runtime_GetLockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLockInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServer).TryLock(ctx, in)
+		return srv.(RuntimeServer).GetLockInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spec.proto.runtime.v1.Runtime/TryLock",
+		FullMethod: "/spec.proto.runtime.v1.Runtime/GetLockInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServer).TryLock(ctx, req.(*TryLockRequest))
+		return srv.(RuntimeServer).GetLockInfo(ctx, req.(*GetLockInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Runtime_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockRequest)
+func _Runtime_ListLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServer).Unlock(ctx, in)
+		return srv.(RuntimeServer).ListLocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spec.proto.runtime.v1.Runtime/Unlock",
+		FullMethod: "/spec.proto.runtime.v1.Runtime/ListLocks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServer).Unlock(ctx, req.(*UnlockRequest))
+		return srv.(RuntimeServer).ListLocks(ctx, req.(*ListLocksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Runtime_LockKeepAlive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LockKeepAliveRequest)
+func _Runtime_GetNextId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServer).LockKeepAlive(ctx, in)
+		return srv.(RuntimeServer).GetNextId(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spec.proto.runtime.v1.Runtime/LockKeepAlive",
+		FullMethod: "/spec.proto.runtime.v1.Runtime/GetNextId",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServer).LockKeepAlive(ctx, req.(*LockKeepAliveRequest))
+		return srv.(RuntimeServer).GetNextId(ctx, req.(*GetNextIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Runtime_GetNextId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNextIdRequest)
+func _Runtime_GetNextIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextIdsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServer).GetNextId(ctx, in)
+		return srv.(RuntimeServer).GetNextIds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/spec.proto.runtime.v1.Runtime/GetNextId",
+		FullMethod: "/spec.proto.runtime.v1.Runtime/GetNextIds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServer).GetNextId(ctx, req.(*GetNextIdRequest))
+		return srv.(RuntimeServer).GetNextIds(ctx, req.(*GetNextIdsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -945,6 +1613,158 @@ func _Runtime_GetFileMeta_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runtime_InitiateFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateFileUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).InitiateFileUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/InitiateFileUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).InitiateFileUpload(ctx, req.(*InitiateFileUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_UploadFilePart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RuntimeServer).UploadFilePart(&runtimeUploadFilePartServer{stream})
+}
+
+type Runtime_UploadFilePartServer interface {
+	SendAndClose(*FileUploadStatusResponse) error
+	Recv() (*UploadFilePartRequest, error)
+	grpc.ServerStream
+}
+
+type runtimeUploadFilePartServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeUploadFilePartServer) SendAndClose(m *FileUploadStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *runtimeUploadFilePartServer) Recv() (*UploadFilePartRequest, error) {
+	m := new(UploadFilePartRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Runtime_GetFileUploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).GetFileUploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/GetFileUploadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).GetFileUploadStatus(ctx, req.(*FileUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_CompleteFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).CompleteFileUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/CompleteFileUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).CompleteFileUpload(ctx, req.(*FileUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_AbortFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).AbortFileUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/AbortFileUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).AbortFileUpload(ctx, req.(*FileUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).CopyFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/CopyFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).CopyFile(ctx, req.(*CopyFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_RenameFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).RenameFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/RenameFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).RenameFile(ctx, req.(*RenameFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runtime_PresignURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresignURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServer).PresignURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spec.proto.runtime.v1.Runtime/PresignURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServer).PresignURL(ctx, req.(*PresignURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runtime_InvokeBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvokeBindingRequest)
 	if err := dec(in); err != nil {
@@ -1026,10 +1846,22 @@ var Runtime_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteConfiguration",
 			Handler:    _Runtime_DeleteConfiguration_Handler,
 		},
+		{
+			MethodName: "GetConfigurationHistory",
+			Handler:    _Runtime_GetConfigurationHistory_Handler,
+		},
+		{
+			MethodName: "RollbackConfiguration",
+			Handler:    _Runtime_RollbackConfiguration_Handler,
+		},
 		{
 			MethodName: "TryLock",
 			Handler:    _Runtime_TryLock_Handler,
 		},
+		{
+			MethodName: "Lock",
+			Handler:    _Runtime_Lock_Handler,
+		},
 		{
 			MethodName: "Unlock",
 			Handler:    _Runtime_Unlock_Handler,
@@ -1038,10 +1870,26 @@ var Runtime_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LockKeepAlive",
 			Handler:    _Runtime_LockKeepAlive_Handler,
 		},
+		{
+			MethodName: "GetLockHolderCount",
+			Handler:    _Runtime_GetLockHolderCount_Handler,
+		},
+		{
+			MethodName: "GetLockInfo",
+			Handler:    _Runtime_GetLockInfo_Handler,
+		},
+		{
+			MethodName: "ListLocks",
+			Handler:    _Runtime_ListLocks_Handler,
+		},
 		{
 			MethodName: "GetNextId",
 			Handler:    _Runtime_GetNextId_Handler,
 		},
+		{
+			MethodName: "GetNextIds",
+			Handler:    _Runtime_GetNextIds_Handler,
+		},
 		{
 			MethodName: "GetState",
 			Handler:    _Runtime_GetState_Handler,
@@ -1082,6 +1930,34 @@ var Runtime_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFileMeta",
 			Handler:    _Runtime_GetFileMeta_Handler,
 		},
+		{
+			MethodName: "InitiateFileUpload",
+			Handler:    _Runtime_InitiateFileUpload_Handler,
+		},
+		{
+			MethodName: "GetFileUploadStatus",
+			Handler:    _Runtime_GetFileUploadStatus_Handler,
+		},
+		{
+			MethodName: "CompleteFileUpload",
+			Handler:    _Runtime_CompleteFileUpload_Handler,
+		},
+		{
+			MethodName: "AbortFileUpload",
+			Handler:    _Runtime_AbortFileUpload_Handler,
+		},
+		{
+			MethodName: "CopyFile",
+			Handler:    _Runtime_CopyFile_Handler,
+		},
+		{
+			MethodName: "RenameFile",
+			Handler:    _Runtime_RenameFile_Handler,
+		},
+		{
+			MethodName: "PresignURL",
+			Handler:    _Runtime_PresignURL_Handler,
+		},
 		{
 			MethodName: "InvokeBinding",
 			Handler:    _Runtime_InvokeBinding_Handler,
@@ -1096,12 +1972,33 @@ var Runtime_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InvokeServiceClientStream",
+			Handler:       _Runtime_InvokeServiceClientStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "InvokeServiceServerStream",
+			Handler:       _Runtime_InvokeServiceServerStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InvokeServiceBidiStream",
+			Handler:       _Runtime_InvokeServiceBidiStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "SubscribeConfiguration",
 			Handler:       _Runtime_SubscribeConfiguration_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "LockWithLease",
+			Handler:       _Runtime_LockWithLease_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetFile",
 			Handler:       _Runtime_GetFile_Handler,
@@ -1112,6 +2009,11 @@ var Runtime_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Runtime_PutFile_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UploadFilePart",
+			Handler:       _Runtime_UploadFilePart_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "runtime.proto",
 }